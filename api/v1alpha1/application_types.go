@@ -28,7 +28,8 @@ const ApplicationKind = "Application"
 type ApplicationSpec struct {
 	// ConfigRepo is the address of the git repository containing the automation for this application
 	ConfigRepo string `json:"config_url,omitempty"`
-	// URL is the address of the git repository for this application
+	// URL is the address of the git repository for this application, or of the Helm repository,
+	// OCI repository (oci://<registry>/<repository>[:<tag>]) or bucket (http[s]://<endpoint>/<bucket>)
 	URL string `json:"url,omitempty"`
 	// Path is the path in the repository where the k8s yaml files for this application are stored.
 	Path string `json:"path,omitempty"`
@@ -38,8 +39,12 @@ type ApplicationSpec struct {
 	DeploymentType DeploymentType `json:"deployment_type,omitempty"`
 	// SourceType is the type of repository containing the app manifests
 	SourceType SourceType `json:"source_type,omitempty"`
+	// SourceSecretRef is the name of the Secret with the credentials of a bucket source
+	SourceSecretRef string `json:"source_secret_ref,omitempty"`
 	// HelmTargetNamespace is the namespace in which to deploy an added Helm Chart
 	HelmTargetNamespace string `json:"helm_target_namespace,omitempty"`
+	// DependsOn is the list of applications, in the same namespace, that must be ready before this application is deployed
+	DependsOn []string `json:"depends_on,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=helm;kustomize
@@ -50,12 +55,14 @@ const (
 	DeploymentTypeKustomize DeploymentType = "kustomize"
 )
 
-// +kubebuilder:validation:Enum=helm;git
+// +kubebuilder:validation:Enum=helm;git;oci;bucket
 type SourceType string
 
 const (
-	SourceTypeGit  SourceType = "git"
-	SourceTypeHelm SourceType = "helm"
+	SourceTypeGit    SourceType = "git"
+	SourceTypeHelm   SourceType = "helm"
+	SourceTypeOCI    SourceType = "oci"
+	SourceTypeBucket SourceType = "bucket"
 )
 
 // SuspendAction defines the command run to pause/unpause an application
@@ -68,15 +75,38 @@ const (
 
 const DefaultNamespace = "wego-system"
 
+// Condition types reported on an Application
+const (
+	// ReadyCondition mirrors the Ready condition of the application's Kustomization or HelmRelease
+	ReadyCondition = "Ready"
+	// SourceReadyCondition mirrors the Ready condition of the application's GitRepository or HelmRepository
+	SourceReadyCondition = "SourceReady"
+	// InventoryAvailableCondition is set to False when the objects applied by the deployment object cannot be read
+	InventoryAvailableCondition = "InventoryAvailable"
+)
+
 // ApplicationStatus defines the observed state of Application
 type ApplicationStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// ObservedGeneration is the last generation of the Application that was reconciled
+	ObservedGeneration int64 `json:"observed_generation,omitempty"`
+	// Conditions holds the conditions of the source and deployment objects generated for this application
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// LastAppliedRevision is the revision last applied to the cluster by the deployment object
+	LastAppliedRevision string `json:"last_applied_revision,omitempty"`
+	// Suspended is true when either the source or the deployment object is suspended
+	Suspended bool `json:"suspended,omitempty"`
+	// InventoryCount is the number of objects applied to the cluster by the deployment object
+	InventoryCount int `json:"inventory_count,omitempty"`
 }
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:singular=app,path=apps
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//+kubebuilder:printcolumn:name="Revision",type="string",JSONPath=".status.last_applied_revision"
+//+kubebuilder:printcolumn:name="Suspended",type="boolean",JSONPath=".status.suspended"
+//+kubebuilder:printcolumn:name="Objects",type="integer",JSONPath=".status.inventory_count"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Application is the Schema for the applications API
 type Application struct {
@@ -91,6 +121,12 @@ func (a *Application) IsHelmRepository() bool {
 	return a.Spec.SourceType == SourceTypeHelm
 }
 
+// IsArtifactSource returns true for applications whose manifests are fetched
+// from an OCI repository or a bucket rather than from a repository.
+func (a *Application) IsArtifactSource() bool {
+	return a.Spec.SourceType == SourceTypeOCI || a.Spec.SourceType == SourceTypeBucket
+}
+
 //+kubebuilder:object:root=true
 
 // ApplicationList contains a list of Application
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher"
@@ -17,6 +18,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/server"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
 )
 
 func main() {
@@ -38,13 +40,19 @@ const (
 )
 
 func NewAPIServerCommand() *cobra.Command {
+	var (
+		clusterSecretsNamespace string
+		clusterSecretsSelector  string
+		apiMetricsBindAddress   string
+	)
+
 	cmd := &cobra.Command{
 		Use:  "gitops-server",
 		Long: `The gitops-server handles HTTP requests for Weave GitOps Applications`,
 
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			flux.New(osys.New(), &runner.CLIRunner{}).SetupBin()
+			flux.NewClient(osys.New(), &runner.CLIRunner{}).SetupBin()
 
 			appConfig, err := server.DefaultApplicationsConfig()
 			if err != nil {
@@ -95,15 +103,47 @@ func NewAPIServerCommand() *cobra.Command {
 				ClusterName:   clusterName,
 			}, profileCache, "default", "weaveworks-charts")
 
-			s, err := server.NewHandlers(context.Background(), &server.Config{AppConfig: appConfig, ProfilesConfig: profilesConfig})
+			var appOptions []server.ApplicationsOption
+
+			if clusterSecretsNamespace != "" {
+				if err := server.ValidateClusterSecretsNamespace(clusterSecretsNamespace); err != nil {
+					return err
+				}
+
+				selector, err := labels.Parse(clusterSecretsSelector)
+				if err != nil {
+					return fmt.Errorf("invalid cluster secrets selector: %w", err)
+				}
+
+				appOptions = append(appOptions, server.WithClustersGetter(server.NewSecretsClustersGetter(rawClient, clusterSecretsNamespace, selector)))
+			}
+
+			s, err := server.NewHandlers(context.Background(), &server.Config{AppConfig: appConfig, AppOptions: appOptions, ProfilesConfig: profilesConfig})
 			if err != nil {
 				return err
 			}
 
+			if apiMetricsBindAddress != "" {
+				middleware.RegisterKubeClientMetrics()
+
+				go func() {
+					appConfig.Logger.Info("metrics server starting", "address", apiMetricsBindAddress)
+
+					if err := server.ListenAndServeMetrics(apiMetricsBindAddress); err != nil {
+						appConfig.Logger.Error(err, "metrics server exited")
+						os.Exit(1)
+					}
+				}()
+			}
+
 			appConfig.Logger.Info("server starting", "address", addr)
 			return http.ListenAndServe(addr, s)
 		},
 	}
 
+	cmd.Flags().StringVar(&clusterSecretsNamespace, "cluster-secrets-namespace", "", "the namespace of the kubeconfig Secrets of other clusters to show applications from, e.g. the ones created by Cluster API. In a cluster, it must be the namespace the server runs in")
+	cmd.Flags().StringVar(&clusterSecretsSelector, "cluster-secrets-selector", server.ClusterNameLabel, "the label selector of the kubeconfig Secrets of other clusters")
+	cmd.Flags().StringVar(&apiMetricsBindAddress, "metrics-bind-address", "", "bind address for the Prometheus metrics of the API, e.g. ':9982'. Metrics are not served when empty")
+
 	return cmd
 }
@@ -11,11 +11,13 @@ import (
 
 	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"k8s.io/cli-runtime/pkg/printers"
 
 	"github.com/lithammer/dedent"
 	"github.com/pkg/errors"
@@ -24,13 +26,21 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 )
 
 const (
 	SSHAuthSock = "SSH_AUTH_SOCK"
 )
 
-var params app.AddParams
+var (
+	params     app.AddParams
+	diffFormat string
+	sourceType string
+	appsFile   string
+	gitAuth    string
+)
 
 var Cmd = &cobra.Command{
 	Use:   "app [--name <name>] (--url <url> | <repository directory>) [--branch <branch>] [--path <path within repository>]",
@@ -44,6 +54,27 @@ var Cmd = &cobra.Command{
 
   # Add podinfo application to gitops control from github repository
   gitops add app --url git@github.com:myorg/podinfo
+
+  # Show the changes adding podinfo would make to the config repository
+  gitops add app --url git@github.com:myorg/podinfo --diff
+
+  # Add podinfo application from a bucket, using the credentials of the minio-credentials secret
+  gitops add app --source-type bucket --url https://minio.example.com/podinfo --source-secret-ref minio-credentials
+
+  # Add loki helm chart with values from a file, an inline value and a ConfigMap
+  gitops add app --url https://grafana.github.io/helm-charts --chart loki --values ./loki-values.yaml --set persistence.enabled=true --values-from configmap/loki-values
+
+  # Add podinfo application, deployed once the cert-manager and ingress-nginx applications are ready
+  gitops add app --url git@github.com:myorg/podinfo --depends-on cert-manager,ingress-nginx
+
+  # Add all the applications defined in a file of Application resources in a single commit or pull request
+  gitops add app -f apps.yaml
+
+  # Add podinfo application, fetched by flux over HTTPS with the token of the git provider instead of a deploy key
+  gitops add app --url git@github.com:myorg/podinfo --git-auth token
+
+  # Add podinfo application and wait up to 10 minutes for it to be ready
+  gitops add app --url git@github.com:myorg/podinfo --auto-merge --wait --timeout 10m
 `,
 	RunE:          runCmd,
 	SilenceUsage:  true,
@@ -60,9 +91,21 @@ func init() {
 	Cmd.Flags().StringVar(&params.Branch, "branch", "", "Branch to watch within git repository")
 	Cmd.Flags().StringVar(&params.DeploymentType, "deployment-type", app.DefaultDeploymentType, "Deployment type [kustomize, helm]")
 	Cmd.Flags().StringVar(&params.Chart, "chart", "", "Specify chart for helm source")
+	Cmd.Flags().StringVar(&sourceType, "source-type", "", "Type of the application source [git, helm, bucket]; defaults to helm when --chart is set and to git otherwise")
+	Cmd.Flags().StringVar(&gitAuth, "git-auth", "", "How the application git repository is authenticated to [deploy-key, token, github-app]; defaults to deploy-key, or to the mode of the repository when it is already set up")
+	Cmd.Flags().StringVar(&params.SourceSecretRef, "source-secret-ref", "", "Name of the secret with the credentials of a bucket source")
+	Cmd.Flags().StringArrayVar(&params.HelmValuesFiles, "values", nil, "Values file for the helm release; can be repeated, later files override earlier ones")
+	Cmd.Flags().StringArrayVar(&params.HelmSetValues, "set", nil, "Value for the helm release in the form key=val; can be repeated, overrides values files")
+	Cmd.Flags().StringArrayVar(&params.HelmValuesFrom, "values-from", nil, "ConfigMap or Secret holding values for the helm release, in the form configmap/<name> or secret/<name>; can be repeated")
+	Cmd.Flags().StringSliceVar(&params.DependsOn, "depends-on", nil, "Applications, in the same namespace, that must be ready before this application is deployed")
 	Cmd.Flags().StringVar(&params.HelmReleaseTargetNamespace, "helm-release-target-namespace", "", "Namespace in which to deploy a helm chart; defaults to the gitops installation namespace")
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops add app' will not make any changes to the system; it will just display the actions that would have been taken")
 	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops add app' will merge automatically into the set --branch")
+	Cmd.Flags().BoolVar(&params.Wait, "wait", false, "If set, 'gitops add app' will wait for the source and the deployment of the application to be ready. Requires --auto-merge")
+	Cmd.Flags().DurationVar(&params.WaitTimeout, "timeout", app.DefaultWaitTimeout, "How long to wait for the application to be ready when --wait is set")
+	Cmd.Flags().StringVarP(&appsFile, "file", "f", "", "File of Application resources to add in a single commit or pull request")
+	Cmd.Flags().StringVar(&diffFormat, "diff", "", "If set, 'gitops add app' will print the changes it would make to the config repository without pushing them; one of [unified, json]")
+	Cmd.Flags().Lookup("diff").NoOptDefVal = internal.DiffFormatUnified
 }
 
 func ensureUrlIsValid() error {
@@ -83,11 +126,21 @@ func ensureUrlIsValid() error {
 func runCmd(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+	params.SourceType = wego.SourceType(sourceType)
+
+	var err error
+	if params.GitAuth, err = gitproviders.ParseGitAuth(gitAuth); err != nil {
+		return err
+	}
 
 	if params.Url != "" && len(args) > 0 {
 		return fmt.Errorf("you should choose either --url or the app directory")
 	}
 
+	if appsFile != "" && (params.Url != "" || len(args) > 0 || diffFormat != "") {
+		return fmt.Errorf("--file cannot be used with --url, --diff or the app directory")
+	}
+
 	if len(args) > 0 {
 		path, err := filepath.Abs(args[0])
 		if err != nil {
@@ -97,15 +150,27 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		params.Dir = path
 	}
 
-	if urlErr := ensureUrlIsValid(); urlErr != nil {
-		return urlErr
+	if appsFile == "" {
+		if urlErr := ensureUrlIsValid(); urlErr != nil {
+			return urlErr
+		}
+	}
+
+	if err := internal.ValidateDiffFormat(diffFormat); err != nil {
+		return err
+	}
+
+	// Keep stdout for the diff so it can be piped
+	output := os.Stdout
+	if diffFormat != "" {
+		output = os.Stderr
 	}
 
-	log := internal.NewCLILogger(os.Stdout)
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	log := internal.NewCLILogger(output)
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 	factory := services.NewFactory(fluxClient, log)
 
-	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+	providerClient := internal.NewGitProviderClient(output, os.LookupEnv, auth.NewAuthCLIHandler, log)
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -124,20 +189,141 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	params.ConfigRepo = wegoConfig.ConfigRepo
 
+	if appsFile != "" {
+		return addBatch(ctx, kubeClient, factory, providerClient, appService)
+	}
+
+	if diffFormat != "" {
+		gitClient, gitProvider, err := internal.GetDiffClients(providerClient, params.ConfigRepo)
+		if err != nil {
+			return fmt.Errorf("failed to get git clients: %w", err)
+		}
+
+		changes, err := appService.DiffAdd(gitClient, gitProvider, params)
+		if err != nil {
+			return errors.Wrapf(err, "failed to diff the app %s", params.Name)
+		}
+
+		return internal.PrintDiff(os.Stdout, changes, diffFormat)
+	}
+
 	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.GitConfigParams{
 		URL:              params.Url,
 		ConfigRepo:       params.ConfigRepo,
 		Namespace:        params.Namespace,
 		IsHelmRepository: params.IsHelmRepository(),
+		IsArtifactSource: params.IsArtifactSource(),
 		DryRun:           params.DryRun,
+		GitAuth:          params.GitAuth,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to get git clients: %w", err)
 	}
 
+	if err := resolveGitAuth(ctx, kubeClient, &params); err != nil {
+		return err
+	}
+
 	if err := appService.Add(gitClient, gitProvider, params); err != nil {
 		return errors.Wrapf(err, "failed to add the app %s", params.Name)
 	}
 
 	return nil
 }
+
+func addBatch(ctx context.Context, kubeClient kube.Kube, factory services.Factory, providerClient gitproviders.Client, appService app.AppService) error {
+	f, err := os.Open(appsFile)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", appsFile, err)
+	}
+	defer f.Close()
+
+	apps, err := app.ReadAddParams(f, params.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", appsFile, err)
+	}
+
+	var (
+		gitClient   git.Git
+		gitProvider gitproviders.GitProvider
+	)
+
+	for i := range apps {
+		if apps[i].ConfigRepo == "" {
+			apps[i].ConfigRepo = params.ConfigRepo
+		}
+
+		apps[i].GitAuth = params.GitAuth
+
+		// Sets up the deploy keys of the application repositories
+		gitClient, gitProvider, err = factory.GetGitClients(ctx, kubeClient, providerClient, services.GitConfigParams{
+			URL:              apps[i].Url,
+			ConfigRepo:       params.ConfigRepo,
+			Namespace:        apps[i].Namespace,
+			IsHelmRepository: apps[i].IsHelmRepository(),
+			IsArtifactSource: apps[i].IsArtifactSource(),
+			DryRun:           params.DryRun,
+			GitAuth:          apps[i].GitAuth,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get git clients for %s: %w", apps[i].Url, err)
+		}
+
+		if err := resolveGitAuth(ctx, kubeClient, &apps[i]); err != nil {
+			return err
+		}
+	}
+
+	results, err := appService.AddBatch(gitClient, gitProvider, app.AddBatchParams{
+		Apps:        apps,
+		DryRun:      params.DryRun,
+		AutoMerge:   params.AutoMerge,
+		Wait:        params.Wait,
+		WaitTimeout: params.WaitTimeout,
+	})
+
+	printAddResults(results, err == nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the applications: %w", err)
+	}
+
+	return nil
+}
+
+// resolveGitAuth sets the authentication of the application git repository to the mode its credentials were
+// set up with, so that flux fetches it with the same protocol.
+func resolveGitAuth(ctx context.Context, kubeClient kube.Kube, params *app.AddParams) error {
+	if params.GitAuth != "" || params.DryRun || params.IsHelmRepository() || params.IsArtifactSource() {
+		return nil
+	}
+
+	repoUrl, err := gitproviders.NewRepoURL(params.Url)
+	if err != nil {
+		return err
+	}
+
+	params.GitAuth, err = auth.RepoGitAuth(ctx, kubeClient.Raw(), params.Namespace, repoUrl)
+
+	return err
+}
+
+func printAddResults(results []app.AddResult, added bool) {
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "NAME\tRESULT")
+
+	for _, result := range results {
+		status := "valid"
+
+		switch {
+		case result.Err != nil:
+			status = result.Err.Error()
+		case added && !params.DryRun:
+			status = "added"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\n", result.Name, status)
+	}
+}
@@ -64,7 +64,7 @@ func addProfileCmdRunE() func(*cobra.Command, []string) error {
 		rand.Seed(time.Now().UnixNano())
 
 		log := internal.NewCLILogger(os.Stdout)
-		fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+		fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 		factory := services.NewFactory(fluxClient, log)
 		providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
 
@@ -32,7 +32,7 @@ var Cmd = &cobra.Command{
 		params.Namespace, _ = cmd.Parent().Parent().Flags().GetString("namespace")
 
 		log := internal.NewCLILogger(os.Stdout)
-		fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+		fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 		appFactory := services.NewFactory(fluxClient, log)
 
 		kubeClient, _, err := kube.NewKubeHTTPClient()
@@ -64,7 +64,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	log := internal.NewCLILogger(os.Stdout)
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 	factory := services.NewFactory(fluxClient, log)
 
 	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
@@ -84,6 +84,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		ConfigRepo:       params.ConfigRepo,
 		Namespace:        params.Namespace,
 		IsHelmRepository: params.IsHelmRepository(),
+		IsArtifactSource: params.IsArtifactSource(),
 		DryRun:           params.DryRun,
 	})
 	if err != nil {
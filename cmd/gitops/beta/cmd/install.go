@@ -59,7 +59,7 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 	namespace, _ := cmd.Parent().Flags().GetString("namespace")
 
 	log := internal.NewCLILogger(os.Stdout)
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 
 	k, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -37,7 +37,7 @@ func init() {
 func runCmd(_ *cobra.Command, _ []string) error {
 	ctx := context.Background()
 
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 
 	rest, clusterName, err := kube.RestConfig()
 	if err != nil {
@@ -24,7 +24,10 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 )
 
-var params app.RemoveParams
+var (
+	params     app.RemoveParams
+	diffFormat string
+)
 
 var Cmd = &cobra.Command{
 	Use:   "app <app name>",
@@ -35,6 +38,9 @@ var Cmd = &cobra.Command{
 	Example: `
   # Delete application from gitops control via immediate commit
   gitops delete app podinfo
+
+  # Show the changes deleting podinfo would make to the config repository, as JSON
+  gitops delete app podinfo --diff=json
 `,
 	Args:          cobra.MinimumNArgs(1),
 	RunE:          runCmd,
@@ -48,6 +54,8 @@ var Cmd = &cobra.Command{
 func init() {
 	Cmd.Flags().BoolVar(&params.DryRun, "dry-run", false, "If set, 'gitops delete app' will not make any changes to the system; it will just display the actions that would have been taken")
 	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops delete app' will merge changes automatically to the config repository")
+	Cmd.Flags().StringVar(&diffFormat, "diff", "", "If set, 'gitops delete app' will print the changes it would make to the config repository without pushing them; one of [unified, json]")
+	Cmd.Flags().Lookup("diff").NoOptDefVal = internal.DiffFormatUnified
 }
 
 func runCmd(cmd *cobra.Command, args []string) error {
@@ -56,8 +64,18 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.Name = args[0]
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 
-	log := internal.NewCLILogger(os.Stdout)
-	factory := services.NewFactory(flux.New(osys.New(), &runner.CLIRunner{}), log)
+	if err := internal.ValidateDiffFormat(diffFormat); err != nil {
+		return err
+	}
+
+	// Keep stdout for the diff so it can be piped
+	output := os.Stdout
+	if diffFormat != "" {
+		output = os.Stderr
+	}
+
+	log := internal.NewCLILogger(output)
+	factory := services.NewFactory(flux.NewClient(osys.New(), &runner.CLIRunner{}), log)
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -74,7 +92,21 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
 	}
 
-	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+	providerClient := internal.NewGitProviderClient(output, os.LookupEnv, auth.NewAuthCLIHandler, log)
+
+	if diffFormat != "" {
+		gitClient, gitProvider, err := internal.GetDiffClients(providerClient, appContent.Spec.ConfigRepo)
+		if err != nil {
+			return fmt.Errorf("failed to get git clients: %w", err)
+		}
+
+		changes, err := appService.DiffRemove(gitClient, gitProvider, params)
+		if err != nil {
+			return errors.Wrapf(err, "failed to diff the app %s", params.Name)
+		}
+
+		return internal.PrintDiff(os.Stdout, changes, diffFormat)
+	}
 
 	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.NewGitConfigParamsFromApp(appContent, params.DryRun))
 	if err != nil {
@@ -32,7 +32,7 @@ func init() {
 // Example flux command with flags 'wego flux -- install -h'
 func runCmd(cmd *cobra.Command, args []string) {
 	cliRunner := &runner.CLIRunner{}
-	fluxClient := flux.New(osys.New(), cliRunner)
+	fluxClient := flux.NewClient(osys.New(), cliRunner)
 
 	exePath, err := fluxClient.GetExePath()
 	if err != nil {
@@ -51,7 +51,7 @@ func runCmd(cmd *cobra.Command, args []string) {
 
 func runStatusCmd(cmd *cobra.Command, args []string) {
 	cliRunner := &runner.CLIRunner{}
-	fluxClient := flux.New(osys.New(), cliRunner)
+	fluxClient := flux.NewClient(osys.New(), cliRunner)
 
 	status, err := fluxClient.GetLatestStatusAllNamespaces()
 	if err != nil {
@@ -2,18 +2,26 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/output"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
 	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/printers"
 )
 
 var Cmd = &cobra.Command{
@@ -29,16 +37,49 @@ gitops get apps
 
 # Get status of an application under gitops control
 gitops get app <app-name>
+
+# Get an application as YAML
+gitops get app <app-name> -o yaml
+
+# Get the warning events of an application, its Flux objects and the objects they reconciled
+gitops get app <app-name> --events --event-type Warning
 `,
 	RunE: runCmd,
 }
 
+var (
+	showEvents bool
+	eventType  string
+)
+
+func init() {
+	Cmd.Flags().BoolVar(&showEvents, "events", false, "Show the Kubernetes events of the application, its Flux objects and the objects they reconciled")
+	Cmd.Flags().StringVar(&eventType, "event-type", "", "Only show the events of this type [Normal, Warning]")
+}
+
 func runCmd(cmd *cobra.Command, args []string) error {
+	o, err := internal.GetOutputOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	if showEvents {
+		if len(args) != 1 {
+			return errors.New("--events requires an application name")
+		}
+
+		return printEvents(cmd, args[0], o)
+	}
+
 	if len(args) == 1 {
+		if !o.IsTable() {
+			return printApplication(cmd, args[0], o)
+		}
+
 		return getApplicationStatus(cmd, args)
 	}
 
-	return getApplications(cmd)
+	return getApplications(cmd, o)
 }
 
 func getApplicationStatus(cmd *cobra.Command, args []string) error {
@@ -50,7 +91,7 @@ func getApplicationStatus(cmd *cobra.Command, args []string) error {
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 
 	log := internal.NewCLILogger(os.Stdout)
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 	factory := services.NewFactory(fluxClient, log)
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
@@ -74,29 +115,118 @@ func getApplicationStatus(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func getApplications(cmd *cobra.Command) error {
-	_, k8s, err := kube.NewKubeHTTPClient()
+// printApplication prints the Application resource in one of the structured output formats.
+func printApplication(cmd *cobra.Command, name string, o output.Options) error {
+	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
-		return fmt.Errorf("error initializing kubernetes client: %w", err)
+		return fmt.Errorf("failed to create kube client: %w", err)
 	}
 
-	fetcher := applicationv2.NewFetcher(k8s)
+	ns, _ := cmd.Parent().Flags().GetString("namespace")
+
+	application, err := kubeClient.GetApplication(cmd.Context(), types.NamespacedName{Name: name, Namespace: ns})
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	setApplicationTypeMeta(application)
+
+	return o.Print(os.Stdout, application)
+}
+
+// printEvents prints the timeline of the events of an application.
+func printEvents(cmd *cobra.Command, name string, o output.Options) error {
+	kubeClient, k8s, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	ns, _ := cmd.Parent().Flags().GetString("namespace")
+
+	application, err := kubeClient.GetApplication(cmd.Context(), types.NamespacedName{Name: name, Namespace: ns})
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	events, err := applicationv2.ListEvents(cmd.Context(), k8s, application, eventType)
+	if err != nil {
+		return fmt.Errorf("failed listing events: %w", err)
+	}
+
+	if !o.IsTable() {
+		return o.Print(os.Stdout, events)
+	}
+
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE")
+
+	for _, event := range events {
+		lastSeen := duration.HumanDuration(time.Since(applicationv2.EventTime(event)))
+		object := strings.ToLower(event.InvolvedObject.Kind) + "/" + event.InvolvedObject.Name
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", lastSeen, event.Type, event.Reason, object, strings.TrimSpace(event.Message))
+	}
+
+	return nil
+}
+
+func getApplications(cmd *cobra.Command, o output.Options) error {
+	kubeClient, k8s, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("error initializing kubernetes client: %w", err)
+	}
 
 	ns, err := cmd.Parent().Parent().Flags().GetString("namespace")
 	if err != nil {
 		return err
 	}
 
-	fmt.Println("NAME")
+	if !o.IsTable() {
+		apps, err := kubeClient.GetApplications(cmd.Context(), ns)
+		if err != nil {
+			return err
+		}
+
+		for i := range apps {
+			setApplicationTypeMeta(&apps[i])
+		}
+
+		return o.Print(os.Stdout, apps)
+	}
+
+	fetcher := applicationv2.NewFetcher(k8s)
 
 	apps, err := fetcher.List(cmd.Context(), ns)
 	if err != nil {
 		return err
 	}
 
+	if !o.IsWide() {
+		fmt.Println("NAME")
+
+		for _, app := range apps {
+			fmt.Println(app.Name)
+		}
+
+		return nil
+	}
+
+	w := printers.GetNewTabWriter(os.Stdout)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "NAME\tSOURCE\tURL\tBRANCH\tPATH\tDEPLOYMENT\tCONFIG_REPO")
+
 	for _, app := range apps {
-		fmt.Println(app.Name)
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", app.Name, app.SourceType, app.SourceURL(), app.Branch, app.Path, app.AutomationType, app.ConfigRepo.String())
 	}
 
 	return nil
 }
+
+// setApplicationTypeMeta fills in the kind and apiVersion, which the client leaves empty.
+func setApplicationTypeMeta(application *wego.Application) {
+	application.APIVersion = wego.GroupVersion.String()
+	application.Kind = wego.ApplicationKind
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/clusters"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -60,6 +61,11 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 			return err
 		}
 
+		o, err := internal.GetOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 
 		defer w.Flush()
@@ -73,9 +79,9 @@ func getClusterCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comma
 		}
 
 		if len(args) == 1 {
-			return clusters.GetClusterByName(args[0], r, w)
+			return clusters.GetClusterByName(args[0], r, w, o)
 		}
 
-		return clusters.GetClusters(r, w)
+		return clusters.GetClusters(r, w, o)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/credentials"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/profiles"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/templates"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 )
 
 func GetCommand(endpoint *string, client *resty.Client) *cobra.Command {
@@ -29,9 +30,17 @@ gitops get templates
 gitops get credentials
 
 # Get all CAPI clusters
-gitops get clusters`,
+gitops get clusters
+
+# Get all applications as JSON
+gitops get apps -o json
+
+# Get the names of all profiles
+gitops get profiles -o jsonpath='{[*].name}'`,
 	}
 
+	internal.AddOutputFlag(cmd)
+
 	cmd.AddCommand(app.Cmd)
 	cmd.AddCommand(commits.Cmd)
 	cmd.AddCommand(templates.TemplateCommand(endpoint, client))
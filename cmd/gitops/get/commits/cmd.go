@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
 	"github.com/pkg/errors"
@@ -44,8 +45,19 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.PageSize = 10
 	params.PageToken = 0
 
-	log := internal.NewCLILogger(os.Stdout)
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	o, err := internal.GetOutputOptions(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Keep stdout for structured output so it can be piped
+	logOutput := os.Stdout
+	if !o.IsTable() {
+		logOutput = os.Stderr
+	}
+
+	log := internal.NewCLILogger(logOutput)
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 	factory := services.NewFactory(fluxClient, log)
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
@@ -63,7 +75,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
 	}
 
-	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+	providerClient := internal.NewGitProviderClient(logOutput, os.LookupEnv, auth.NewAuthCLIHandler, log)
 
 	_, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.NewGitConfigParamsFromApp(appContent, false))
 	if err != nil {
@@ -75,17 +87,40 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return errors.Wrapf(err, "failed to get commits for app %s", params.Name)
 	}
 
-	printCommitTable(log, commits)
+	if !o.IsTable() {
+		infos := []gitprovider.CommitInfo{}
+		for _, commit := range commits {
+			infos = append(infos, commit.Get())
+		}
+
+		return o.Print(os.Stdout, infos)
+	}
+
+	printCommitTable(log, commits, o.IsWide())
 
 	return nil
 }
 
-func printCommitTable(log logger.Logger, commits []gitprovider.Commit) {
+// printCommitTable shortens hashes, messages and URLs unless wide is set.
+func printCommitTable(log logger.Logger, commits []gitprovider.Commit, wide bool) {
 	header := []string{"Commit Hash", "Created At", "Author", "Message", "URL"}
 	rows := [][]string{}
 
 	for _, commit := range commits {
 		c := commit.Get()
+
+		if wide {
+			rows = append(rows, []string{
+				c.Sha,
+				utils.CleanCommitCreatedAt(c.CreatedAt),
+				c.Author,
+				strings.ReplaceAll(c.Message, "\n", " "),
+				c.URL,
+			})
+
+			continue
+		}
+
 		rows = append(rows, []string{
 			utils.ConvertCommitHashToShort(c.Sha),
 			utils.CleanCommitCreatedAt(c.CreatedAt),
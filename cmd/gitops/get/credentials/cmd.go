@@ -6,6 +6,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -46,9 +47,14 @@ func getCredentialCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Co
 			return err
 		}
 
+		o, err := internal.GetOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 		defer w.Flush()
 
-		return capi.GetCredentials(r, w)
+		return capi.GetCredentials(r, w, o)
 	}
 }
@@ -53,9 +53,15 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	o, err := internal.GetOutputOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	return profiles.NewService(clientSet, internal.NewCLILogger(os.Stdout)).Get(context.Background(), profiles.GetOptions{
 		Namespace: ns,
 		Writer:    os.Stdout,
 		Port:      port,
+		Output:    o,
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
 	"github.com/weaveworks/weave-gitops/pkg/adapters"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -82,6 +83,11 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 			return err
 		}
 
+		o, err := internal.GetOutputOptions(cmd)
+		if err != nil {
+			return err
+		}
+
 		w := printers.GetNewTabWriter(os.Stdout)
 		defer w.Flush()
 
@@ -90,7 +96,7 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 				return errors.New("template name is required")
 			}
 
-			return capi.GetTemplateParameters(args[0], r, w)
+			return capi.GetTemplateParameters(args[0], r, w, o)
 		}
 
 		if flags.ListTemplateProfiles {
@@ -98,15 +104,15 @@ func getTemplateCmdRunE(endpoint *string, client *resty.Client) func(*cobra.Comm
 				return errors.New("template name is required")
 			}
 
-			return capi.GetTemplateProfiles(args[0], r, w)
+			return capi.GetTemplateProfiles(args[0], r, w, o)
 		}
 
 		if len(args) == 0 {
 			if flags.Provider != "" {
-				return capi.GetTemplatesByProvider(flags.Provider, r, w)
+				return capi.GetTemplatesByProvider(flags.Provider, r, w, o)
 			}
 
-			return capi.GetTemplates(r, w)
+			return capi.GetTemplates(r, w, o)
 		}
 
 		return nil
@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/spf13/cobra"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
@@ -22,6 +24,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
 	"github.com/weaveworks/weave-gitops/pkg/services/install"
@@ -32,6 +35,9 @@ type params struct {
 	DryRun     bool
 	AutoMerge  bool
 	ConfigRepo string
+	Wait       bool
+	Timeout    time.Duration
+	GitAuth    string
 }
 
 var (
@@ -45,7 +51,14 @@ var Cmd = &cobra.Command{
 adds a cluster entry to the GitOps repo, and persists the GitOps runtime into the
 repo. If a previous version is installed, then an in-place upgrade will be performed.`,
 	Example: fmt.Sprintf(`  # Install GitOps in the %s namespace
-  gitops install --config-repo=ssh://git@github.com/me/mygitopsrepo.git`, wego.DefaultNamespace),
+  gitops install --config-repo=ssh://git@github.com/me/mygitopsrepo.git
+
+  # Install GitOps, accessing the config repository over HTTPS with a GitHub App instead of a deploy key
+  gitops install --config-repo=ssh://git@github.com/me/mygitopsrepo.git --git-auth github-app \
+    --github-app-id 1234 --github-app-installation-id 5678 --github-app-private-key-file app.pem
+
+  # Install GitOps and wait for the config repository to be reconciled
+  gitops install --config-repo=ssh://git@github.com/me/mygitopsrepo.git --auto-merge --wait`, wego.DefaultNamespace),
 	RunE:          installRunCmd,
 	SilenceErrors: true,
 	SilenceUsage:  true,
@@ -58,6 +71,9 @@ func init() {
 	Cmd.Flags().BoolVar(&installParams.DryRun, "dry-run", false, "Outputs all the manifests that would be installed")
 	Cmd.Flags().BoolVar(&installParams.AutoMerge, "auto-merge", false, "If set, 'gitops install' will automatically update the default branch for the configuration repository")
 	Cmd.Flags().StringVar(&installParams.ConfigRepo, "config-repo", "", "URL of external repository that will hold automation manifests")
+	Cmd.Flags().BoolVar(&installParams.Wait, "wait", false, "If set, 'gitops install' will wait for the config repository to be reconciled")
+	Cmd.Flags().DurationVar(&installParams.Timeout, "timeout", app.DefaultWaitTimeout, "How long to wait for the config repository to be reconciled when --wait is set")
+	Cmd.Flags().StringVar(&installParams.GitAuth, "git-auth", "", "How git and flux authenticate to the config repository [deploy-key, token, github-app]; defaults to deploy-key, or to the mode of the existing installation")
 	cobra.CheckErr(Cmd.MarkFlagRequired("config-repo"))
 }
 
@@ -70,8 +86,13 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	gitAuth, err := gitproviders.ParseGitAuth(installParams.GitAuth)
+	if err != nil {
+		return err
+	}
+
 	osysClient := osys.New()
-	fluxClient := flux.New(osysClient, &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osysClient, &runner.CLIRunner{})
 
 	kubeClient, rawK8sClient, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -83,6 +104,14 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if gitAuth == "" {
+		if gitAuth, err = auth.RepoGitAuth(ctx, rawK8sClient, namespace, configURL); err != nil {
+			return err
+		}
+	}
+
+	configURL = configURL.WithGitAuth(gitAuth)
+
 	log := internal.NewCLILogger(os.Stdout)
 
 	token, err := internal.GetToken(configURL, osysClient.Stdout(), osysClient.LookupEnv, auth.NewAuthCLIHandler, log)
@@ -125,17 +154,37 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	authService, err := auth.NewAuthService(fluxClient, rawK8sClient, gitProvider, log)
+	githubApp, err := auth.GitHubAppTokenSourceFromConfig(configURL.URL().Host)
+	if err != nil {
+		return err
+	}
+
+	// The wego-app Deployment refreshes the installation tokens with the GitHub App of this Secret
+	if githubApp != nil {
+		if err := auth.StoreGitHubAppConfig(ctx, rawK8sClient, namespace); err != nil {
+			return fmt.Errorf("failed storing the GitHub App: %w", err)
+		}
+	}
+
+	authService, err := auth.NewAuthService(fluxClient, rawK8sClient, gitProvider, log,
+		auth.WithProviderToken(auth.NewStaticTokenSource(token)),
+		auth.WithGitHubApp(githubApp),
+	)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := authService.SetupGitCredentials(ctx, namespace, configURL)
 	if err != nil {
 		return err
 	}
 
-	deployKey, err := authService.SetupDeployKey(ctx, namespace, configURL)
+	signer, err := auth.CommitSigner(ctx, rawK8sClient, namespace)
 	if err != nil {
 		return err
 	}
 
-	gitClient := git.New(deployKey, wrapper.NewGoGit())
+	gitClient := git.New(credentials, wrapper.NewGoGit(), git.WithSigner(signer))
 
 	repoWriter := gitopswriter.NewRepoWriter(log, gitClient, gitProvider)
 	installer := install.NewInstaller(fluxClient, kubeClient, gitClient, gitProvider, log, repoWriter)
@@ -144,5 +193,12 @@ func installRunCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed installing: %w", err)
 	}
 
+	if installParams.Wait {
+		resources := install.BootstrapResources(clusterName, namespace, configURL)
+		if err := app.WaitForReady(ctx, kubeClient, clock.New(), log, installParams.Timeout, resources); err != nil {
+			return fmt.Errorf("failed waiting for the installation: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,100 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	labelSelector string
+	container     string
+	since         time.Duration
+	follow        bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "app <app-name>",
+	Short: "Print the logs of the pods of an application",
+	Long:  "Print the logs of the containers of the pods of the workloads an application reconciled, prefixed with the pod and container they come from",
+	Args:  cobra.ExactArgs(1),
+	Example: `
+# Print the logs of the pods of podinfo
+gitops logs app podinfo
+
+# Follow the logs of the last 10 minutes of the podinfo containers of the podinfo pods
+gitops logs app podinfo -f --since 10m -c podinfo
+
+# Print the logs of the pods of podinfo labelled as frontend
+gitops logs app podinfo -l tier=frontend`,
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&labelSelector, "selector", "l", "", "Only print the logs of the pods matching this label selector")
+	Cmd.Flags().StringVarP(&container, "container", "c", "", "Only print the logs of the containers with this name")
+	Cmd.Flags().DurationVar(&since, "since", 0, "Only print the logs newer than this duration, e.g. 5s, 2m or 3h; all logs when not set")
+	Cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Keep printing new logs until interrupted")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	namespace, _ := cmd.Parent().Flags().GetString("namespace")
+
+	config, contextName, err := kube.RestConfig()
+	if err != nil {
+		return fmt.Errorf("failed to create kube config: %w", err)
+	}
+
+	kubeClient, k8s, err := kube.NewKubeHTTPClientWithConfig(config, contextName)
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return fmt.Errorf("failed to create kube clientset: %w", err)
+	}
+
+	application, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: args[0], Namespace: namespace})
+	if err != nil {
+		return fmt.Errorf("failed getting application: %w", err)
+	}
+
+	pods, err := applicationv2.ApplicationPods(ctx, k8s, application, labelSelector)
+	if err != nil {
+		return fmt.Errorf("failed getting the pods of application %s: %w", application.Name, err)
+	}
+
+	if len(pods) == 0 {
+		fmt.Fprintf(os.Stderr, "No pods found for application %s\n", application.Name)
+		return nil
+	}
+
+	opts := applicationv2.LogsOptions{
+		Container:    container,
+		SinceSeconds: int64(since.Seconds()),
+		Follow:       follow,
+	}
+
+	return applicationv2.StreamLogs(ctx, clientset, pods, opts, func(line applicationv2.LogLine) error {
+		_, err := fmt.Fprintf(os.Stdout, "[%s/%s] %s\n", line.Pod, line.Container, line.Line)
+		return err
+	})
+}
@@ -0,0 +1,20 @@
+package logs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/logs/app"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Print the logs of your GitOps workloads",
+		Example: `
+# Print the logs of the pods of an application
+gitops logs app <app-name>`,
+	}
+
+	cmd.AddCommand(app.Cmd)
+
+	return cmd
+}
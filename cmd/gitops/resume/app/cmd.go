@@ -39,7 +39,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 	params.Name = args[0]
 
-	appFactory := services.NewFactory(flux.New(osys.New(), &runner.CLIRunner{}), internal.NewCLILogger(os.Stdout))
+	appFactory := services.NewFactory(flux.NewClient(osys.New(), &runner.CLIRunner{}), internal.NewCLILogger(os.Stdout))
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
 	if err != nil {
@@ -0,0 +1,93 @@
+package app
+
+// Provides support for rolling back an application to a previous commit or tag.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var params app.RollbackParams
+
+var Cmd = &cobra.Command{
+	Use:   "app <app-name>",
+	Short: "Roll back an application to a commit or tag",
+	Long: strings.TrimSpace(dedent.Dedent(`
+        Pins the source of an application to a commit or tag in the config repository, so the application is rolled back to it.
+        Use 'gitops unpin app' to make the application track its branch again.
+    `)),
+	Example: `
+  # Roll back podinfo to a commit, the full SHAs are shown by 'gitops get commits podinfo -o wide'
+  gitops rollback app podinfo --to 9fd8b7c1a3f2e6d5c4b3a2918f7e6d5c4b3a2918
+
+  # Roll back podinfo to a tag via an immediate commit
+  gitops rollback app podinfo --to v1.2.0 --auto-merge
+`,
+	Args:          cobra.MinimumNArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringVar(&params.Revision, "to", "", "The full commit SHA or tag to roll the application back to")
+	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops rollback app' will merge changes automatically to the config repository")
+	cobra.CheckErr(Cmd.MarkFlagRequired("to"))
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	log := internal.NewCLILogger(os.Stdout)
+	factory := services.NewFactory(flux.NewClient(osys.New(), &runner.CLIRunner{}), log)
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	appService, err := factory.GetAppService(ctx, kubeClient)
+	if err != nil {
+		return fmt.Errorf("failed to create app service: %w", err)
+	}
+
+	appContent, err := appService.Get(types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
+	}
+
+	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+
+	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.NewGitConfigParamsFromApp(appContent, false))
+	if err != nil {
+		return fmt.Errorf("failed to get git clients: %w", err)
+	}
+
+	if err := appService.Rollback(gitClient, gitProvider, params); err != nil {
+		return errors.Wrapf(err, "failed to roll back the app %s", params.Name)
+	}
+
+	return nil
+}
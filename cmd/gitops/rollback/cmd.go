@@ -0,0 +1,20 @@
+package rollback
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rollback/app"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back your GitOps automations",
+		Example: `
+# Roll back an application to a commit
+gitops rollback app <app-name> --to <commit-sha>`,
+	}
+
+	cmd.AddCommand(app.Cmd)
+
+	return cmd
+}
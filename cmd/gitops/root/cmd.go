@@ -19,13 +19,18 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/flux"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/install"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/logs"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rollback"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rotate"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/ui"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/uninstall"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/unpin"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/upgrade"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
 	fluxBin "github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
@@ -128,7 +133,12 @@ func RootCmd(client *resty.Client) *cobra.Command {
 	rootCmd.PersistentFlags().String("namespace", wego.DefaultNamespace, "The namespace scope for this operation")
 	rootCmd.PersistentFlags().StringVarP(&options.endpoint, "endpoint", "e", os.Getenv("WEAVE_GITOPS_ENTERPRISE_API_URL"), "The Weave GitOps Enterprise HTTP API endpoint")
 	rootCmd.PersistentFlags().BoolVar(&options.overrideInCluster, "override-in-cluster", false, "override running in cluster check")
-	rootCmd.PersistentFlags().StringToStringVar(&options.gitHostTypes, "git-host-types", map[string]string{}, "Specify which custom domains are running what (github or gitlab)")
+	rootCmd.PersistentFlags().StringToStringVar(&options.gitHostTypes, "git-host-types", map[string]string{}, "Specify which custom domains are running what (github, gitlab, bitbucket-server, gitea or git)")
+	rootCmd.PersistentFlags().String("signing-key-file", "", "Sign the commits made to the config repository with the private key in this file (the passphrase is read from GITOPS_SIGNING_KEY_PASSPHRASE)")
+	rootCmd.PersistentFlags().String("signing-key-format", string(git.SigningFormatOpenPGP), "The format of the signing key (openpgp or ssh)")
+	rootCmd.PersistentFlags().String("github-app-id", "", "ID of the GitHub App whose installation tokens authenticate the repositories set up with --git-auth github-app")
+	rootCmd.PersistentFlags().String("github-app-installation-id", "", "ID of the installation of the GitHub App in the organization or account of the repositories")
+	rootCmd.PersistentFlags().String("github-app-private-key-file", "", "File with the PEM encoded private key of the GitHub App")
 	cobra.CheckErr(rootCmd.PersistentFlags().MarkHidden("override-in-cluster"))
 	cobra.CheckErr(rootCmd.PersistentFlags().MarkHidden("git-host-types"))
 
@@ -143,6 +153,10 @@ func RootCmd(client *resty.Client) *cobra.Command {
 	rootCmd.AddCommand(delete.DeleteCommand(&options.endpoint, client))
 	rootCmd.AddCommand(resume.GetCommand())
 	rootCmd.AddCommand(suspend.GetCommand())
+	rootCmd.AddCommand(logs.GetCommand())
+	rootCmd.AddCommand(rollback.GetCommand())
+	rootCmd.AddCommand(rotate.GetCommand())
+	rootCmd.AddCommand(unpin.GetCommand())
 	rootCmd.AddCommand(upgrade.Cmd)
 	rootCmd.AddCommand(docs.Cmd)
 	rootCmd.AddCommand(check.Cmd)
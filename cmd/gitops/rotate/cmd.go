@@ -0,0 +1,20 @@
+package rotate
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/rotate/deploykeys"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Rotate the credentials of your GitOps repositories",
+		Example: `
+# Rotate the deploy keys of all the repositories
+gitops rotate deploy-keys`,
+	}
+
+	cmd.AddCommand(deploykeys.Cmd)
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package deploykeys
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+)
+
+var params struct {
+	Repos   []string
+	Timeout time.Duration
+}
+
+var Cmd = &cobra.Command{
+	Use:   "deploy-keys [--repo <url>]",
+	Short: "Replace the deploy keys of repositories with new ones",
+	Long: `Generates a new deploy key for each repository, uploads it to the git provider and stores it
+in the secret of the repository. The old key is deleted from the git provider once the sources
+using the secret reconciled with the new key, and kept if they fail to.`,
+	Example: `
+  # Rotate the deploy keys of all the repositories of the sources in the namespace
+  gitops rotate deploy-keys
+
+  # Rotate the deploy key of the podinfo repository
+  gitops rotate deploy-keys --repo git@github.com:myorg/podinfo`,
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().StringSliceVar(&params.Repos, "repo", nil, "URL of a repository whose deploy key is rotated; can be repeated, defaults to all the repositories with a deploy key")
+	Cmd.Flags().DurationVar(&params.Timeout, "timeout", app.DefaultWaitTimeout, "How long to wait for each source to reconcile with the new deploy key")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	namespace, _ := cmd.Parent().Flags().GetString("namespace")
+
+	log := internal.NewCLILogger(os.Stdout)
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
+	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+
+	_, rawClient, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	repos := []gitproviders.RepoURL{}
+
+	for _, r := range params.Repos {
+		repo, err := gitproviders.NewRepoURL(r)
+		if err != nil {
+			return err
+		}
+
+		repos = append(repos, repo)
+	}
+
+	if len(repos) == 0 {
+		if repos, err = auth.DeployKeyRepositories(ctx, rawClient, namespace); err != nil {
+			return err
+		}
+
+		if len(repos) == 0 {
+			log.Println("No repositories with a deploy key found in namespace %s", namespace)
+			return nil
+		}
+	}
+
+	for _, repo := range repos {
+		gitProvider, err := providerClient.GetProvider(repo, gitproviders.GetAccountType)
+		if err != nil {
+			return fmt.Errorf("failed to get the git provider of %s: %w", repo, err)
+		}
+
+		authService, err := auth.NewAuthService(fluxClient, rawClient, gitProvider, log)
+		if err != nil {
+			return err
+		}
+
+		if err := authService.RotateDeployKey(ctx, namespace, repo, params.Timeout); err != nil {
+			return fmt.Errorf("failed to rotate the deploy key of %s: %w", repo, err)
+		}
+	}
+
+	return nil
+}
@@ -39,7 +39,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
 	params.Name = args[0]
 
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 	factory := services.NewFactory(fluxClient, internal.NewCLILogger(os.Stdout))
 
 	kubeClient, _, err := kube.NewKubeHTTPClient()
@@ -20,15 +20,26 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/cache"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/server"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	servicesauth "github.com/weaveworks/weave-gitops/pkg/services/auth"
 )
 
+// gitCredentialsRefreshInterval is how often the GitHub App installation tokens used by flux are checked.
+// They expire after an hour and are refreshed in the 10 minutes before.
+const gitCredentialsRefreshInterval = 5 * time.Minute
+
 // Options contains all the options for the `ui run` command.
 type Options struct {
 	Port                          string
@@ -41,7 +52,14 @@ type Options struct {
 	Path                          string
 	LoggingEnabled                bool
 	OIDC                          OIDCAuthenticationOptions
+	StaticUser                    StaticUserAuthenticationOptions
+	ServiceAccountTokenLogin      bool
+	ServiceAccountTokenAudiences  []string
+	ServiceAccountTokenGroups     []string
 	NotificationControllerAddress string
+	ClusterSecretsNamespace       string
+	ClusterSecretsSelector        string
+	MetricsBindAddress            string
 }
 
 // OIDCAuthenticationOptions contains the OIDC authentication options for the
@@ -52,6 +70,18 @@ type OIDCAuthenticationOptions struct {
 	ClientSecret   string
 	RedirectURL    string
 	CookieDuration time.Duration
+	UsernameClaim  string
+	GroupsClaim    string
+	UsernamePrefix string
+	GroupsPrefix   string
+}
+
+// StaticUserAuthenticationOptions contains the options of the static user
+// login for the `ui run` command.
+type StaticUserAuthenticationOptions struct {
+	Enabled         bool
+	SecretName      string
+	SecretNamespace string
 }
 
 var options Options
@@ -75,8 +105,11 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.ProfileCacheLocation, "profile-cache-location", "/tmp/helm-cache", "the location where the cache Profile data lives")
 	cmd.Flags().StringVar(&options.WatcherHealthzBindAddress, "watcher-healthz-bind-address", ":9981", "bind address for the healthz service of the watcher")
 	cmd.Flags().StringVar(&options.WatcherMetricsBindAddress, "watcher-metrics-bind-address", ":9980", "bind address for the metrics service of the watcher")
+	cmd.Flags().StringVar(&options.MetricsBindAddress, "metrics-bind-address", "", "bind address for the Prometheus metrics of the API, e.g. ':9982'. Metrics are not served when empty")
 	cmd.Flags().StringVar(&options.NotificationControllerAddress, "notification-controller-address", "http://notification-controller./", "the address of the notification-controller running in the cluster")
 	cmd.Flags().IntVar(&options.WatcherPort, "watcher-port", 9443, "the port on which the watcher is running")
+	cmd.Flags().StringVar(&options.ClusterSecretsNamespace, "cluster-secrets-namespace", "", "the namespace of the kubeconfig Secrets of other clusters to show applications from, e.g. the ones created by Cluster API. In a cluster, it must be the namespace the server runs in")
+	cmd.Flags().StringVar(&options.ClusterSecretsSelector, "cluster-secrets-selector", server.ClusterNameLabel, "the label selector of the kubeconfig Secrets of other clusters")
 
 	if server.AuthEnabled() {
 		cmd.Flags().StringVar(&options.OIDC.IssuerURL, "oidc-issuer-url", "", "The URL of the OpenID Connect issuer")
@@ -84,6 +117,16 @@ func NewCommand() *cobra.Command {
 		cmd.Flags().StringVar(&options.OIDC.ClientSecret, "oidc-client-secret", "", "The client secret to use with OpenID Connect issuer")
 		cmd.Flags().StringVar(&options.OIDC.RedirectURL, "oidc-redirect-url", "", "The OAuth2 redirect URL")
 		cmd.Flags().DurationVar(&options.OIDC.CookieDuration, "oidc-cookie-duration", time.Hour, "The duration of the ID token cookie. It should be set in the format: number + time unit (s,m,h) e.g., 20m")
+		cmd.Flags().StringVar(&options.OIDC.UsernameClaim, "oidc-username-claim", auth.DefaultUsernameClaim, "The ID token claim used as the username of the user impersonated on requests to Kubernetes")
+		cmd.Flags().StringVar(&options.OIDC.GroupsClaim, "oidc-groups-claim", auth.DefaultGroupsClaim, "The ID token claim used as the groups of the user impersonated on requests to Kubernetes")
+		cmd.Flags().StringVar(&options.OIDC.UsernamePrefix, "oidc-username-prefix", "", "The prefix added to the username of the impersonated user, e.g. 'oidc:'. Set it to the --oidc-username-prefix of the Kubernetes API server to reuse its RBAC bindings")
+		cmd.Flags().StringVar(&options.OIDC.GroupsPrefix, "oidc-groups-prefix", "", "The prefix added to the groups of the impersonated user, e.g. 'oidc:'. Set it to the --oidc-groups-prefix of the Kubernetes API server to reuse its RBAC bindings")
+		cmd.Flags().BoolVar(&options.StaticUser.Enabled, "static-user-login", false, "Enable the login of a static user, e.g. an admin, whose username and bcrypt-hashed password are stored in a Secret")
+		cmd.Flags().StringVar(&options.StaticUser.SecretName, "static-user-secret-name", auth.DefaultStaticUserSecretName, "The name of the Secret with the 'username' and 'password' (bcrypt hash) of the static user")
+		cmd.Flags().StringVar(&options.StaticUser.SecretNamespace, "static-user-secret-namespace", wego.DefaultNamespace, "The namespace of the Secret of the static user")
+		cmd.Flags().BoolVar(&options.ServiceAccountTokenLogin, "service-account-token-login", false, "Accept Kubernetes ServiceAccount tokens as bearer tokens, validated with a TokenReview")
+		cmd.Flags().StringSliceVar(&options.ServiceAccountTokenAudiences, "service-account-token-audiences", nil, "The audiences the ServiceAccount tokens must be issued for, defaults to the audiences of the Kubernetes API server")
+		cmd.Flags().StringSliceVar(&options.ServiceAccountTokenGroups, "service-account-token-groups", []string{auth.ServiceAccountsGroup}, "The groups allowed to log in with a ServiceAccount token, e.g. 'system:serviceaccounts:ci'")
 	}
 
 	return cmd
@@ -91,6 +134,12 @@ func NewCommand() *cobra.Command {
 
 func preRunCmd(cmd *cobra.Command, args []string) error {
 	if server.AuthEnabled() {
+		// The OIDC issuer is optional when another login is enabled,
+		// e.g. in air-gapped clusters without an identity provider.
+		if options.OIDC.IssuerURL == "" && (options.StaticUser.Enabled || options.ServiceAccountTokenLogin) {
+			return nil
+		}
+
 		if options.OIDC.IssuerURL == "" {
 			return cmderrors.ErrNoIssuerURL
 		}
@@ -142,7 +191,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not create client config: %w", err)
 	}
 
-	_, rawClient, err := kube.NewKubeHTTPClientWithConfig(rest, clusterName)
+	_, rawClient, err := kube.NewKubeHTTPClientWithConfig(rest, clusterName, authenticationv1.AddToScheme)
 	if err != nil {
 		return fmt.Errorf("could not create kube http client: %w", err)
 	}
@@ -171,11 +220,37 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	githubApps := servicesauth.GitHubAppTokenSourcesFromConfig()
+
+	githubApp, err := githubApps("github.com")
+	if err != nil {
+		return fmt.Errorf("failed to configure the GitHub App: %w", err)
+	}
+
+	if githubApp != nil {
+		go refreshGitCredentials(log, rawClient, githubApps)
+	}
+
 	profilesConfig := server.NewProfilesConfig(kube.ClusterConfig{
 		DefaultConfig: rest,
 		ClusterName:   clusterName,
 	}, profileCache, options.HelmRepoNamespace, options.HelmRepoName)
 
+	var appOptions []server.ApplicationsOption
+
+	if options.ClusterSecretsNamespace != "" {
+		if err := server.ValidateClusterSecretsNamespace(options.ClusterSecretsNamespace); err != nil {
+			return err
+		}
+
+		selector, err := labels.Parse(options.ClusterSecretsSelector)
+		if err != nil {
+			return fmt.Errorf("invalid cluster secrets selector: %w", err)
+		}
+
+		appOptions = append(appOptions, server.WithClustersGetter(server.NewSecretsClustersGetter(rawClient, options.ClusterSecretsNamespace, selector)))
+	}
+
 	var authServer *auth.AuthServer
 
 	if server.AuthEnabled() {
@@ -201,11 +276,28 @@ func runCmd(cmd *cobra.Command, args []string) error {
 					ClientID:     options.OIDC.ClientID,
 					ClientSecret: options.OIDC.ClientSecret,
 					RedirectURL:  options.OIDC.RedirectURL,
+					Claims: auth.ClaimsConfig{
+						UsernameClaim:  options.OIDC.UsernameClaim,
+						GroupsClaim:    options.OIDC.GroupsClaim,
+						UsernamePrefix: options.OIDC.UsernamePrefix,
+						GroupsPrefix:   options.OIDC.GroupsPrefix,
+					},
 				},
 				CookieConfig: auth.CookieConfig{
 					CookieDuration:     options.OIDC.CookieDuration,
 					IssueSecureCookies: oidcIssueSecureCookies,
 				},
+				StaticUser: auth.StaticUserConfig{
+					Enabled:         options.StaticUser.Enabled,
+					SecretName:      options.StaticUser.SecretName,
+					SecretNamespace: options.StaticUser.SecretNamespace,
+				},
+				TokenReview: auth.TokenReviewConfig{
+					Enabled:   options.ServiceAccountTokenLogin,
+					Audiences: options.ServiceAccountTokenAudiences,
+					Groups:    options.ServiceAccountTokenGroups,
+				},
+				KubernetesClient: rawClient,
 			},
 		)
 		if err != nil {
@@ -218,7 +310,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		authServer = srv
 	}
 
-	appAndProfilesHandlers, err := server.NewHandlers(context.Background(), &server.Config{AppConfig: appConfig, ProfilesConfig: profilesConfig, AuthServer: authServer})
+	appAndProfilesHandlers, err := server.NewHandlers(context.Background(), &server.Config{AppConfig: appConfig, AppOptions: appOptions, ProfilesConfig: profilesConfig, AuthServer: authServer})
 	if err != nil {
 		return fmt.Errorf("could not create handler: %w", err)
 	}
@@ -248,6 +340,19 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		Handler: mux,
 	}
 
+	if options.MetricsBindAddress != "" {
+		middleware.RegisterKubeClientMetrics()
+
+		go func() {
+			log.Infof("Serving metrics on %s", options.MetricsBindAddress)
+
+			if err := server.ListenAndServeMetrics(options.MetricsBindAddress); err != nil {
+				log.Error(err, "metrics server exited")
+				os.Exit(1)
+			}
+		}()
+	}
+
 	go func() {
 		log.Infof("Serving on port %s", options.Port)
 
@@ -285,6 +390,19 @@ func runCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// refreshGitCredentials keeps the GitHub App installation tokens of the repositories set up with the
+// github-app authentication valid in all namespaces, as flux cannot refresh them.
+func refreshGitCredentials(log logrus.FieldLogger, k8sClient client.Client, sources servicesauth.GitTokenSources) {
+	ticker := time.NewTicker(gitCredentialsRefreshInterval)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		if err := servicesauth.RefreshGitCredentials(context.Background(), k8sClient, metav1.NamespaceAll, sources); err != nil {
+			log.Errorf("failed to refresh the git credentials: %v", err)
+		}
+	}
+}
+
 //go:embed dist/*
 var static embed.FS
 
@@ -0,0 +1,85 @@
+package app
+
+// Provides support for making a rolled back application track its branch again.
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/cmd/internal"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	"github.com/weaveworks/weave-gitops/pkg/services"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var params app.UnpinParams
+
+var Cmd = &cobra.Command{
+	Use:   "app <app-name>",
+	Short: "Make a rolled back application track its branch again",
+	Example: `
+  # Unpin podinfo via a pull request
+  gitops unpin app podinfo
+
+  # Unpin podinfo via an immediate commit
+  gitops unpin app podinfo --auto-merge
+`,
+	Args:          cobra.MinimumNArgs(1),
+	RunE:          runCmd,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	PostRun: func(cmd *cobra.Command, args []string) {
+		version.CheckVersion(version.CheckpointParamsWithFlags(version.CheckpointParams(), cmd))
+	},
+}
+
+func init() {
+	Cmd.Flags().BoolVar(&params.AutoMerge, "auto-merge", false, "If set, 'gitops unpin app' will merge changes automatically to the config repository")
+}
+
+func runCmd(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	params.Name = args[0]
+	params.Namespace, _ = cmd.Parent().Flags().GetString("namespace")
+
+	log := internal.NewCLILogger(os.Stdout)
+	factory := services.NewFactory(flux.NewClient(osys.New(), &runner.CLIRunner{}), log)
+
+	kubeClient, _, err := kube.NewKubeHTTPClient()
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	appService, err := factory.GetAppService(ctx, kubeClient)
+	if err != nil {
+		return fmt.Errorf("failed to create app service: %w", err)
+	}
+
+	appContent, err := appService.Get(types.NamespacedName{Name: params.Name, Namespace: params.Namespace})
+	if err != nil {
+		return fmt.Errorf("unable to get application for %s %w", params.Name, err)
+	}
+
+	providerClient := internal.NewGitProviderClient(os.Stdout, os.LookupEnv, auth.NewAuthCLIHandler, log)
+
+	gitClient, gitProvider, err := factory.GetGitClients(ctx, kubeClient, providerClient, services.NewGitConfigParamsFromApp(appContent, false))
+	if err != nil {
+		return fmt.Errorf("failed to get git clients: %w", err)
+	}
+
+	if err := appService.Unpin(gitClient, gitProvider, params); err != nil {
+		return errors.Wrapf(err, "failed to unpin the app %s", params.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package unpin
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/unpin/app"
+)
+
+func GetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpin",
+		Short: "Unpin your GitOps automations",
+		Example: `
+# Make a rolled back application track its branch again
+gitops unpin app <app-name>`,
+	}
+
+	cmd.AddCommand(app.Cmd)
+
+	return cmd
+}
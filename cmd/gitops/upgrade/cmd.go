@@ -13,6 +13,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
 	"github.com/weaveworks/weave-gitops/pkg/services"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
 	"github.com/weaveworks/weave-gitops/pkg/upgrade"
 )
@@ -43,6 +44,8 @@ func init() {
 	Cmd.PersistentFlags().StringVar(&upgradeCmdFlags.CommitMessage, "commit-message", "Upgrade to WGE", "The commit message")
 	Cmd.PersistentFlags().StringArrayVar(&upgradeCmdFlags.Values, "set", []string{}, "set profile values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
 	Cmd.PersistentFlags().BoolVar(&upgradeCmdFlags.DryRun, "dry-run", false, "Output the generated profile without creating a pull request")
+	Cmd.PersistentFlags().BoolVar(&upgradeCmdFlags.Wait, "wait", false, "Wait for Weave GitOps Enterprise to be ready once the pull request is merged")
+	Cmd.PersistentFlags().DurationVar(&upgradeCmdFlags.Timeout, "timeout", app.DefaultWaitTimeout, "How long to wait for Weave GitOps Enterprise to be ready when --wait is set")
 
 	cobra.CheckErr(Cmd.MarkPersistentFlagRequired("version"))
 }
@@ -65,7 +68,7 @@ func upgradeCmdRunE() func(*cobra.Command, []string) error {
 		upgradeCmdFlags.Namespace = namespace
 
 		log := internal.NewCLILogger(os.Stdout)
-		fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+		fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 		factory := services.NewFactory(fluxClient, log)
 
 		wegoConfig, err := kubeClient.GetWegoConfig(ctx, namespace)
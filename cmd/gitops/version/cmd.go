@@ -77,7 +77,7 @@ func CheckpointParamsWithFlags(params *checkpoint.CheckParams, c *cobra.Command)
 }
 func CheckFluxVersion() (string, error) {
 	cliRunner := &runner.CLIRunner{}
-	fluxClient := flux.New(osys.New(), cliRunner)
+	fluxClient := flux.NewClient(osys.New(), cliRunner)
 
 	return fluxClient.GetVersion()
 }
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
+)
+
+// Output formats of the --diff flag
+const (
+	DiffFormatUnified = "unified"
+	DiffFormatJSON    = "json"
+)
+
+// ValidateDiffFormat returns an error for an unknown --diff format.
+func ValidateDiffFormat(format string) error {
+	switch format {
+	case "", DiffFormatUnified, DiffFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported diff format %q, must be one of: %s, %s", format, DiffFormatUnified, DiffFormatJSON)
+	}
+}
+
+// GetDiffClients returns the clients needed to render changes to the config repository. Unlike the
+// clients created by the services factory, they never upload deploy keys or create secrets: the
+// repository is cloned with the credentials of the user's SSH agent.
+func GetDiffClients(gpClient gitproviders.Client, configRepo string) (git.Git, gitproviders.GitProvider, error) {
+	configUrl, err := gitproviders.NewRepoURL(configRepo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error normalizing config url: %w", err)
+	}
+
+	gitProvider, err := gpClient.GetProvider(configUrl, gitproviders.GetAccountType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error obtaining git provider token: %w", err)
+	}
+
+	return git.New(nil, wrapper.NewGoGit()), gitProvider, nil
+}
+
+// PrintDiff writes the changes as a unified diff, or as JSON.
+func PrintDiff(w io.Writer, changes []gitopswriter.FileChange, format string) error {
+	if format == DiffFormatJSON {
+		out, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal changes: %w", err)
+		}
+
+		_, err = fmt.Fprintln(w, string(out))
+
+		return err
+	}
+
+	for _, change := range changes {
+		if _, err := fmt.Fprintf(w, "diff --git a/%s b/%s\n%s", change.Path, change.Path, change.Diff); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
+)
+
+var _ = Describe("PrintDiff", func() {
+	var changes []gitopswriter.FileChange
+
+	BeforeEach(func() {
+		changes = []gitopswriter.FileChange{
+			{Path: "apps/foo/app.yaml", Action: gitopswriter.FileCreated, Diff: "--- /dev/null\n+++ b/apps/foo/app.yaml\n@@ -0,0 +1 @@\n+kind: Application\n"},
+			{Path: "apps/bar/app.yaml", Action: gitopswriter.FileDeleted, Diff: "--- a/apps/bar/app.yaml\n+++ /dev/null\n@@ -1 +0,0 @@\n-kind: Application\n"},
+		}
+	})
+
+	It("prints a unified diff", func() {
+		out := &bytes.Buffer{}
+		Expect(PrintDiff(out, changes, DiffFormatUnified)).To(Succeed())
+
+		Expect(out.String()).To(Equal("diff --git a/apps/foo/app.yaml b/apps/foo/app.yaml\n" + changes[0].Diff +
+			"diff --git a/apps/bar/app.yaml b/apps/bar/app.yaml\n" + changes[1].Diff))
+	})
+
+	It("prints json", func() {
+		out := &bytes.Buffer{}
+		Expect(PrintDiff(out, changes, DiffFormatJSON)).To(Succeed())
+
+		printed := []gitopswriter.FileChange{}
+		Expect(json.Unmarshal(out.Bytes(), &printed)).To(Succeed())
+		Expect(printed).To(Equal(changes))
+	})
+
+	It("validates the format", func() {
+		Expect(ValidateDiffFormat(DiffFormatJSON)).To(Succeed())
+		Expect(ValidateDiffFormat("yaml")).To(MatchError(ContainSubstring(`unsupported diff format "yaml"`)))
+	})
+})
@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"github.com/spf13/cobra"
+	cliout "github.com/weaveworks/weave-gitops/pkg/output"
+)
+
+// AddOutputFlag adds the --output flag shared by the get commands.
+func AddOutputFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringP("output", "o", "", cliout.FlagUsage)
+}
+
+// GetOutputOptions parses the --output flag of cmd, or of one of its parents.
+func GetOutputOptions(cmd *cobra.Command) (cliout.Options, error) {
+	flag := cmd.Flag("output")
+	if flag == nil {
+		return cliout.Options{}, nil
+	}
+
+	return cliout.NewOptions(flag.Value.String())
+}
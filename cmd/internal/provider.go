@@ -17,6 +17,7 @@ type gitProviderClient struct {
 	stdout          *os.File
 	lookupEnvFunc   func(key string) (string, bool)
 	log             logger.Logger
+	tokens          map[gitproviders.GitProviderName]string
 }
 
 func NewGitProviderClient(stdout *os.File, lookupEnvFunc func(key string) (string, bool), authHandlerFunc GetAuthHandler, log logger.Logger) gitproviders.Client {
@@ -25,13 +26,14 @@ func NewGitProviderClient(stdout *os.File, lookupEnvFunc func(key string) (strin
 		lookupEnvFunc:   lookupEnvFunc,
 		authHandlerFunc: authHandlerFunc,
 		log:             log,
+		tokens:          map[gitproviders.GitProviderName]string{},
 	}
 }
 
 // GetProvider returns a GitProvider containing either the token stored in the <git provider>_TOKEN env var
 // or a token retrieved via the CLI auth flow
 func (c *gitProviderClient) GetProvider(repoUrl gitproviders.RepoURL, getAccountType gitproviders.AccountTypeGetter) (gitproviders.GitProvider, error) {
-	token, err := GetToken(repoUrl, c.stdout, c.lookupEnvFunc, c.authHandlerFunc, c.log)
+	token, err := c.GetToken(repoUrl)
 	if err != nil {
 		return nil, err
 	}
@@ -48,12 +50,35 @@ func (c *gitProviderClient) GetProvider(repoUrl gitproviders.RepoURL, getAccount
 	return provider, nil
 }
 
+// GetToken returns the token of the git provider of the repository. It is cached so the CLI auth flow
+// runs at most once per git provider.
+func (c *gitProviderClient) GetToken(repoUrl gitproviders.RepoURL) (string, error) {
+	if token, ok := c.tokens[repoUrl.Provider()]; ok {
+		return token, nil
+	}
+
+	token, err := GetToken(repoUrl, c.stdout, c.lookupEnvFunc, c.authHandlerFunc, c.log)
+	if err != nil {
+		return "", err
+	}
+
+	c.tokens[repoUrl.Provider()] = token
+
+	return token, nil
+}
+
 func getTokenVarName(providerName gitproviders.GitProviderName) (string, error) {
 	switch providerName {
 	case gitproviders.GitProviderGitHub:
 		return "GITHUB_TOKEN", nil
 	case gitproviders.GitProviderGitLab:
 		return "GITLAB_TOKEN", nil
+	case gitproviders.GitProviderBitbucketServer:
+		return "BITBUCKET_SERVER_TOKEN", nil
+	case gitproviders.GitProviderGitea:
+		return "GITEA_TOKEN", nil
+	case gitproviders.GitProviderGit:
+		return "GIT_TOKEN", nil
 	default:
 		return "", fmt.Errorf("unknown git provider: %q", providerName)
 	}
@@ -68,6 +93,11 @@ func GetToken(repoUrl gitproviders.RepoURL, w io.Writer, lookupEnvFunc func(key
 	}
 
 	token, exists := lookupEnvFunc(tokenVarName)
+	if !exists && repoUrl.Provider() == gitproviders.GitProviderGit {
+		// Repositories without an API only need a token to be accessed over https
+		return "", nil
+	}
+
 	if !exists {
 		log.Warningf(envVariableWarning, tokenVarName)
 
@@ -29,6 +29,12 @@ metadata:
   namespace: my-namespace`))
 			Expect(manifests).To(ContainSubstring("latest"))
 
+			By("reading the GitHub App from its optional Secret")
+			Expect(manifests).To(ContainSubstring(`
+          secret:
+            secretName: weave-gitops-github-app
+            optional: true`))
+
 			By("containing a Service manifest")
 			Expect(manifests).To(ContainSubstring(`
 kind: Service
@@ -66,6 +72,46 @@ metadata:
 kind: ClusterRoleBinding
 metadata:
   name: wego-helm-watcher-rolebinding`))
+
+			By("containing the application controller Cluster Role manifests")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRole
+metadata:
+  name: wego-app-controller-role`))
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-controller-rolebinding`))
+
+			By("containing the impersonation Cluster Role manifests")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRole
+metadata:
+  name: wego-app-impersonation-role`))
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-impersonation-rolebinding`))
+
+			By("containing the token review Cluster Role manifests")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRole
+metadata:
+  name: wego-app-token-review-role`))
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-token-review-rolebinding`))
+
+			By("containing the git credentials Cluster Role manifests")
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRole
+metadata:
+  name: wego-app-git-credentials-role`))
+			Expect(manifests).To(ContainSubstring(`
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-git-credentials-rolebinding`))
 		})
 	})
 })
@@ -19,6 +19,30 @@ spec:
             - containerPort: 9001
               protocol: TCP
           imagePullPolicy: IfNotPresent
+          env:
+            - name: GITOPS_GITHUB_APP_ID
+              valueFrom:
+                secretKeyRef:
+                  name: weave-gitops-github-app
+                  key: app-id
+                  optional: true
+            - name: GITOPS_GITHUB_APP_INSTALLATION_ID
+              valueFrom:
+                secretKeyRef:
+                  name: weave-gitops-github-app
+                  key: installation-id
+                  optional: true
+            - name: GITOPS_GITHUB_APP_PRIVATE_KEY_FILE
+              value: /etc/weave-gitops/github-app/private-key
+          volumeMounts:
+            - name: github-app
+              mountPath: /etc/weave-gitops/github-app
+              readOnly: true
+      volumes:
+        - name: github-app
+          secret:
+            secretName: weave-gitops-github-app
+            optional: true
   selector:
     matchLabels:
       app: wego-app
@@ -0,0 +1,12 @@
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: wego-app-git-credentials-rolebinding
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: wego-app-git-credentials-role
+subjects:
+  - kind: ServiceAccount
+    name: wego-app-service-account
+    namespace: {{ .Namespace }}
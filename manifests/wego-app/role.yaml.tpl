@@ -6,7 +6,7 @@ metadata:
 rules:
   - apiGroups: [""]
     resources: ["secrets"]
-    verbs: ["get","create"]
+    verbs: ["get","list","create","update"]
   - apiGroups: ["wego.weave.works"]
     resources: [ "apps" ]
     verbs: [ "*" ]
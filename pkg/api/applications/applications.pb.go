@@ -72,9 +72,11 @@ func (AutomationKind) EnumDescriptor() ([]byte, []int) {
 type GitProvider int32
 
 const (
-	GitProvider_Unknown GitProvider = 0
-	GitProvider_GitHub  GitProvider = 1
-	GitProvider_GitLab  GitProvider = 2
+	GitProvider_Unknown         GitProvider = 0
+	GitProvider_GitHub          GitProvider = 1
+	GitProvider_GitLab          GitProvider = 2
+	GitProvider_BitbucketServer GitProvider = 3
+	GitProvider_Gitea           GitProvider = 4
 )
 
 // Enum value maps for GitProvider.
@@ -83,11 +85,15 @@ var (
 		0: "Unknown",
 		1: "GitHub",
 		2: "GitLab",
+		3: "BitbucketServer",
+		4: "Gitea",
 	}
 	GitProvider_value = map[string]int32{
-		"Unknown": 0,
-		"GitHub":  1,
-		"GitLab":  2,
+		"Unknown":         0,
+		"GitHub":          1,
+		"GitLab":          2,
+		"BitbucketServer": 3,
+		"Gitea":           4,
 	}
 )
 
@@ -121,8 +127,10 @@ func (GitProvider) EnumDescriptor() ([]byte, []int) {
 type Source_Type int32
 
 const (
-	Source_Git  Source_Type = 0
-	Source_Helm Source_Type = 1
+	Source_Git    Source_Type = 0
+	Source_Helm   Source_Type = 1
+	Source_OCI    Source_Type = 2
+	Source_Bucket Source_Type = 3
 )
 
 // Enum value maps for Source_Type.
@@ -130,10 +138,14 @@ var (
 	Source_Type_name = map[int32]string{
 		0: "Git",
 		1: "Helm",
+		2: "OCI",
+		3: "Bucket",
 	}
 	Source_Type_value = map[string]int32{
-		"Git":  0,
-		"Helm": 1,
+		"Git":    0,
+		"Helm":   1,
+		"OCI":    2,
+		"Bucket": 3,
 	}
 )
 
@@ -161,7 +173,7 @@ func (x Source_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Source_Type.Descriptor instead.
 func (Source_Type) EnumDescriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{5, 0}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{6, 0}
 }
 
 // This object represents a single condition for a Kubernetes object.
@@ -261,6 +273,7 @@ type Application struct {
 	Kustomization         *Kustomization      `protobuf:"bytes,9,opt,name=kustomization,proto3" json:"kustomization,omitempty"`                                                             // Kustomization associated to the application
 	HelmRelease           *HelmRelease        `protobuf:"bytes,10,opt,name=helm_release,json=helmRelease,proto3" json:"helm_release,omitempty"`                                             // HelmRelease associated to the application
 	Source                *Source             `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`                                                                          // Source associated to the application
+	ClusterName           string              `protobuf:"bytes,12,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`                                             // The name of the cluster the application is in
 }
 
 func (x *Application) Reset() {
@@ -372,6 +385,13 @@ func (x *Application) GetSource() *Source {
 	return nil
 }
 
+func (x *Application) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
 type Kustomization struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -480,13 +500,15 @@ type HelmRelease struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // The name of the Helm Release
-	Namespace           string       `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // The namespace of the Helm Release
-	TargetNamespace     string       `protobuf:"bytes,3,opt,name=targetNamespace,proto3" json:"targetNamespace,omitempty"`         // Namepsace to target when performing operations for the HelmRelease
-	Chart               *HelmChart   `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`                             // Chart defines the template of the Chart that should be created for this HelmRelease.
-	Interval            string       `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`                       // Interval at which to reconcile the Helm release.
-	LastAppliedRevision string       `protobuf:"bytes,6,opt,name=lastAppliedRevision,proto3" json:"lastAppliedRevision,omitempty"` // The last successfully applied revision.
-	Conditions          []*Condition `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`                   // A list of conditions for this Helm Release
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // The name of the Helm Release
+	Namespace           string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // The namespace of the Helm Release
+	TargetNamespace     string                 `protobuf:"bytes,3,opt,name=targetNamespace,proto3" json:"targetNamespace,omitempty"`         // Namepsace to target when performing operations for the HelmRelease
+	Chart               *HelmChart             `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`                             // Chart defines the template of the Chart that should be created for this HelmRelease.
+	Interval            string                 `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`                       // Interval at which to reconcile the Helm release.
+	LastAppliedRevision string                 `protobuf:"bytes,6,opt,name=lastAppliedRevision,proto3" json:"lastAppliedRevision,omitempty"` // The last successfully applied revision.
+	Conditions          []*Condition           `protobuf:"bytes,7,rep,name=conditions,proto3" json:"conditions,omitempty"`                   // A list of conditions for this Helm Release
+	Values              string                 `protobuf:"bytes,8,opt,name=values,proto3" json:"values,omitempty"`                           // The inline values of the Helm Release, as YAML
+	ValuesFrom          []*HelmValuesReference `protobuf:"bytes,9,rep,name=valuesFrom,proto3" json:"valuesFrom,omitempty"`                   // ConfigMaps and Secrets holding values of the Helm Release
 }
 
 func (x *HelmRelease) Reset() {
@@ -570,6 +592,99 @@ func (x *HelmRelease) GetConditions() []*Condition {
 	return nil
 }
 
+func (x *HelmRelease) GetValues() string {
+	if x != nil {
+		return x.Values
+	}
+	return ""
+}
+
+func (x *HelmRelease) GetValuesFrom() []*HelmValuesReference {
+	if x != nil {
+		return x.ValuesFrom
+	}
+	return nil
+}
+
+type HelmValuesReference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`             // ConfigMap or Secret
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`             // The name of the ConfigMap or Secret
+	ValuesKey  string `protobuf:"bytes,3,opt,name=valuesKey,proto3" json:"valuesKey,omitempty"`   // The key holding the values. Defaults to values.yaml
+	TargetPath string `protobuf:"bytes,4,opt,name=targetPath,proto3" json:"targetPath,omitempty"` // The path the values are merged at. Defaults to the root of the values
+	Optional   bool   `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`    // Whether the reference may be missing
+}
+
+func (x *HelmValuesReference) Reset() {
+	*x = HelmValuesReference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelmValuesReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelmValuesReference) ProtoMessage() {}
+
+func (x *HelmValuesReference) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelmValuesReference.ProtoReflect.Descriptor instead.
+func (*HelmValuesReference) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HelmValuesReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *HelmValuesReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HelmValuesReference) GetValuesKey() string {
+	if x != nil {
+		return x.ValuesKey
+	}
+	return ""
+}
+
+func (x *HelmValuesReference) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *HelmValuesReference) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
 type HelmChart struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -583,7 +698,7 @@ type HelmChart struct {
 func (x *HelmChart) Reset() {
 	*x = HelmChart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[4]
+		mi := &file_api_applications_applications_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -596,7 +711,7 @@ func (x *HelmChart) String() string {
 func (*HelmChart) ProtoMessage() {}
 
 func (x *HelmChart) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[4]
+	mi := &file_api_applications_applications_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -609,7 +724,7 @@ func (x *HelmChart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HelmChart.ProtoReflect.Descriptor instead.
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{4}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HelmChart) GetChart() string {
@@ -639,11 +754,11 @@ type Source struct {
 	unknownFields protoimpl.UnknownFields
 
 	Name       string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // The name of the Source
-	Url        string       `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                    // Git or Helm repository URL
+	Url        string       `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                    // Git, Helm or OCI repository, or bucket URL
 	Type       Source_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=wego_server.v1.Source_Type" json:"type,omitempty"` // Source Type
 	Namespace  string       `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // The namespace of the Source
 	Interval   string       `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`                          // The interval at which to check the upstream for updates
-	Reference  string       `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`                        // Git branch or tag
+	Reference  string       `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`                        // Git branch or tag, or OCI repository tag
 	Suspend    bool         `protobuf:"varint,7,opt,name=suspend,proto3" json:"suspend,omitempty"`                           // This flag tells the controller to suspend the reconciliation of this source
 	Timeout    string       `protobuf:"bytes,8,opt,name=timeout,proto3" json:"timeout,omitempty"`                            // The timeout of index downloading.
 	Conditions []*Condition `protobuf:"bytes,9,rep,name=conditions,proto3" json:"conditions,omitempty"`                      // A list of conditions for this Source
@@ -652,7 +767,7 @@ type Source struct {
 func (x *Source) Reset() {
 	*x = Source{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[5]
+		mi := &file_api_applications_applications_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -665,7 +780,7 @@ func (x *Source) String() string {
 func (*Source) ProtoMessage() {}
 
 func (x *Source) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[5]
+	mi := &file_api_applications_applications_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +793,7 @@ func (x *Source) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Source.ProtoReflect.Descriptor instead.
 func (*Source) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{5}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Source) GetName() string {
@@ -756,7 +871,7 @@ type AuthenticateRequest struct {
 func (x *AuthenticateRequest) Reset() {
 	*x = AuthenticateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[6]
+		mi := &file_api_applications_applications_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -769,7 +884,7 @@ func (x *AuthenticateRequest) String() string {
 func (*AuthenticateRequest) ProtoMessage() {}
 
 func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[6]
+	mi := &file_api_applications_applications_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +897,7 @@ func (x *AuthenticateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateRequest.ProtoReflect.Descriptor instead.
 func (*AuthenticateRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{6}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AuthenticateRequest) GetProviderName() string {
@@ -810,7 +925,7 @@ type AuthenticateResponse struct {
 func (x *AuthenticateResponse) Reset() {
 	*x = AuthenticateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[7]
+		mi := &file_api_applications_applications_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -823,7 +938,7 @@ func (x *AuthenticateResponse) String() string {
 func (*AuthenticateResponse) ProtoMessage() {}
 
 func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[7]
+	mi := &file_api_applications_applications_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +951,7 @@ func (x *AuthenticateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticateResponse.ProtoReflect.Descriptor instead.
 func (*AuthenticateResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{7}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AuthenticateResponse) GetToken() string {
@@ -851,13 +966,15 @@ type ListApplicationsRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace to look for applications
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                         // The namespace to look for applications
+	ClusterName string `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`  // The cluster to look for applications. Defaults to the cluster the server runs in
+	AllClusters bool   `protobuf:"varint,3,opt,name=all_clusters,json=allClusters,proto3" json:"all_clusters,omitempty"` // Look for applications in every cluster known to the server
 }
 
 func (x *ListApplicationsRequest) Reset() {
 	*x = ListApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[8]
+		mi := &file_api_applications_applications_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -870,7 +987,7 @@ func (x *ListApplicationsRequest) String() string {
 func (*ListApplicationsRequest) ProtoMessage() {}
 
 func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[8]
+	mi := &file_api_applications_applications_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -883,7 +1000,7 @@ func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
 func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{8}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListApplicationsRequest) GetNamespace() string {
@@ -893,18 +1010,33 @@ func (x *ListApplicationsRequest) GetNamespace() string {
 	return ""
 }
 
+func (x *ListApplicationsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ListApplicationsRequest) GetAllClusters() bool {
+	if x != nil {
+		return x.AllClusters
+	}
+	return false
+}
+
 type ListApplicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Applications []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"` // A list of applications
+	Applications []*Application  `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"` // A list of applications
+	Errors       []*ClusterError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`             // The clusters applications could not be listed from
 }
 
 func (x *ListApplicationsResponse) Reset() {
 	*x = ListApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[9]
+		mi := &file_api_applications_applications_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -917,7 +1049,7 @@ func (x *ListApplicationsResponse) String() string {
 func (*ListApplicationsResponse) ProtoMessage() {}
 
 func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[9]
+	mi := &file_api_applications_applications_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1062,7 @@ func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
 func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{9}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListApplicationsResponse) GetApplications() []*Application {
@@ -940,19 +1072,83 @@ func (x *ListApplicationsResponse) GetApplications() []*Application {
 	return nil
 }
 
+func (x *ListApplicationsResponse) GetErrors() []*ClusterError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// ClusterError describes why a cluster could not be queried
+type ClusterError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"` // The name of the cluster
+	Message     string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                            // The error returned by the cluster
+}
+
+func (x *ClusterError) Reset() {
+	*x = ClusterError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterError) ProtoMessage() {}
+
+func (x *ClusterError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterError.ProtoReflect.Descriptor instead.
+func (*ClusterError) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ClusterError) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type GetApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The name of an application
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // The kubernetes namespace of the application.`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // The name of an application
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                        // The kubernetes namespace of the application.`
+	ClusterName string `protobuf:"bytes,3,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"` // The cluster of the application. Defaults to the cluster the server runs in
 }
 
 func (x *GetApplicationRequest) Reset() {
 	*x = GetApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[10]
+		mi := &file_api_applications_applications_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -965,7 +1161,7 @@ func (x *GetApplicationRequest) String() string {
 func (*GetApplicationRequest) ProtoMessage() {}
 
 func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[10]
+	mi := &file_api_applications_applications_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +1174,7 @@ func (x *GetApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationRequest.ProtoReflect.Descriptor instead.
 func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{10}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetApplicationRequest) GetName() string {
@@ -995,18 +1191,83 @@ func (x *GetApplicationRequest) GetNamespace() string {
 	return ""
 }
 
+func (x *GetApplicationRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+// ApplicationHealth is the health of an application, aggregated from the kstatus of its Source, its
+// Kustomization or HelmRelease and the objects they reconciled
+type ApplicationHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`   // The least healthy status of the objects: Failed, Terminating, InProgress or Current
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Explains the status, naming the object it comes from
+}
+
+func (x *ApplicationHealth) Reset() {
+	*x = ApplicationHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplicationHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationHealth) ProtoMessage() {}
+
+func (x *ApplicationHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationHealth.ProtoReflect.Descriptor instead.
+func (*ApplicationHealth) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApplicationHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApplicationHealth) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type GetApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Application *Application `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Application *Application       `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Health      *ApplicationHealth `protobuf:"bytes,2,opt,name=health,proto3" json:"health,omitempty"`
 }
 
 func (x *GetApplicationResponse) Reset() {
 	*x = GetApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[11]
+		mi := &file_api_applications_applications_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1280,7 @@ func (x *GetApplicationResponse) String() string {
 func (*GetApplicationResponse) ProtoMessage() {}
 
 func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[11]
+	mi := &file_api_applications_applications_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1293,7 @@ func (x *GetApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetApplicationResponse.ProtoReflect.Descriptor instead.
 func (*GetApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{11}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetApplicationResponse) GetApplication() *Application {
@@ -1042,6 +1303,13 @@ func (x *GetApplicationResponse) GetApplication() *Application {
 	return nil
 }
 
+func (x *GetApplicationResponse) GetHealth() *ApplicationHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
 type AddApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1059,7 +1327,7 @@ type AddApplicationRequest struct {
 func (x *AddApplicationRequest) Reset() {
 	*x = AddApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[12]
+		mi := &file_api_applications_applications_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1072,7 +1340,7 @@ func (x *AddApplicationRequest) String() string {
 func (*AddApplicationRequest) ProtoMessage() {}
 
 func (x *AddApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[12]
+	mi := &file_api_applications_applications_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1353,7 @@ func (x *AddApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddApplicationRequest.ProtoReflect.Descriptor instead.
 func (*AddApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{12}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AddApplicationRequest) GetName() string {
@@ -1149,7 +1417,7 @@ type AddApplicationResponse struct {
 func (x *AddApplicationResponse) Reset() {
 	*x = AddApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[13]
+		mi := &file_api_applications_applications_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1162,7 +1430,7 @@ func (x *AddApplicationResponse) String() string {
 func (*AddApplicationResponse) ProtoMessage() {}
 
 func (x *AddApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[13]
+	mi := &file_api_applications_applications_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1443,7 @@ func (x *AddApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddApplicationResponse.ProtoReflect.Descriptor instead.
 func (*AddApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{13}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AddApplicationResponse) GetSuccess() bool {
@@ -1205,7 +1473,7 @@ type RemoveApplicationRequest struct {
 func (x *RemoveApplicationRequest) Reset() {
 	*x = RemoveApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[14]
+		mi := &file_api_applications_applications_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1218,7 +1486,7 @@ func (x *RemoveApplicationRequest) String() string {
 func (*RemoveApplicationRequest) ProtoMessage() {}
 
 func (x *RemoveApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[14]
+	mi := &file_api_applications_applications_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1499,7 @@ func (x *RemoveApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveApplicationRequest.ProtoReflect.Descriptor instead.
 func (*RemoveApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{14}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RemoveApplicationRequest) GetName() string {
@@ -1267,7 +1535,7 @@ type RemoveApplicationResponse struct {
 func (x *RemoveApplicationResponse) Reset() {
 	*x = RemoveApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[15]
+		mi := &file_api_applications_applications_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1280,7 +1548,7 @@ func (x *RemoveApplicationResponse) String() string {
 func (*RemoveApplicationResponse) ProtoMessage() {}
 
 func (x *RemoveApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[15]
+	mi := &file_api_applications_applications_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1561,7 @@ func (x *RemoveApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveApplicationResponse.ProtoReflect.Descriptor instead.
 func (*RemoveApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{15}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RemoveApplicationResponse) GetSuccess() bool {
@@ -1322,7 +1590,7 @@ type SyncApplicationRequest struct {
 func (x *SyncApplicationRequest) Reset() {
 	*x = SyncApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[16]
+		mi := &file_api_applications_applications_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1335,7 +1603,7 @@ func (x *SyncApplicationRequest) String() string {
 func (*SyncApplicationRequest) ProtoMessage() {}
 
 func (x *SyncApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[16]
+	mi := &file_api_applications_applications_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +1616,7 @@ func (x *SyncApplicationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncApplicationRequest.ProtoReflect.Descriptor instead.
 func (*SyncApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{16}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SyncApplicationRequest) GetName() string {
@@ -1376,7 +1644,7 @@ type SyncApplicationResponse struct {
 func (x *SyncApplicationResponse) Reset() {
 	*x = SyncApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[17]
+		mi := &file_api_applications_applications_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1389,7 +1657,7 @@ func (x *SyncApplicationResponse) String() string {
 func (*SyncApplicationResponse) ProtoMessage() {}
 
 func (x *SyncApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[17]
+	mi := &file_api_applications_applications_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1402,7 +1670,7 @@ func (x *SyncApplicationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncApplicationResponse.ProtoReflect.Descriptor instead.
 func (*SyncApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{17}
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SyncApplicationResponse) GetSuccess() bool {
@@ -1412,35 +1680,33 @@ func (x *SyncApplicationResponse) GetSuccess() bool {
 	return false
 }
 
-type Commit struct {
+type SuspendApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`       // The hash of the commit
-	Date    string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`       // The date the commit was made.
-	Author  string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`   // The author of the commit
-	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // The commit message
-	Url     string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`         // The commit url
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClusterName string `protobuf:"bytes,3,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *Commit) Reset() {
-	*x = Commit{}
+func (x *SuspendApplicationRequest) Reset() {
+	*x = SuspendApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[18]
+		mi := &file_api_applications_applications_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Commit) String() string {
+func (x *SuspendApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Commit) ProtoMessage() {}
+func (*SuspendApplicationRequest) ProtoMessage() {}
 
-func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[18]
+func (x *SuspendApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,76 +1717,58 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Commit.ProtoReflect.Descriptor instead.
-func (*Commit) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *Commit) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
-}
-
-func (x *Commit) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use SuspendApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SuspendApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Commit) GetAuthor() string {
+func (x *SuspendApplicationRequest) GetName() string {
 	if x != nil {
-		return x.Author
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Commit) GetMessage() string {
+func (x *SuspendApplicationRequest) GetNamespace() string {
 	if x != nil {
-		return x.Message
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *Commit) GetUrl() string {
+func (x *SuspendApplicationRequest) GetClusterName() string {
 	if x != nil {
-		return x.Url
+		return x.ClusterName
 	}
 	return ""
 }
 
-type ListCommitsRequest struct {
+type SuspendApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The application name
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace the application is in
-	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional. A pagination token returned from a previous call
-	// that indicates where this listing should continue from.
-	PageToken *int32 `protobuf:"varint,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+	Success   bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Suspended bool `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended,omitempty"` // False when the application was already suspended
 }
 
-func (x *ListCommitsRequest) Reset() {
-	*x = ListCommitsRequest{}
+func (x *SuspendApplicationResponse) Reset() {
+	*x = SuspendApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[19]
+		mi := &file_api_applications_applications_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCommitsRequest) String() string {
+func (x *SuspendApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommitsRequest) ProtoMessage() {}
+func (*SuspendApplicationResponse) ProtoMessage() {}
 
-func (x *ListCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[19]
+func (x *SuspendApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,67 +1779,52 @@ func (x *ListCommitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommitsRequest.ProtoReflect.Descriptor instead.
-func (*ListCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ListCommitsRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use SuspendApplicationResponse.ProtoReflect.Descriptor instead.
+func (*SuspendApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ListCommitsRequest) GetNamespace() string {
+func (x *SuspendApplicationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Namespace
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListCommitsRequest) GetPageSize() int32 {
+func (x *SuspendApplicationResponse) GetSuspended() bool {
 	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *ListCommitsRequest) GetPageToken() int32 {
-	if x != nil && x.PageToken != nil {
-		return *x.PageToken
+		return x.Suspended
 	}
-	return 0
+	return false
 }
 
-type ListCommitsResponse struct {
+type ResumeApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Commits []*Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"` // A list of commits
-	// A pagination token returned from a previous call
-	// that indicates from where listing should continue.
-	NextPageToken int32 `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ClusterName string `protobuf:"bytes,3,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *ListCommitsResponse) Reset() {
-	*x = ListCommitsResponse{}
+func (x *ResumeApplicationRequest) Reset() {
+	*x = ResumeApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[20]
+		mi := &file_api_applications_applications_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListCommitsResponse) String() string {
+func (x *ResumeApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommitsResponse) ProtoMessage() {}
+func (*ResumeApplicationRequest) ProtoMessage() {}
 
-func (x *ListCommitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[20]
+func (x *ResumeApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,53 +1835,58 @@ func (x *ListCommitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommitsResponse.ProtoReflect.Descriptor instead.
-func (*ListCommitsResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ResumeApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ResumeApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ListCommitsResponse) GetCommits() []*Commit {
+func (x *ResumeApplicationRequest) GetName() string {
 	if x != nil {
-		return x.Commits
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ListCommitsResponse) GetNextPageToken() int32 {
+func (x *ResumeApplicationRequest) GetNamespace() string {
 	if x != nil {
-		return x.NextPageToken
+		return x.Namespace
 	}
-	return 0
+	return ""
 }
 
-// GroupVersionKind represents an objects Kubernetes API type data
-type GroupVersionKind struct {
+func (x *ResumeApplicationRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type ResumeApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group   string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Resumed bool `protobuf:"varint,2,opt,name=resumed,proto3" json:"resumed,omitempty"` // False when the application was already reconciling
 }
 
-func (x *GroupVersionKind) Reset() {
-	*x = GroupVersionKind{}
+func (x *ResumeApplicationResponse) Reset() {
+	*x = ResumeApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[21]
+		mi := &file_api_applications_applications_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupVersionKind) String() string {
+func (x *ResumeApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupVersionKind) ProtoMessage() {}
+func (*ResumeApplicationResponse) ProtoMessage() {}
 
-func (x *GroupVersionKind) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[21]
+func (x *ResumeApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,62 +1897,53 @@ func (x *GroupVersionKind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupVersionKind.ProtoReflect.Descriptor instead.
-func (*GroupVersionKind) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *GroupVersionKind) GetGroup() string {
-	if x != nil {
-		return x.Group
-	}
-	return ""
+// Deprecated: Use ResumeApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ResumeApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GroupVersionKind) GetKind() string {
+func (x *ResumeApplicationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Kind
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GroupVersionKind) GetVersion() string {
+func (x *ResumeApplicationResponse) GetResumed() bool {
 	if x != nil {
-		return x.Version
+		return x.Resumed
 	}
-	return ""
+	return false
 }
 
-// UnstructuredObject is a Kubernetes object of an unknown type
-type UnstructuredObject struct {
+type RollbackApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupVersionKind *GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
-	Name             string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace        string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Uid              string            `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
-	Status           string            `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Revision  string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"` // A full commit SHA or a tag to pin the source to
+	AutoMerge bool   `protobuf:"varint,4,opt,name=autoMerge,proto3" json:"autoMerge,omitempty"`
 }
 
-func (x *UnstructuredObject) Reset() {
-	*x = UnstructuredObject{}
+func (x *RollbackApplicationRequest) Reset() {
+	*x = RollbackApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[22]
+		mi := &file_api_applications_applications_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnstructuredObject) String() string {
+func (x *RollbackApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnstructuredObject) ProtoMessage() {}
+func (*RollbackApplicationRequest) ProtoMessage() {}
 
-func (x *UnstructuredObject) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[22]
+func (x *RollbackApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1725,74 +1954,65 @@ func (x *UnstructuredObject) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnstructuredObject.ProtoReflect.Descriptor instead.
-func (*UnstructuredObject) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *UnstructuredObject) GetGroupVersionKind() *GroupVersionKind {
-	if x != nil {
-		return x.GroupVersionKind
-	}
-	return nil
+// Deprecated: Use RollbackApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RollbackApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *UnstructuredObject) GetName() string {
+func (x *RollbackApplicationRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UnstructuredObject) GetNamespace() string {
+func (x *RollbackApplicationRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *UnstructuredObject) GetUid() string {
+func (x *RollbackApplicationRequest) GetRevision() string {
 	if x != nil {
-		return x.Uid
+		return x.Revision
 	}
 	return ""
 }
 
-func (x *UnstructuredObject) GetStatus() string {
+func (x *RollbackApplicationRequest) GetAutoMerge() bool {
 	if x != nil {
-		return x.Status
+		return x.AutoMerge
 	}
-	return ""
+	return false
 }
 
-type GetReconciledObjectsReq struct {
+type RollbackApplicationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AutomationName      string              `protobuf:"bytes,1,opt,name=automationName,proto3" json:"automationName,omitempty"`
-	AutomationNamespace string              `protobuf:"bytes,2,opt,name=automationNamespace,proto3" json:"automationNamespace,omitempty"`
-	AutomationKind      AutomationKind      `protobuf:"varint,3,opt,name=automationKind,proto3,enum=wego_server.v1.AutomationKind" json:"automationKind,omitempty"`
-	Kinds               []*GroupVersionKind `protobuf:"bytes,4,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RepoUrl string `protobuf:"bytes,2,opt,name=repoUrl,proto3" json:"repoUrl,omitempty"`
 }
 
-func (x *GetReconciledObjectsReq) Reset() {
-	*x = GetReconciledObjectsReq{}
+func (x *RollbackApplicationResponse) Reset() {
+	*x = RollbackApplicationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[23]
+		mi := &file_api_applications_applications_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetReconciledObjectsReq) String() string {
+func (x *RollbackApplicationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReconciledObjectsReq) ProtoMessage() {}
+func (*RollbackApplicationResponse) ProtoMessage() {}
 
-func (x *GetReconciledObjectsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[23]
+func (x *RollbackApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,64 +2023,52 @@ func (x *GetReconciledObjectsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReconciledObjectsReq.ProtoReflect.Descriptor instead.
-func (*GetReconciledObjectsReq) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RollbackApplicationResponse.ProtoReflect.Descriptor instead.
+func (*RollbackApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetReconciledObjectsReq) GetAutomationName() string {
+func (x *RollbackApplicationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AutomationName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GetReconciledObjectsReq) GetAutomationNamespace() string {
+func (x *RollbackApplicationResponse) GetRepoUrl() string {
 	if x != nil {
-		return x.AutomationNamespace
+		return x.RepoUrl
 	}
 	return ""
 }
 
-func (x *GetReconciledObjectsReq) GetAutomationKind() AutomationKind {
-	if x != nil {
-		return x.AutomationKind
-	}
-	return AutomationKind_Kustomize
-}
-
-func (x *GetReconciledObjectsReq) GetKinds() []*GroupVersionKind {
-	if x != nil {
-		return x.Kinds
-	}
-	return nil
-}
-
-type GetReconciledObjectsRes struct {
+type UnpinApplicationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Objects []*UnstructuredObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	AutoMerge bool   `protobuf:"varint,3,opt,name=autoMerge,proto3" json:"autoMerge,omitempty"`
 }
 
-func (x *GetReconciledObjectsRes) Reset() {
-	*x = GetReconciledObjectsRes{}
+func (x *UnpinApplicationRequest) Reset() {
+	*x = UnpinApplicationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[24]
+		mi := &file_api_applications_applications_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetReconciledObjectsRes) String() string {
+func (x *UnpinApplicationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReconciledObjectsRes) ProtoMessage() {}
+func (*UnpinApplicationRequest) ProtoMessage() {}
 
-func (x *GetReconciledObjectsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[24]
+func (x *UnpinApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1871,44 +2079,1071 @@ func (x *GetReconciledObjectsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReconciledObjectsRes.ProtoReflect.Descriptor instead.
-func (*GetReconciledObjectsRes) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UnpinApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UnpinApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetReconciledObjectsRes) GetObjects() []*UnstructuredObject {
+func (x *UnpinApplicationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnpinApplicationRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UnpinApplicationRequest) GetAutoMerge() bool {
+	if x != nil {
+		return x.AutoMerge
+	}
+	return false
+}
+
+type UnpinApplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RepoUrl string `protobuf:"bytes,2,opt,name=repoUrl,proto3" json:"repoUrl,omitempty"`
+}
+
+func (x *UnpinApplicationResponse) Reset() {
+	*x = UnpinApplicationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnpinApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpinApplicationResponse) ProtoMessage() {}
+
+func (x *UnpinApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpinApplicationResponse.ProtoReflect.Descriptor instead.
+func (*UnpinApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnpinApplicationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnpinApplicationResponse) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+type Commit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash     string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`          // The hash of the commit
+	Date     string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`          // The date the commit was made.
+	Author   string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`      // The author of the commit
+	Message  string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`    // The commit message
+	Url      string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`            // The commit url
+	Signed   bool   `protobuf:"varint,6,opt,name=signed,proto3" json:"signed,omitempty"`     // Whether the commit is signed
+	Verified bool   `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"` // Whether the signature is made by a trusted signing key
+}
+
+func (x *Commit) Reset() {
+	*x = Commit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Commit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Commit) ProtoMessage() {}
+
+func (x *Commit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Commit.ProtoReflect.Descriptor instead.
+func (*Commit) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Commit) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Commit) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Commit) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Commit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Commit) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Commit) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *Commit) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type ListCommitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The application name
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace the application is in
+	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional. A pagination token returned from a previous call
+	// that indicates where this listing should continue from.
+	PageToken *int32 `protobuf:"varint,4,opt,name=page_token,json=pageToken,proto3,oneof" json:"page_token,omitempty"`
+}
+
+func (x *ListCommitsRequest) Reset() {
+	*x = ListCommitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitsRequest) ProtoMessage() {}
+
+func (x *ListCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCommitsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListCommitsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListCommitsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommitsRequest) GetPageToken() int32 {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return 0
+}
+
+type ListCommitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commits []*Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"` // A list of commits
+	// A pagination token returned from a previous call
+	// that indicates from where listing should continue.
+	NextPageToken int32 `protobuf:"varint,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListCommitsResponse) Reset() {
+	*x = ListCommitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCommitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommitsResponse) ProtoMessage() {}
+
+func (x *ListCommitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommitsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommitsResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListCommitsResponse) GetCommits() []*Commit {
+	if x != nil {
+		return x.Commits
+	}
+	return nil
+}
+
+func (x *ListCommitsResponse) GetNextPageToken() int32 {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return 0
+}
+
+// GroupVersionKind represents an objects Kubernetes API type data
+type GroupVersionKind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group   string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *GroupVersionKind) Reset() {
+	*x = GroupVersionKind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupVersionKind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupVersionKind) ProtoMessage() {}
+
+func (x *GroupVersionKind) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupVersionKind.ProtoReflect.Descriptor instead.
+func (*GroupVersionKind) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GroupVersionKind) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GroupVersionKind) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GroupVersionKind) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// UnstructuredObject is a Kubernetes object of an unknown type
+type UnstructuredObject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupVersionKind *GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
+	Name             string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace        string            `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid              string            `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Status           string            `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`   // The kstatus of the object: Current, InProgress, Failed or Terminating
+	Message          string            `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"` // Explains the status of the object
+}
+
+func (x *UnstructuredObject) Reset() {
+	*x = UnstructuredObject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnstructuredObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnstructuredObject) ProtoMessage() {}
+
+func (x *UnstructuredObject) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnstructuredObject.ProtoReflect.Descriptor instead.
+func (*UnstructuredObject) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnstructuredObject) GetGroupVersionKind() *GroupVersionKind {
+	if x != nil {
+		return x.GroupVersionKind
+	}
+	return nil
+}
+
+func (x *UnstructuredObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UnstructuredObject) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UnstructuredObject) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UnstructuredObject) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UnstructuredObject) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetReconciledObjectsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AutomationName      string              `protobuf:"bytes,1,opt,name=automationName,proto3" json:"automationName,omitempty"`
+	AutomationNamespace string              `protobuf:"bytes,2,opt,name=automationNamespace,proto3" json:"automationNamespace,omitempty"`
+	AutomationKind      AutomationKind      `protobuf:"varint,3,opt,name=automationKind,proto3,enum=wego_server.v1.AutomationKind" json:"automationKind,omitempty"`
+	Kinds               []*GroupVersionKind `protobuf:"bytes,4,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	ClusterName         string              `protobuf:"bytes,5,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *GetReconciledObjectsReq) Reset() {
+	*x = GetReconciledObjectsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReconciledObjectsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciledObjectsReq) ProtoMessage() {}
+
+func (x *GetReconciledObjectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciledObjectsReq.ProtoReflect.Descriptor instead.
+func (*GetReconciledObjectsReq) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetReconciledObjectsReq) GetAutomationName() string {
+	if x != nil {
+		return x.AutomationName
+	}
+	return ""
+}
+
+func (x *GetReconciledObjectsReq) GetAutomationNamespace() string {
+	if x != nil {
+		return x.AutomationNamespace
+	}
+	return ""
+}
+
+func (x *GetReconciledObjectsReq) GetAutomationKind() AutomationKind {
+	if x != nil {
+		return x.AutomationKind
+	}
+	return AutomationKind_Kustomize
+}
+
+func (x *GetReconciledObjectsReq) GetKinds() []*GroupVersionKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *GetReconciledObjectsReq) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetReconciledObjectsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objects []*UnstructuredObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (x *GetReconciledObjectsRes) Reset() {
+	*x = GetReconciledObjectsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReconciledObjectsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciledObjectsRes) ProtoMessage() {}
+
+func (x *GetReconciledObjectsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciledObjectsRes.ProtoReflect.Descriptor instead.
+func (*GetReconciledObjectsRes) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetReconciledObjectsRes) GetObjects() []*UnstructuredObject {
 	if x != nil {
 		return x.Objects
 	}
 	return nil
 }
 
-type GetChildObjectsReq struct {
+type GetChildObjectsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupVersionKind *GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
+	ParentUid        string            `protobuf:"bytes,2,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	ClusterName      string            `protobuf:"bytes,3,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *GetChildObjectsReq) Reset() {
+	*x = GetChildObjectsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChildObjectsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildObjectsReq) ProtoMessage() {}
+
+func (x *GetChildObjectsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildObjectsReq.ProtoReflect.Descriptor instead.
+func (*GetChildObjectsReq) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetChildObjectsReq) GetGroupVersionKind() *GroupVersionKind {
+	if x != nil {
+		return x.GroupVersionKind
+	}
+	return nil
+}
+
+func (x *GetChildObjectsReq) GetParentUid() string {
+	if x != nil {
+		return x.ParentUid
+	}
+	return ""
+}
+
+func (x *GetChildObjectsReq) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type GetChildObjectsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objects []*UnstructuredObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+}
+
+func (x *GetChildObjectsRes) Reset() {
+	*x = GetChildObjectsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChildObjectsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChildObjectsRes) ProtoMessage() {}
+
+func (x *GetChildObjectsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChildObjectsRes.ProtoReflect.Descriptor instead.
+func (*GetChildObjectsRes) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetChildObjectsRes) GetObjects() []*UnstructuredObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+type ListApplicationEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // The application name
+	Namespace   string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace the application is in
+	Type        string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`           // Only return the events of this type, Normal or Warning; all events are returned when empty
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+}
+
+func (x *ListApplicationEventsRequest) Reset() {
+	*x = ListApplicationEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApplicationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationEventsRequest) ProtoMessage() {}
+
+func (x *ListApplicationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListApplicationEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListApplicationEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListApplicationEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListApplicationEventsRequest) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                     // The type of the event, Normal or Warning
+	Reason         string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                 // Short, machine understandable reason of the event
+	Message        string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`               // Human readable description of the event
+	Kind           string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                     // The kind of the object the event is about
+	Name           string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                     // The name of the object the event is about
+	Namespace      string `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`           // The namespace of the object the event is about
+	Count          int32  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`                  // The number of times the event occurred
+	FirstTimestamp string `protobuf:"bytes,8,opt,name=firstTimestamp,proto3" json:"firstTimestamp,omitempty"` // The first time the event was seen, in RFC3339 format
+	LastTimestamp  string `protobuf:"bytes,9,opt,name=lastTimestamp,proto3" json:"lastTimestamp,omitempty"`   // The last time the event was seen, in RFC3339 format
+	Source         string `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`                // The component that reported the event
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Event) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Event) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Event) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Event) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Event) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Event) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Event) GetFirstTimestamp() string {
+	if x != nil {
+		return x.FirstTimestamp
+	}
+	return ""
+}
+
+func (x *Event) GetLastTimestamp() string {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return ""
+}
+
+func (x *Event) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ListApplicationEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListApplicationEventsResponse) Reset() {
+	*x = ListApplicationEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApplicationEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationEventsResponse) ProtoMessage() {}
+
+func (x *ListApplicationEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListApplicationEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetGithubDeviceCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetGithubDeviceCodeRequest) Reset() {
+	*x = GetGithubDeviceCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGithubDeviceCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGithubDeviceCodeRequest) ProtoMessage() {}
+
+func (x *GetGithubDeviceCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGithubDeviceCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetGithubDeviceCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{41}
+}
+
+type GetGithubDeviceCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserCode      string `protobuf:"bytes,1,opt,name=userCode,proto3" json:"userCode,omitempty"`           // The Github Device Flow code that will be shown to the user
+	DeviceCode    string `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`       // A code that uniquely identifies a device
+	ValidationURI string `protobuf:"bytes,3,opt,name=validationURI,proto3" json:"validationURI,omitempty"` // The URI that the user will visit to complete the flow
+	Interval      int32  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`          // How often the client should retry the request
+}
+
+func (x *GetGithubDeviceCodeResponse) Reset() {
+	*x = GetGithubDeviceCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGithubDeviceCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGithubDeviceCodeResponse) ProtoMessage() {}
+
+func (x *GetGithubDeviceCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGithubDeviceCodeResponse.ProtoReflect.Descriptor instead.
+func (*GetGithubDeviceCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGithubDeviceCodeResponse) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *GetGithubDeviceCodeResponse) GetDeviceCode() string {
+	if x != nil {
+		return x.DeviceCode
+	}
+	return ""
+}
+
+func (x *GetGithubDeviceCodeResponse) GetValidationURI() string {
+	if x != nil {
+		return x.ValidationURI
+	}
+	return ""
+}
+
+func (x *GetGithubDeviceCodeResponse) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+type GetGithubAuthStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupVersionKind *GroupVersionKind `protobuf:"bytes,1,opt,name=groupVersionKind,proto3" json:"groupVersionKind,omitempty"`
-	ParentUid        string            `protobuf:"bytes,2,opt,name=parentUid,proto3" json:"parentUid,omitempty"`
+	DeviceCode string `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"` // The deviceCode returned from a GetGithubDeviceCodeResponse
 }
 
-func (x *GetChildObjectsReq) Reset() {
-	*x = GetChildObjectsReq{}
+func (x *GetGithubAuthStatusRequest) Reset() {
+	*x = GetGithubAuthStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[25]
+		mi := &file_api_applications_applications_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChildObjectsReq) String() string {
+func (x *GetGithubAuthStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildObjectsReq) ProtoMessage() {}
+func (*GetGithubAuthStatusRequest) ProtoMessage() {}
 
-func (x *GetChildObjectsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[25]
+func (x *GetGithubAuthStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1919,50 +3154,44 @@ func (x *GetChildObjectsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildObjectsReq.ProtoReflect.Descriptor instead.
-func (*GetChildObjectsReq) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetChildObjectsReq) GetGroupVersionKind() *GroupVersionKind {
-	if x != nil {
-		return x.GroupVersionKind
-	}
-	return nil
+// Deprecated: Use GetGithubAuthStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetGithubAuthStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetChildObjectsReq) GetParentUid() string {
+func (x *GetGithubAuthStatusRequest) GetDeviceCode() string {
 	if x != nil {
-		return x.ParentUid
+		return x.DeviceCode
 	}
 	return ""
 }
 
-type GetChildObjectsRes struct {
+type GetGithubAuthStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Objects []*UnstructuredObject `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"` // An access token that can be used to interact with the Weave GitOps API.
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`             // An error message.
 }
 
-func (x *GetChildObjectsRes) Reset() {
-	*x = GetChildObjectsRes{}
+func (x *GetGithubAuthStatusResponse) Reset() {
+	*x = GetGithubAuthStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[26]
+		mi := &file_api_applications_applications_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetChildObjectsRes) String() string {
+func (x *GetGithubAuthStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChildObjectsRes) ProtoMessage() {}
+func (*GetGithubAuthStatusResponse) ProtoMessage() {}
 
-func (x *GetChildObjectsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[26]
+func (x *GetGithubAuthStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,41 +3202,50 @@ func (x *GetChildObjectsRes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChildObjectsRes.ProtoReflect.Descriptor instead.
-func (*GetChildObjectsRes) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetGithubAuthStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetGithubAuthStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetChildObjectsRes) GetObjects() []*UnstructuredObject {
+func (x *GetGithubAuthStatusResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Objects
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-type GetGithubDeviceCodeRequest struct {
+func (x *GetGithubAuthStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ParseRepoURLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // The URL to be parsed
 }
 
-func (x *GetGithubDeviceCodeRequest) Reset() {
-	*x = GetGithubDeviceCodeRequest{}
+func (x *ParseRepoURLRequest) Reset() {
+	*x = ParseRepoURLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[27]
+		mi := &file_api_applications_applications_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGithubDeviceCodeRequest) String() string {
+func (x *ParseRepoURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGithubDeviceCodeRequest) ProtoMessage() {}
+func (*ParseRepoURLRequest) ProtoMessage() {}
 
-func (x *GetGithubDeviceCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[27]
+func (x *ParseRepoURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2018,39 +3256,45 @@ func (x *GetGithubDeviceCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGithubDeviceCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetGithubDeviceCodeRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ParseRepoURLRequest.ProtoReflect.Descriptor instead.
+func (*ParseRepoURLRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{45}
 }
 
-type GetGithubDeviceCodeResponse struct {
+func (x *ParseRepoURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ParseRepoURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserCode      string `protobuf:"bytes,1,opt,name=userCode,proto3" json:"userCode,omitempty"`           // The Github Device Flow code that will be shown to the user
-	DeviceCode    string `protobuf:"bytes,2,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"`       // A code that uniquely identifies a device
-	ValidationURI string `protobuf:"bytes,3,opt,name=validationURI,proto3" json:"validationURI,omitempty"` // The URI that the user will visit to complete the flow
-	Interval      int32  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`          // How often the client should retry the request
+	Name     string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                          // The name of the git repository
+	Provider GitProvider `protobuf:"varint,2,opt,name=provider,proto3,enum=wego_server.v1.GitProvider" json:"provider,omitempty"` // The GitProvider for the repository
+	Owner    string      `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                                        // The person or organization to which this repo belongs
 }
 
-func (x *GetGithubDeviceCodeResponse) Reset() {
-	*x = GetGithubDeviceCodeResponse{}
+func (x *ParseRepoURLResponse) Reset() {
+	*x = ParseRepoURLResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[28]
+		mi := &file_api_applications_applications_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGithubDeviceCodeResponse) String() string {
+func (x *ParseRepoURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGithubDeviceCodeResponse) ProtoMessage() {}
+func (*ParseRepoURLResponse) ProtoMessage() {}
 
-func (x *GetGithubDeviceCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[28]
+func (x *ParseRepoURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,64 +3305,104 @@ func (x *GetGithubDeviceCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGithubDeviceCodeResponse.ProtoReflect.Descriptor instead.
-func (*GetGithubDeviceCodeResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ParseRepoURLResponse.ProtoReflect.Descriptor instead.
+func (*ParseRepoURLResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetGithubDeviceCodeResponse) GetUserCode() string {
+func (x *ParseRepoURLResponse) GetName() string {
 	if x != nil {
-		return x.UserCode
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetGithubDeviceCodeResponse) GetDeviceCode() string {
+func (x *ParseRepoURLResponse) GetProvider() GitProvider {
 	if x != nil {
-		return x.DeviceCode
+		return x.Provider
 	}
-	return ""
+	return GitProvider_Unknown
 }
 
-func (x *GetGithubDeviceCodeResponse) GetValidationURI() string {
+func (x *ParseRepoURLResponse) GetOwner() string {
 	if x != nil {
-		return x.ValidationURI
+		return x.Owner
 	}
 	return ""
 }
 
-func (x *GetGithubDeviceCodeResponse) GetInterval() int32 {
+type GetGitlabAuthURLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectUri string `protobuf:"bytes,1,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"` // The URI that GitLab will use to send users back to GitOps.
+}
+
+func (x *GetGitlabAuthURLRequest) Reset() {
+	*x = GetGitlabAuthURLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_applications_applications_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGitlabAuthURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGitlabAuthURLRequest) ProtoMessage() {}
+
+func (x *GetGitlabAuthURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGitlabAuthURLRequest.ProtoReflect.Descriptor instead.
+func (*GetGitlabAuthURLRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetGitlabAuthURLRequest) GetRedirectUri() string {
 	if x != nil {
-		return x.Interval
+		return x.RedirectUri
 	}
-	return 0
+	return ""
 }
 
-type GetGithubAuthStatusRequest struct {
+type GetGitlabAuthURLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DeviceCode string `protobuf:"bytes,1,opt,name=deviceCode,proto3" json:"deviceCode,omitempty"` // The deviceCode returned from a GetGithubDeviceCodeResponse
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // The URL that users must visit to authorize GitLab authentication.
 }
 
-func (x *GetGithubAuthStatusRequest) Reset() {
-	*x = GetGithubAuthStatusRequest{}
+func (x *GetGitlabAuthURLResponse) Reset() {
+	*x = GetGitlabAuthURLResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[29]
+		mi := &file_api_applications_applications_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGithubAuthStatusRequest) String() string {
+func (x *GetGitlabAuthURLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGithubAuthStatusRequest) ProtoMessage() {}
+func (*GetGitlabAuthURLResponse) ProtoMessage() {}
 
-func (x *GetGithubAuthStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[29]
+func (x *GetGitlabAuthURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2129,44 +3413,44 @@ func (x *GetGithubAuthStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGithubAuthStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetGithubAuthStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetGitlabAuthURLResponse.ProtoReflect.Descriptor instead.
+func (*GetGitlabAuthURLResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetGithubAuthStatusRequest) GetDeviceCode() string {
+func (x *GetGitlabAuthURLResponse) GetUrl() string {
 	if x != nil {
-		return x.DeviceCode
+		return x.Url
 	}
 	return ""
 }
 
-type GetGithubAuthStatusResponse struct {
+type AuthorizeGitlabRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"` // An access token that can be used to interact with the Weave GitOps API.
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`             // An error message.
+	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`               // The challenge code obtained from the OAuth callback
+	RedirectUri string `protobuf:"bytes,2,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"` // The redirect URI that originated the OAuth flow
 }
 
-func (x *GetGithubAuthStatusResponse) Reset() {
-	*x = GetGithubAuthStatusResponse{}
+func (x *AuthorizeGitlabRequest) Reset() {
+	*x = AuthorizeGitlabRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[30]
+		mi := &file_api_applications_applications_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGithubAuthStatusResponse) String() string {
+func (x *AuthorizeGitlabRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGithubAuthStatusResponse) ProtoMessage() {}
+func (*AuthorizeGitlabRequest) ProtoMessage() {}
 
-func (x *GetGithubAuthStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[30]
+func (x *AuthorizeGitlabRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,50 +3461,50 @@ func (x *GetGithubAuthStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGithubAuthStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetGithubAuthStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use AuthorizeGitlabRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeGitlabRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetGithubAuthStatusResponse) GetAccessToken() string {
+func (x *AuthorizeGitlabRequest) GetCode() string {
 	if x != nil {
-		return x.AccessToken
+		return x.Code
 	}
 	return ""
 }
 
-func (x *GetGithubAuthStatusResponse) GetError() string {
+func (x *AuthorizeGitlabRequest) GetRedirectUri() string {
 	if x != nil {
-		return x.Error
+		return x.RedirectUri
 	}
 	return ""
 }
 
-type ParseRepoURLRequest struct {
+type AuthorizeGitlabResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // The URL to be parsed
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // A token that can be used to authenticate the GitOps API server.
 }
 
-func (x *ParseRepoURLRequest) Reset() {
-	*x = ParseRepoURLRequest{}
+func (x *AuthorizeGitlabResponse) Reset() {
+	*x = AuthorizeGitlabResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[31]
+		mi := &file_api_applications_applications_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ParseRepoURLRequest) String() string {
+func (x *AuthorizeGitlabResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseRepoURLRequest) ProtoMessage() {}
+func (*AuthorizeGitlabResponse) ProtoMessage() {}
 
-func (x *ParseRepoURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[31]
+func (x *AuthorizeGitlabResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2231,45 +3515,43 @@ func (x *ParseRepoURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseRepoURLRequest.ProtoReflect.Descriptor instead.
-func (*ParseRepoURLRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AuthorizeGitlabResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeGitlabResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ParseRepoURLRequest) GetUrl() string {
+func (x *AuthorizeGitlabResponse) GetToken() string {
 	if x != nil {
-		return x.Url
+		return x.Token
 	}
 	return ""
 }
 
-type ParseRepoURLResponse struct {
+type ValidateProviderTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                          // The name of the git repository
-	Provider GitProvider `protobuf:"varint,2,opt,name=provider,proto3,enum=wego_server.v1.GitProvider" json:"provider,omitempty"` // The GitProvider for the repository
-	Owner    string      `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`                                        // The person or organization to which this repo belongs
+	Provider GitProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=wego_server.v1.GitProvider" json:"provider,omitempty"`
 }
 
-func (x *ParseRepoURLResponse) Reset() {
-	*x = ParseRepoURLResponse{}
+func (x *ValidateProviderTokenRequest) Reset() {
+	*x = ValidateProviderTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[32]
+		mi := &file_api_applications_applications_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ParseRepoURLResponse) String() string {
+func (x *ValidateProviderTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseRepoURLResponse) ProtoMessage() {}
+func (*ValidateProviderTokenRequest) ProtoMessage() {}
 
-func (x *ParseRepoURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[32]
+func (x *ValidateProviderTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2280,57 +3562,43 @@ func (x *ParseRepoURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseRepoURLResponse.ProtoReflect.Descriptor instead.
-func (*ParseRepoURLResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ParseRepoURLResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ValidateProviderTokenRequest.ProtoReflect.Descriptor instead.
+func (*ValidateProviderTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ParseRepoURLResponse) GetProvider() GitProvider {
+func (x *ValidateProviderTokenRequest) GetProvider() GitProvider {
 	if x != nil {
 		return x.Provider
 	}
 	return GitProvider_Unknown
 }
 
-func (x *ParseRepoURLResponse) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-type GetGitlabAuthURLRequest struct {
+type ValidateProviderTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedirectUri string `protobuf:"bytes,1,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"` // The URI that GitLab will use to send users back to GitOps.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (x *GetGitlabAuthURLRequest) Reset() {
-	*x = GetGitlabAuthURLRequest{}
+func (x *ValidateProviderTokenResponse) Reset() {
+	*x = ValidateProviderTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[33]
+		mi := &file_api_applications_applications_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGitlabAuthURLRequest) String() string {
+func (x *ValidateProviderTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitlabAuthURLRequest) ProtoMessage() {}
+func (*ValidateProviderTokenResponse) ProtoMessage() {}
 
-func (x *GetGitlabAuthURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[33]
+func (x *ValidateProviderTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2341,43 +3609,43 @@ func (x *GetGitlabAuthURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitlabAuthURLRequest.ProtoReflect.Descriptor instead.
-func (*GetGitlabAuthURLRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ValidateProviderTokenResponse.ProtoReflect.Descriptor instead.
+func (*ValidateProviderTokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetGitlabAuthURLRequest) GetRedirectUri() string {
+func (x *ValidateProviderTokenResponse) GetValid() bool {
 	if x != nil {
-		return x.RedirectUri
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-type GetGitlabAuthURLResponse struct {
+type WatchApplicationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // The URL that users must visit to authorize GitLab authentication.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace to watch, all namespaces when empty
 }
 
-func (x *GetGitlabAuthURLResponse) Reset() {
-	*x = GetGitlabAuthURLResponse{}
+func (x *WatchApplicationsRequest) Reset() {
+	*x = WatchApplicationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[34]
+		mi := &file_api_applications_applications_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetGitlabAuthURLResponse) String() string {
+func (x *WatchApplicationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGitlabAuthURLResponse) ProtoMessage() {}
+func (*WatchApplicationsRequest) ProtoMessage() {}
 
-func (x *GetGitlabAuthURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[34]
+func (x *WatchApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2388,44 +3656,50 @@ func (x *GetGitlabAuthURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGitlabAuthURLResponse.ProtoReflect.Descriptor instead.
-func (*GetGitlabAuthURLResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use WatchApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetGitlabAuthURLResponse) GetUrl() string {
+func (x *WatchApplicationsRequest) GetNamespace() string {
 	if x != nil {
-		return x.Url
+		return x.Namespace
 	}
 	return ""
 }
 
-type AuthorizeGitlabRequest struct {
+type WatchApplicationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code        string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`               // The challenge code obtained from the OAuth callback
-	RedirectUri string `protobuf:"bytes,2,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"` // The redirect URI that originated the OAuth flow
+	Type                string       `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                               // The type of change: ADDED, MODIFIED or DELETED
+	Kind                string       `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`                               // The kind of the object that changed
+	Name                string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                               // The name of the object that changed
+	Namespace           string       `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // The namespace of the object that changed
+	Application         string       `protobuf:"bytes,5,opt,name=application,proto3" json:"application,omitempty"`                 // The name of the application the object belongs to
+	Conditions          []*Condition `protobuf:"bytes,6,rep,name=conditions,proto3" json:"conditions,omitempty"`                   // The conditions of the object
+	LastAppliedRevision string       `protobuf:"bytes,7,opt,name=lastAppliedRevision,proto3" json:"lastAppliedRevision,omitempty"` // The last revision applied by a Kustomization or HelmRelease
+	Suspended           bool         `protobuf:"varint,8,opt,name=suspended,proto3" json:"suspended,omitempty"`                    // Whether reconciliation of the object is suspended
 }
 
-func (x *AuthorizeGitlabRequest) Reset() {
-	*x = AuthorizeGitlabRequest{}
+func (x *WatchApplicationsResponse) Reset() {
+	*x = WatchApplicationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[35]
+		mi := &file_api_applications_applications_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizeGitlabRequest) String() string {
+func (x *WatchApplicationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizeGitlabRequest) ProtoMessage() {}
+func (*WatchApplicationsResponse) ProtoMessage() {}
 
-func (x *AuthorizeGitlabRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[35]
+func (x *WatchApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2436,50 +3710,98 @@ func (x *AuthorizeGitlabRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizeGitlabRequest.ProtoReflect.Descriptor instead.
-func (*AuthorizeGitlabRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use WatchApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*WatchApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *AuthorizeGitlabRequest) GetCode() string {
+func (x *WatchApplicationsResponse) GetType() string {
 	if x != nil {
-		return x.Code
+		return x.Type
 	}
 	return ""
 }
 
-func (x *AuthorizeGitlabRequest) GetRedirectUri() string {
+func (x *WatchApplicationsResponse) GetKind() string {
 	if x != nil {
-		return x.RedirectUri
+		return x.Kind
 	}
 	return ""
 }
 
-type AuthorizeGitlabResponse struct {
+func (x *WatchApplicationsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WatchApplicationsResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WatchApplicationsResponse) GetApplication() string {
+	if x != nil {
+		return x.Application
+	}
+	return ""
+}
+
+func (x *WatchApplicationsResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *WatchApplicationsResponse) GetLastAppliedRevision() string {
+	if x != nil {
+		return x.LastAppliedRevision
+	}
+	return ""
+}
+
+func (x *WatchApplicationsResponse) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
+type StreamApplicationLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // A token that can be used to authenticate the GitOps API server.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                   // The application name
+	Namespace     string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`         // The namespace the application is in
+	LabelSelector string `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"` // Only stream the logs of the pods matching this label selector
+	Container     string `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`         // Only stream the logs of the containers with this name
+	SinceSeconds  int64  `protobuf:"varint,5,opt,name=sinceSeconds,proto3" json:"sinceSeconds,omitempty"`  // Only stream the logs newer than this number of seconds; all logs when 0
+	Follow        bool   `protobuf:"varint,6,opt,name=follow,proto3" json:"follow,omitempty"`              // Keep streaming new logs until the request is cancelled
+	ClusterName   string `protobuf:"bytes,7,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 }
 
-func (x *AuthorizeGitlabResponse) Reset() {
-	*x = AuthorizeGitlabResponse{}
+func (x *StreamApplicationLogsRequest) Reset() {
+	*x = StreamApplicationLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[36]
+		mi := &file_api_applications_applications_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthorizeGitlabResponse) String() string {
+func (x *StreamApplicationLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorizeGitlabResponse) ProtoMessage() {}
+func (*StreamApplicationLogsRequest) ProtoMessage() {}
 
-func (x *AuthorizeGitlabResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[36]
+func (x *StreamApplicationLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,90 +3812,88 @@ func (x *AuthorizeGitlabResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorizeGitlabResponse.ProtoReflect.Descriptor instead.
-func (*AuthorizeGitlabResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use StreamApplicationLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamApplicationLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AuthorizeGitlabResponse) GetToken() string {
+func (x *StreamApplicationLogsRequest) GetName() string {
 	if x != nil {
-		return x.Token
+		return x.Name
 	}
 	return ""
 }
 
-type ValidateProviderTokenRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Provider GitProvider `protobuf:"varint,1,opt,name=provider,proto3,enum=wego_server.v1.GitProvider" json:"provider,omitempty"`
+func (x *StreamApplicationLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
 }
 
-func (x *ValidateProviderTokenRequest) Reset() {
-	*x = ValidateProviderTokenRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StreamApplicationLogsRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
 	}
+	return ""
 }
 
-func (x *ValidateProviderTokenRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StreamApplicationLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
 }
 
-func (*ValidateProviderTokenRequest) ProtoMessage() {}
-
-func (x *ValidateProviderTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StreamApplicationLogsRequest) GetSinceSeconds() int64 {
+	if x != nil {
+		return x.SinceSeconds
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ValidateProviderTokenRequest.ProtoReflect.Descriptor instead.
-func (*ValidateProviderTokenRequest) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{37}
+func (x *StreamApplicationLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
 }
 
-func (x *ValidateProviderTokenRequest) GetProvider() GitProvider {
+func (x *StreamApplicationLogsRequest) GetClusterName() string {
 	if x != nil {
-		return x.Provider
+		return x.ClusterName
 	}
-	return GitProvider_Unknown
+	return ""
 }
 
-type ValidateProviderTokenResponse struct {
+type StreamApplicationLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // The namespace of the pod
+	Pod       string `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`             // The name of the pod
+	Container string `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"` // The name of the container
+	Line      string `protobuf:"bytes,4,opt,name=line,proto3" json:"line,omitempty"`           // A line of the logs of the container
 }
 
-func (x *ValidateProviderTokenResponse) Reset() {
-	*x = ValidateProviderTokenResponse{}
+func (x *StreamApplicationLogsResponse) Reset() {
+	*x = StreamApplicationLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_applications_applications_proto_msgTypes[38]
+		mi := &file_api_applications_applications_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidateProviderTokenResponse) String() string {
+func (x *StreamApplicationLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateProviderTokenResponse) ProtoMessage() {}
+func (*StreamApplicationLogsResponse) ProtoMessage() {}
 
-func (x *ValidateProviderTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_applications_applications_proto_msgTypes[38]
+func (x *StreamApplicationLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_applications_applications_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2584,16 +3904,37 @@ func (x *ValidateProviderTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateProviderTokenResponse.ProtoReflect.Descriptor instead.
-func (*ValidateProviderTokenResponse) Descriptor() ([]byte, []int) {
-	return file_api_applications_applications_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use StreamApplicationLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamApplicationLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_applications_applications_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ValidateProviderTokenResponse) GetValid() bool {
+func (x *StreamApplicationLogsResponse) GetNamespace() string {
 	if x != nil {
-		return x.Valid
+		return x.Namespace
 	}
-	return false
+	return ""
+}
+
+func (x *StreamApplicationLogsResponse) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *StreamApplicationLogsResponse) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *StreamApplicationLogsResponse) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
 }
 
 var File_api_applications_applications_proto protoreflect.FileDescriptor
@@ -2615,7 +3956,7 @@ var file_api_applications_applications_proto_rawDesc = []byte{
 	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xd5, 0x04,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xf8, 0x04,
 	0x0a, 0x0b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -2653,426 +3994,672 @@ var file_api_applications_applications_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
 	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x0d, 0x4b, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x02, 0x0a, 0x0d, 0x4b, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x03, 0x0a, 0x0b, 0x48, 0x65,
+	0x6c, 0x6d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52,
+	0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65,
+	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x22, 0x97, 0x01, 0x0a,
+	0x13, 0x48, 0x65, 0x6c, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x5d, 0x0a, 0x09, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68,
+	0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x69, 0x74, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x6d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x43, 0x49,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x10, 0x03, 0x22, 0x5d,
+	0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x0a,
+	0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61,
+	0x6c, 0x6c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x4b,
+	0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x11, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x92, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x67,
+	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x71, 0x0a,
+	0x16, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x6a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x22, 0x4f, 0x0a, 0x19,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x4a, 0x0a,
+	0x16, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
 	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65,
-	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70,
-	0x72, 0x75, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x6c, 0x6d, 0x52,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x05, 0x63, 0x68,
-	0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
-	0x30, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61,
-	0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5d, 0x0a, 0x09,
-	0x48, 0x65, 0x6c, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61,
-	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67,
-	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x19, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
-	0x47, 0x69, 0x74, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x6d, 0x10, 0x01, 0x22,
-	0x5d, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c,
-	0x0a, 0x14, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x37, 0x0a, 0x17,
-	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x5b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x49, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x6f,
+	0x0a, 0x19, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x57, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77,
-	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x71,
-	0x0a, 0x16, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x6a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x54, 0x0a, 0x1a, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x22, 0x51, 0x0a, 0x1b, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x70, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x55, 0x72, 0x6c, 0x22, 0x69, 0x0a, 0x17, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x22,
+	0x4e, 0x0a, 0x18, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22,
+	0xa8, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x65,
+	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd8, 0x01, 0x0a,
+	0x12, 0x55, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x95, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x46, 0x0a,
+	0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x57, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65,
+	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x4c, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
+	0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x67, 0x6f,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x73, 0x22, 0x86, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x4e, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x55, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x27, 0x0a,
+	0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x79, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75,
+	0x74, 0x68, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x2c,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74, 0x68, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x4e, 0x0a, 0x16,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x2f, 0x0a, 0x17,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x57, 0x0a,
+	0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x38, 0x0a,
+	0x18, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0xa2, 0x02, 0x0a, 0x19, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xf2, 0x01, 0x0a,
+	0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x22, 0x4f, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x4a,
-	0x0a, 0x16, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x33, 0x0a, 0x17, 0x53, 0x79,
-	0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x74, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48,
-	0x00, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6f,
-	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x56, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b,
-	0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4c,
-	0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75,
-	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13,
-	0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x46,
-	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x57,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x67,
-	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
-	0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x4c,
-	0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
-	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x12, 0x3c, 0x0a, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x1c,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9b, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x52, 0x49, 0x12, 0x1a,
-	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x55, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x27, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x79, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x73,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62,
-	0x41, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69,
-	0x22, 0x2c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74,
-	0x68, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x4e,
-	0x0a, 0x16, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x2f,
-	0x0a, 0x17, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x57, 0x0a, 0x1c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69,
-	0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x37, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2a,
-	0x29, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e,
-	0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x6d, 0x10, 0x01, 0x2a, 0x32, 0x0a, 0x0b, 0x47, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b,
-	0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62,
-	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x10, 0x02, 0x32, 0x98,
-	0x11, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x86, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x12, 0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x77,
-	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x77,
-	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x7f, 0x0a, 0x0b,
-	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x77, 0x65,
+	0x24, 0x0a, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12,
+	0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x81, 0x01, 0x0a, 0x1d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x70, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x2a, 0x29, 0x0a, 0x0e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x69, 0x7a, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x65, 0x6c, 0x6d, 0x10, 0x01,
+	0x2a, 0x52, 0x0a, 0x0b, 0x47, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x69, 0x74, 0x4c,
+	0x61, 0x62, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x69, 0x74,
+	0x65, 0x61, 0x10, 0x04, 0x32, 0xc3, 0x19, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x0c, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x65,
+	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7f,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x65,
 	0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x12, 0xa9, 0x01,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
-	0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39,
-	0x22, 0x34, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x84, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x22, 0x2e,
+	0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x80, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0x7f, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x12, 0xa9, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x77,
+	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x3f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x22, 0x34, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x63,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x84, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x2c, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x2e,
 	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x22, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x9e, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41,
-	0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x77, 0x65, 0x67, 0x6f,
+	0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x65, 0x67, 0x6f,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22, 0x2d, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74, 0x68, 0x55, 0x52,
-	0x4c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74, 0x68,
-	0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x65, 0x67,
+	0x74, 0x68, 0x75, 0x62, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0xa8, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x47, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
+	0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x65, 0x67,
 	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
-	0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76,
-	0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x69,
-	0x74, 0x6c, 0x61, 0x62, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61,
-	0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x35, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x22,
+	0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01,
+	0x2a, 0x12, 0x95, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41,
+	0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x41, 0x75, 0x74, 0x68, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x41, 0x75, 0x74, 0x68, 0x55, 0x52,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22,
-	0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x77, 0x65, 0x67,
-	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
-	0x3a, 0x01, 0x2a, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
-	0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x3a, 0x01,
-	0x2a, 0x12, 0x82, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55,
-	0x52, 0x4c, 0x12, 0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x65,
-	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0xa0, 0x01, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x2c, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x72, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x26, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65,
+	0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x22, 0x30, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7c, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77, 0x65, 0x67, 0x6f,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x17, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x8b, 0x01, 0x0a, 0x0f, 0x53, 0x79, 0x6e,
+	0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x77,
+	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73,
+	0x79, 0x6e, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e,
+	0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a,
+	0x12, 0x93, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x9b, 0x01, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a,
 	0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x65, 0x67,
+	0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22,
+	0x20, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x8f, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x77, 0x65, 0x67, 0x6f,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x75, 0x6e,
+	0x70, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x12, 0x23, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77,
+	0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x70, 0x61, 0x72,
+	0x73, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0xa0, 0x01, 0x0a, 0x15,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x42, 0xce, 0x01, 0x5a, 0x3a, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x77, 0x6f,
-	0x72, 0x6b, 0x73, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x2d, 0x67, 0x69, 0x74, 0x6f, 0x70, 0x73,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x8e, 0x01, 0x12, 0x68, 0x0a, 0x15,
-	0x57, 0x65, 0x47, 0x6f, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x20, 0x41, 0x50, 0x49, 0x12, 0x4a, 0x54, 0x68, 0x65, 0x20, 0x57, 0x65, 0x47, 0x6f, 0x20,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x41, 0x50, 0x49,
-	0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x57, 0x65, 0x61, 0x76, 0x65, 0x20, 0x47, 0x69,
-	0x74, 0x4f, 0x70, 0x73, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2c, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x8a,
+	0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0x9c, 0x01, 0x0a, 0x15,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2c, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x65, 0x67, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x42, 0xce, 0x01, 0x5a, 0x3a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x2d, 0x67, 0x69, 0x74, 0x6f, 0x70,
+	0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x92, 0x41, 0x8e, 0x01, 0x12, 0x68, 0x0a,
+	0x15, 0x57, 0x65, 0x47, 0x6f, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x20, 0x41, 0x50, 0x49, 0x12, 0x4a, 0x54, 0x68, 0x65, 0x20, 0x57, 0x65, 0x47, 0x6f,
+	0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x57, 0x65, 0x61, 0x76, 0x65, 0x20, 0x47,
+	0x69, 0x74, 0x4f, 0x70, 0x73, 0x20, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x32, 0x03, 0x30, 0x2e, 0x31, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3088,7 +4675,7 @@ func file_api_applications_applications_proto_rawDescGZIP() []byte {
 }
 
 var file_api_applications_applications_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_api_applications_applications_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_api_applications_applications_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_api_applications_applications_proto_goTypes = []interface{}{
 	(AutomationKind)(0),                   // 0: wego_server.v1.AutomationKind
 	(GitProvider)(0),                      // 1: wego_server.v1.GitProvider
@@ -3097,102 +4684,139 @@ var file_api_applications_applications_proto_goTypes = []interface{}{
 	(*Application)(nil),                   // 4: wego_server.v1.Application
 	(*Kustomization)(nil),                 // 5: wego_server.v1.Kustomization
 	(*HelmRelease)(nil),                   // 6: wego_server.v1.HelmRelease
-	(*HelmChart)(nil),                     // 7: wego_server.v1.HelmChart
-	(*Source)(nil),                        // 8: wego_server.v1.Source
-	(*AuthenticateRequest)(nil),           // 9: wego_server.v1.AuthenticateRequest
-	(*AuthenticateResponse)(nil),          // 10: wego_server.v1.AuthenticateResponse
-	(*ListApplicationsRequest)(nil),       // 11: wego_server.v1.ListApplicationsRequest
-	(*ListApplicationsResponse)(nil),      // 12: wego_server.v1.ListApplicationsResponse
-	(*GetApplicationRequest)(nil),         // 13: wego_server.v1.GetApplicationRequest
-	(*GetApplicationResponse)(nil),        // 14: wego_server.v1.GetApplicationResponse
-	(*AddApplicationRequest)(nil),         // 15: wego_server.v1.AddApplicationRequest
-	(*AddApplicationResponse)(nil),        // 16: wego_server.v1.AddApplicationResponse
-	(*RemoveApplicationRequest)(nil),      // 17: wego_server.v1.RemoveApplicationRequest
-	(*RemoveApplicationResponse)(nil),     // 18: wego_server.v1.RemoveApplicationResponse
-	(*SyncApplicationRequest)(nil),        // 19: wego_server.v1.SyncApplicationRequest
-	(*SyncApplicationResponse)(nil),       // 20: wego_server.v1.SyncApplicationResponse
-	(*Commit)(nil),                        // 21: wego_server.v1.Commit
-	(*ListCommitsRequest)(nil),            // 22: wego_server.v1.ListCommitsRequest
-	(*ListCommitsResponse)(nil),           // 23: wego_server.v1.ListCommitsResponse
-	(*GroupVersionKind)(nil),              // 24: wego_server.v1.GroupVersionKind
-	(*UnstructuredObject)(nil),            // 25: wego_server.v1.UnstructuredObject
-	(*GetReconciledObjectsReq)(nil),       // 26: wego_server.v1.GetReconciledObjectsReq
-	(*GetReconciledObjectsRes)(nil),       // 27: wego_server.v1.GetReconciledObjectsRes
-	(*GetChildObjectsReq)(nil),            // 28: wego_server.v1.GetChildObjectsReq
-	(*GetChildObjectsRes)(nil),            // 29: wego_server.v1.GetChildObjectsRes
-	(*GetGithubDeviceCodeRequest)(nil),    // 30: wego_server.v1.GetGithubDeviceCodeRequest
-	(*GetGithubDeviceCodeResponse)(nil),   // 31: wego_server.v1.GetGithubDeviceCodeResponse
-	(*GetGithubAuthStatusRequest)(nil),    // 32: wego_server.v1.GetGithubAuthStatusRequest
-	(*GetGithubAuthStatusResponse)(nil),   // 33: wego_server.v1.GetGithubAuthStatusResponse
-	(*ParseRepoURLRequest)(nil),           // 34: wego_server.v1.ParseRepoURLRequest
-	(*ParseRepoURLResponse)(nil),          // 35: wego_server.v1.ParseRepoURLResponse
-	(*GetGitlabAuthURLRequest)(nil),       // 36: wego_server.v1.GetGitlabAuthURLRequest
-	(*GetGitlabAuthURLResponse)(nil),      // 37: wego_server.v1.GetGitlabAuthURLResponse
-	(*AuthorizeGitlabRequest)(nil),        // 38: wego_server.v1.AuthorizeGitlabRequest
-	(*AuthorizeGitlabResponse)(nil),       // 39: wego_server.v1.AuthorizeGitlabResponse
-	(*ValidateProviderTokenRequest)(nil),  // 40: wego_server.v1.ValidateProviderTokenRequest
-	(*ValidateProviderTokenResponse)(nil), // 41: wego_server.v1.ValidateProviderTokenResponse
+	(*HelmValuesReference)(nil),           // 7: wego_server.v1.HelmValuesReference
+	(*HelmChart)(nil),                     // 8: wego_server.v1.HelmChart
+	(*Source)(nil),                        // 9: wego_server.v1.Source
+	(*AuthenticateRequest)(nil),           // 10: wego_server.v1.AuthenticateRequest
+	(*AuthenticateResponse)(nil),          // 11: wego_server.v1.AuthenticateResponse
+	(*ListApplicationsRequest)(nil),       // 12: wego_server.v1.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),      // 13: wego_server.v1.ListApplicationsResponse
+	(*ClusterError)(nil),                  // 14: wego_server.v1.ClusterError
+	(*GetApplicationRequest)(nil),         // 15: wego_server.v1.GetApplicationRequest
+	(*ApplicationHealth)(nil),             // 16: wego_server.v1.ApplicationHealth
+	(*GetApplicationResponse)(nil),        // 17: wego_server.v1.GetApplicationResponse
+	(*AddApplicationRequest)(nil),         // 18: wego_server.v1.AddApplicationRequest
+	(*AddApplicationResponse)(nil),        // 19: wego_server.v1.AddApplicationResponse
+	(*RemoveApplicationRequest)(nil),      // 20: wego_server.v1.RemoveApplicationRequest
+	(*RemoveApplicationResponse)(nil),     // 21: wego_server.v1.RemoveApplicationResponse
+	(*SyncApplicationRequest)(nil),        // 22: wego_server.v1.SyncApplicationRequest
+	(*SyncApplicationResponse)(nil),       // 23: wego_server.v1.SyncApplicationResponse
+	(*SuspendApplicationRequest)(nil),     // 24: wego_server.v1.SuspendApplicationRequest
+	(*SuspendApplicationResponse)(nil),    // 25: wego_server.v1.SuspendApplicationResponse
+	(*ResumeApplicationRequest)(nil),      // 26: wego_server.v1.ResumeApplicationRequest
+	(*ResumeApplicationResponse)(nil),     // 27: wego_server.v1.ResumeApplicationResponse
+	(*RollbackApplicationRequest)(nil),    // 28: wego_server.v1.RollbackApplicationRequest
+	(*RollbackApplicationResponse)(nil),   // 29: wego_server.v1.RollbackApplicationResponse
+	(*UnpinApplicationRequest)(nil),       // 30: wego_server.v1.UnpinApplicationRequest
+	(*UnpinApplicationResponse)(nil),      // 31: wego_server.v1.UnpinApplicationResponse
+	(*Commit)(nil),                        // 32: wego_server.v1.Commit
+	(*ListCommitsRequest)(nil),            // 33: wego_server.v1.ListCommitsRequest
+	(*ListCommitsResponse)(nil),           // 34: wego_server.v1.ListCommitsResponse
+	(*GroupVersionKind)(nil),              // 35: wego_server.v1.GroupVersionKind
+	(*UnstructuredObject)(nil),            // 36: wego_server.v1.UnstructuredObject
+	(*GetReconciledObjectsReq)(nil),       // 37: wego_server.v1.GetReconciledObjectsReq
+	(*GetReconciledObjectsRes)(nil),       // 38: wego_server.v1.GetReconciledObjectsRes
+	(*GetChildObjectsReq)(nil),            // 39: wego_server.v1.GetChildObjectsReq
+	(*GetChildObjectsRes)(nil),            // 40: wego_server.v1.GetChildObjectsRes
+	(*ListApplicationEventsRequest)(nil),  // 41: wego_server.v1.ListApplicationEventsRequest
+	(*Event)(nil),                         // 42: wego_server.v1.Event
+	(*ListApplicationEventsResponse)(nil), // 43: wego_server.v1.ListApplicationEventsResponse
+	(*GetGithubDeviceCodeRequest)(nil),    // 44: wego_server.v1.GetGithubDeviceCodeRequest
+	(*GetGithubDeviceCodeResponse)(nil),   // 45: wego_server.v1.GetGithubDeviceCodeResponse
+	(*GetGithubAuthStatusRequest)(nil),    // 46: wego_server.v1.GetGithubAuthStatusRequest
+	(*GetGithubAuthStatusResponse)(nil),   // 47: wego_server.v1.GetGithubAuthStatusResponse
+	(*ParseRepoURLRequest)(nil),           // 48: wego_server.v1.ParseRepoURLRequest
+	(*ParseRepoURLResponse)(nil),          // 49: wego_server.v1.ParseRepoURLResponse
+	(*GetGitlabAuthURLRequest)(nil),       // 50: wego_server.v1.GetGitlabAuthURLRequest
+	(*GetGitlabAuthURLResponse)(nil),      // 51: wego_server.v1.GetGitlabAuthURLResponse
+	(*AuthorizeGitlabRequest)(nil),        // 52: wego_server.v1.AuthorizeGitlabRequest
+	(*AuthorizeGitlabResponse)(nil),       // 53: wego_server.v1.AuthorizeGitlabResponse
+	(*ValidateProviderTokenRequest)(nil),  // 54: wego_server.v1.ValidateProviderTokenRequest
+	(*ValidateProviderTokenResponse)(nil), // 55: wego_server.v1.ValidateProviderTokenResponse
+	(*WatchApplicationsRequest)(nil),      // 56: wego_server.v1.WatchApplicationsRequest
+	(*WatchApplicationsResponse)(nil),     // 57: wego_server.v1.WatchApplicationsResponse
+	(*StreamApplicationLogsRequest)(nil),  // 58: wego_server.v1.StreamApplicationLogsRequest
+	(*StreamApplicationLogsResponse)(nil), // 59: wego_server.v1.StreamApplicationLogsResponse
 }
 var file_api_applications_applications_proto_depIdxs = []int32{
 	3,  // 0: wego_server.v1.Application.source_conditions:type_name -> wego_server.v1.Condition
 	3,  // 1: wego_server.v1.Application.deployment_conditions:type_name -> wego_server.v1.Condition
 	0,  // 2: wego_server.v1.Application.deployment_type:type_name -> wego_server.v1.AutomationKind
-	24, // 3: wego_server.v1.Application.reconciled_object_kinds:type_name -> wego_server.v1.GroupVersionKind
+	35, // 3: wego_server.v1.Application.reconciled_object_kinds:type_name -> wego_server.v1.GroupVersionKind
 	5,  // 4: wego_server.v1.Application.kustomization:type_name -> wego_server.v1.Kustomization
 	6,  // 5: wego_server.v1.Application.helm_release:type_name -> wego_server.v1.HelmRelease
-	8,  // 6: wego_server.v1.Application.source:type_name -> wego_server.v1.Source
+	9,  // 6: wego_server.v1.Application.source:type_name -> wego_server.v1.Source
 	3,  // 7: wego_server.v1.Kustomization.conditions:type_name -> wego_server.v1.Condition
-	7,  // 8: wego_server.v1.HelmRelease.chart:type_name -> wego_server.v1.HelmChart
+	8,  // 8: wego_server.v1.HelmRelease.chart:type_name -> wego_server.v1.HelmChart
 	3,  // 9: wego_server.v1.HelmRelease.conditions:type_name -> wego_server.v1.Condition
-	2,  // 10: wego_server.v1.Source.type:type_name -> wego_server.v1.Source.Type
-	3,  // 11: wego_server.v1.Source.conditions:type_name -> wego_server.v1.Condition
-	4,  // 12: wego_server.v1.ListApplicationsResponse.applications:type_name -> wego_server.v1.Application
-	4,  // 13: wego_server.v1.GetApplicationResponse.application:type_name -> wego_server.v1.Application
-	4,  // 14: wego_server.v1.AddApplicationResponse.application:type_name -> wego_server.v1.Application
-	21, // 15: wego_server.v1.ListCommitsResponse.commits:type_name -> wego_server.v1.Commit
-	24, // 16: wego_server.v1.UnstructuredObject.groupVersionKind:type_name -> wego_server.v1.GroupVersionKind
-	0,  // 17: wego_server.v1.GetReconciledObjectsReq.automationKind:type_name -> wego_server.v1.AutomationKind
-	24, // 18: wego_server.v1.GetReconciledObjectsReq.kinds:type_name -> wego_server.v1.GroupVersionKind
-	25, // 19: wego_server.v1.GetReconciledObjectsRes.objects:type_name -> wego_server.v1.UnstructuredObject
-	24, // 20: wego_server.v1.GetChildObjectsReq.groupVersionKind:type_name -> wego_server.v1.GroupVersionKind
-	25, // 21: wego_server.v1.GetChildObjectsRes.objects:type_name -> wego_server.v1.UnstructuredObject
-	1,  // 22: wego_server.v1.ParseRepoURLResponse.provider:type_name -> wego_server.v1.GitProvider
-	1,  // 23: wego_server.v1.ValidateProviderTokenRequest.provider:type_name -> wego_server.v1.GitProvider
-	9,  // 24: wego_server.v1.Applications.Authenticate:input_type -> wego_server.v1.AuthenticateRequest
-	11, // 25: wego_server.v1.Applications.ListApplications:input_type -> wego_server.v1.ListApplicationsRequest
-	13, // 26: wego_server.v1.Applications.GetApplication:input_type -> wego_server.v1.GetApplicationRequest
-	22, // 27: wego_server.v1.Applications.ListCommits:input_type -> wego_server.v1.ListCommitsRequest
-	26, // 28: wego_server.v1.Applications.GetReconciledObjects:input_type -> wego_server.v1.GetReconciledObjectsReq
-	28, // 29: wego_server.v1.Applications.GetChildObjects:input_type -> wego_server.v1.GetChildObjectsReq
-	30, // 30: wego_server.v1.Applications.GetGithubDeviceCode:input_type -> wego_server.v1.GetGithubDeviceCodeRequest
-	32, // 31: wego_server.v1.Applications.GetGithubAuthStatus:input_type -> wego_server.v1.GetGithubAuthStatusRequest
-	36, // 32: wego_server.v1.Applications.GetGitlabAuthURL:input_type -> wego_server.v1.GetGitlabAuthURLRequest
-	38, // 33: wego_server.v1.Applications.AuthorizeGitlab:input_type -> wego_server.v1.AuthorizeGitlabRequest
-	15, // 34: wego_server.v1.Applications.AddApplication:input_type -> wego_server.v1.AddApplicationRequest
-	17, // 35: wego_server.v1.Applications.RemoveApplication:input_type -> wego_server.v1.RemoveApplicationRequest
-	19, // 36: wego_server.v1.Applications.SyncApplication:input_type -> wego_server.v1.SyncApplicationRequest
-	34, // 37: wego_server.v1.Applications.ParseRepoURL:input_type -> wego_server.v1.ParseRepoURLRequest
-	40, // 38: wego_server.v1.Applications.ValidateProviderToken:input_type -> wego_server.v1.ValidateProviderTokenRequest
-	10, // 39: wego_server.v1.Applications.Authenticate:output_type -> wego_server.v1.AuthenticateResponse
-	12, // 40: wego_server.v1.Applications.ListApplications:output_type -> wego_server.v1.ListApplicationsResponse
-	14, // 41: wego_server.v1.Applications.GetApplication:output_type -> wego_server.v1.GetApplicationResponse
-	23, // 42: wego_server.v1.Applications.ListCommits:output_type -> wego_server.v1.ListCommitsResponse
-	27, // 43: wego_server.v1.Applications.GetReconciledObjects:output_type -> wego_server.v1.GetReconciledObjectsRes
-	29, // 44: wego_server.v1.Applications.GetChildObjects:output_type -> wego_server.v1.GetChildObjectsRes
-	31, // 45: wego_server.v1.Applications.GetGithubDeviceCode:output_type -> wego_server.v1.GetGithubDeviceCodeResponse
-	33, // 46: wego_server.v1.Applications.GetGithubAuthStatus:output_type -> wego_server.v1.GetGithubAuthStatusResponse
-	37, // 47: wego_server.v1.Applications.GetGitlabAuthURL:output_type -> wego_server.v1.GetGitlabAuthURLResponse
-	39, // 48: wego_server.v1.Applications.AuthorizeGitlab:output_type -> wego_server.v1.AuthorizeGitlabResponse
-	16, // 49: wego_server.v1.Applications.AddApplication:output_type -> wego_server.v1.AddApplicationResponse
-	18, // 50: wego_server.v1.Applications.RemoveApplication:output_type -> wego_server.v1.RemoveApplicationResponse
-	20, // 51: wego_server.v1.Applications.SyncApplication:output_type -> wego_server.v1.SyncApplicationResponse
-	35, // 52: wego_server.v1.Applications.ParseRepoURL:output_type -> wego_server.v1.ParseRepoURLResponse
-	41, // 53: wego_server.v1.Applications.ValidateProviderToken:output_type -> wego_server.v1.ValidateProviderTokenResponse
-	39, // [39:54] is the sub-list for method output_type
-	24, // [24:39] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	7,  // 10: wego_server.v1.HelmRelease.valuesFrom:type_name -> wego_server.v1.HelmValuesReference
+	2,  // 11: wego_server.v1.Source.type:type_name -> wego_server.v1.Source.Type
+	3,  // 12: wego_server.v1.Source.conditions:type_name -> wego_server.v1.Condition
+	4,  // 13: wego_server.v1.ListApplicationsResponse.applications:type_name -> wego_server.v1.Application
+	14, // 14: wego_server.v1.ListApplicationsResponse.errors:type_name -> wego_server.v1.ClusterError
+	4,  // 15: wego_server.v1.GetApplicationResponse.application:type_name -> wego_server.v1.Application
+	16, // 16: wego_server.v1.GetApplicationResponse.health:type_name -> wego_server.v1.ApplicationHealth
+	4,  // 17: wego_server.v1.AddApplicationResponse.application:type_name -> wego_server.v1.Application
+	32, // 18: wego_server.v1.ListCommitsResponse.commits:type_name -> wego_server.v1.Commit
+	35, // 19: wego_server.v1.UnstructuredObject.groupVersionKind:type_name -> wego_server.v1.GroupVersionKind
+	0,  // 20: wego_server.v1.GetReconciledObjectsReq.automationKind:type_name -> wego_server.v1.AutomationKind
+	35, // 21: wego_server.v1.GetReconciledObjectsReq.kinds:type_name -> wego_server.v1.GroupVersionKind
+	36, // 22: wego_server.v1.GetReconciledObjectsRes.objects:type_name -> wego_server.v1.UnstructuredObject
+	35, // 23: wego_server.v1.GetChildObjectsReq.groupVersionKind:type_name -> wego_server.v1.GroupVersionKind
+	36, // 24: wego_server.v1.GetChildObjectsRes.objects:type_name -> wego_server.v1.UnstructuredObject
+	42, // 25: wego_server.v1.ListApplicationEventsResponse.events:type_name -> wego_server.v1.Event
+	1,  // 26: wego_server.v1.ParseRepoURLResponse.provider:type_name -> wego_server.v1.GitProvider
+	1,  // 27: wego_server.v1.ValidateProviderTokenRequest.provider:type_name -> wego_server.v1.GitProvider
+	3,  // 28: wego_server.v1.WatchApplicationsResponse.conditions:type_name -> wego_server.v1.Condition
+	10, // 29: wego_server.v1.Applications.Authenticate:input_type -> wego_server.v1.AuthenticateRequest
+	12, // 30: wego_server.v1.Applications.ListApplications:input_type -> wego_server.v1.ListApplicationsRequest
+	15, // 31: wego_server.v1.Applications.GetApplication:input_type -> wego_server.v1.GetApplicationRequest
+	33, // 32: wego_server.v1.Applications.ListCommits:input_type -> wego_server.v1.ListCommitsRequest
+	37, // 33: wego_server.v1.Applications.GetReconciledObjects:input_type -> wego_server.v1.GetReconciledObjectsReq
+	39, // 34: wego_server.v1.Applications.GetChildObjects:input_type -> wego_server.v1.GetChildObjectsReq
+	41, // 35: wego_server.v1.Applications.ListApplicationEvents:input_type -> wego_server.v1.ListApplicationEventsRequest
+	44, // 36: wego_server.v1.Applications.GetGithubDeviceCode:input_type -> wego_server.v1.GetGithubDeviceCodeRequest
+	46, // 37: wego_server.v1.Applications.GetGithubAuthStatus:input_type -> wego_server.v1.GetGithubAuthStatusRequest
+	50, // 38: wego_server.v1.Applications.GetGitlabAuthURL:input_type -> wego_server.v1.GetGitlabAuthURLRequest
+	52, // 39: wego_server.v1.Applications.AuthorizeGitlab:input_type -> wego_server.v1.AuthorizeGitlabRequest
+	18, // 40: wego_server.v1.Applications.AddApplication:input_type -> wego_server.v1.AddApplicationRequest
+	20, // 41: wego_server.v1.Applications.RemoveApplication:input_type -> wego_server.v1.RemoveApplicationRequest
+	22, // 42: wego_server.v1.Applications.SyncApplication:input_type -> wego_server.v1.SyncApplicationRequest
+	24, // 43: wego_server.v1.Applications.SuspendApplication:input_type -> wego_server.v1.SuspendApplicationRequest
+	26, // 44: wego_server.v1.Applications.ResumeApplication:input_type -> wego_server.v1.ResumeApplicationRequest
+	28, // 45: wego_server.v1.Applications.RollbackApplication:input_type -> wego_server.v1.RollbackApplicationRequest
+	30, // 46: wego_server.v1.Applications.UnpinApplication:input_type -> wego_server.v1.UnpinApplicationRequest
+	48, // 47: wego_server.v1.Applications.ParseRepoURL:input_type -> wego_server.v1.ParseRepoURLRequest
+	54, // 48: wego_server.v1.Applications.ValidateProviderToken:input_type -> wego_server.v1.ValidateProviderTokenRequest
+	56, // 49: wego_server.v1.Applications.WatchApplications:input_type -> wego_server.v1.WatchApplicationsRequest
+	58, // 50: wego_server.v1.Applications.StreamApplicationLogs:input_type -> wego_server.v1.StreamApplicationLogsRequest
+	11, // 51: wego_server.v1.Applications.Authenticate:output_type -> wego_server.v1.AuthenticateResponse
+	13, // 52: wego_server.v1.Applications.ListApplications:output_type -> wego_server.v1.ListApplicationsResponse
+	17, // 53: wego_server.v1.Applications.GetApplication:output_type -> wego_server.v1.GetApplicationResponse
+	34, // 54: wego_server.v1.Applications.ListCommits:output_type -> wego_server.v1.ListCommitsResponse
+	38, // 55: wego_server.v1.Applications.GetReconciledObjects:output_type -> wego_server.v1.GetReconciledObjectsRes
+	40, // 56: wego_server.v1.Applications.GetChildObjects:output_type -> wego_server.v1.GetChildObjectsRes
+	43, // 57: wego_server.v1.Applications.ListApplicationEvents:output_type -> wego_server.v1.ListApplicationEventsResponse
+	45, // 58: wego_server.v1.Applications.GetGithubDeviceCode:output_type -> wego_server.v1.GetGithubDeviceCodeResponse
+	47, // 59: wego_server.v1.Applications.GetGithubAuthStatus:output_type -> wego_server.v1.GetGithubAuthStatusResponse
+	51, // 60: wego_server.v1.Applications.GetGitlabAuthURL:output_type -> wego_server.v1.GetGitlabAuthURLResponse
+	53, // 61: wego_server.v1.Applications.AuthorizeGitlab:output_type -> wego_server.v1.AuthorizeGitlabResponse
+	19, // 62: wego_server.v1.Applications.AddApplication:output_type -> wego_server.v1.AddApplicationResponse
+	21, // 63: wego_server.v1.Applications.RemoveApplication:output_type -> wego_server.v1.RemoveApplicationResponse
+	23, // 64: wego_server.v1.Applications.SyncApplication:output_type -> wego_server.v1.SyncApplicationResponse
+	25, // 65: wego_server.v1.Applications.SuspendApplication:output_type -> wego_server.v1.SuspendApplicationResponse
+	27, // 66: wego_server.v1.Applications.ResumeApplication:output_type -> wego_server.v1.ResumeApplicationResponse
+	29, // 67: wego_server.v1.Applications.RollbackApplication:output_type -> wego_server.v1.RollbackApplicationResponse
+	31, // 68: wego_server.v1.Applications.UnpinApplication:output_type -> wego_server.v1.UnpinApplicationResponse
+	49, // 69: wego_server.v1.Applications.ParseRepoURL:output_type -> wego_server.v1.ParseRepoURLResponse
+	55, // 70: wego_server.v1.Applications.ValidateProviderToken:output_type -> wego_server.v1.ValidateProviderTokenResponse
+	57, // 71: wego_server.v1.Applications.WatchApplications:output_type -> wego_server.v1.WatchApplicationsResponse
+	59, // 72: wego_server.v1.Applications.StreamApplicationLogs:output_type -> wego_server.v1.StreamApplicationLogsResponse
+	51, // [51:73] is the sub-list for method output_type
+	29, // [29:51] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_api_applications_applications_proto_init() }
@@ -3250,7 +4874,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HelmChart); i {
+			switch v := v.(*HelmValuesReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3262,7 +4886,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Source); i {
+			switch v := v.(*HelmChart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3274,7 +4898,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateRequest); i {
+			switch v := v.(*Source); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3286,7 +4910,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthenticateResponse); i {
+			switch v := v.(*AuthenticateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3298,7 +4922,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplicationsRequest); i {
+			switch v := v.(*AuthenticateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3310,7 +4934,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListApplicationsResponse); i {
+			switch v := v.(*ListApplicationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3322,7 +4946,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationRequest); i {
+			switch v := v.(*ListApplicationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3334,7 +4958,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetApplicationResponse); i {
+			switch v := v.(*ClusterError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3346,7 +4970,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddApplicationRequest); i {
+			switch v := v.(*GetApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3358,7 +4982,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddApplicationResponse); i {
+			switch v := v.(*ApplicationHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3370,7 +4994,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveApplicationRequest); i {
+			switch v := v.(*GetApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3382,7 +5006,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveApplicationResponse); i {
+			switch v := v.(*AddApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3394,7 +5018,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncApplicationRequest); i {
+			switch v := v.(*AddApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3406,7 +5030,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SyncApplicationResponse); i {
+			switch v := v.(*RemoveApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3418,7 +5042,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Commit); i {
+			switch v := v.(*RemoveApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3430,7 +5054,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitsRequest); i {
+			switch v := v.(*SyncApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3442,7 +5066,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCommitsResponse); i {
+			switch v := v.(*SyncApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3454,7 +5078,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupVersionKind); i {
+			switch v := v.(*SuspendApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3466,7 +5090,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnstructuredObject); i {
+			switch v := v.(*SuspendApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3478,7 +5102,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReconciledObjectsReq); i {
+			switch v := v.(*ResumeApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3490,7 +5114,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReconciledObjectsRes); i {
+			switch v := v.(*ResumeApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3502,7 +5126,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChildObjectsReq); i {
+			switch v := v.(*RollbackApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3514,7 +5138,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetChildObjectsRes); i {
+			switch v := v.(*RollbackApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3526,7 +5150,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGithubDeviceCodeRequest); i {
+			switch v := v.(*UnpinApplicationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3538,7 +5162,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGithubDeviceCodeResponse); i {
+			switch v := v.(*UnpinApplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3550,7 +5174,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGithubAuthStatusRequest); i {
+			switch v := v.(*Commit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3562,7 +5186,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGithubAuthStatusResponse); i {
+			switch v := v.(*ListCommitsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3574,7 +5198,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParseRepoURLRequest); i {
+			switch v := v.(*ListCommitsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3586,7 +5210,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParseRepoURLResponse); i {
+			switch v := v.(*GroupVersionKind); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3598,7 +5222,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGitlabAuthURLRequest); i {
+			switch v := v.(*UnstructuredObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3610,7 +5234,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGitlabAuthURLResponse); i {
+			switch v := v.(*GetReconciledObjectsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3622,7 +5246,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeGitlabRequest); i {
+			switch v := v.(*GetReconciledObjectsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3634,7 +5258,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthorizeGitlabResponse); i {
+			switch v := v.(*GetChildObjectsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3646,7 +5270,7 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateProviderTokenRequest); i {
+			switch v := v.(*GetChildObjectsRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3658,6 +5282,174 @@ func file_api_applications_applications_proto_init() {
 			}
 		}
 		file_api_applications_applications_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApplicationEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGithubDeviceCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGithubDeviceCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGithubAuthStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGithubAuthStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseRepoURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseRepoURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGitlabAuthURLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGitlabAuthURLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeGitlabRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeGitlabResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateProviderTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ValidateProviderTokenResponse); i {
 			case 0:
 				return &v.state
@@ -3669,15 +5461,63 @@ func file_api_applications_applications_proto_init() {
 				return nil
 			}
 		}
+		file_api_applications_applications_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchApplicationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchApplicationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamApplicationLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_applications_applications_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamApplicationLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_applications_applications_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_api_applications_applications_proto_msgTypes[30].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_applications_applications_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   39,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,6 +40,10 @@ type ApplicationsClient interface {
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(ctx context.Context, in *GetChildObjectsReq, opts ...grpc.CallOption) (*GetChildObjectsRes, error)
 	//
+	// ListApplicationEvents returns the Kubernetes events about an application, its Flux objects and the objects
+	// they reconciled, oldest first.
+	ListApplicationEvents(ctx context.Context, in *ListApplicationEventsRequest, opts ...grpc.CallOption) (*ListApplicationEventsResponse, error)
+	//
 	// GetGithubDeviceCode retrieves a temporary device code for Github authentication.
 	// This code is used to start the Github device-flow.
 	GetGithubDeviceCode(ctx context.Context, in *GetGithubDeviceCodeRequest, opts ...grpc.CallOption) (*GetGithubDeviceCodeResponse, error)
@@ -71,11 +75,29 @@ type ApplicationsClient interface {
 	// SyncApplication triggers the Application reconciliation loop.
 	SyncApplication(ctx context.Context, in *SyncApplicationRequest, opts ...grpc.CallOption) (*SyncApplicationResponse, error)
 	//
+	// SuspendApplication suspends the reconciliation of an Application's source and Kustomization or HelmRelease.
+	SuspendApplication(ctx context.Context, in *SuspendApplicationRequest, opts ...grpc.CallOption) (*SuspendApplicationResponse, error)
+	//
+	// ResumeApplication resumes the reconciliation of an Application's source and Kustomization or HelmRelease.
+	ResumeApplication(ctx context.Context, in *ResumeApplicationRequest, opts ...grpc.CallOption) (*ResumeApplicationResponse, error)
+	//
+	// RollbackApplication pins the source of an Application to a commit or tag via GitOps.
+	RollbackApplication(ctx context.Context, in *RollbackApplicationRequest, opts ...grpc.CallOption) (*RollbackApplicationResponse, error)
+	//
+	// UnpinApplication makes the source of an Application track its branch again via GitOps.
+	UnpinApplication(ctx context.Context, in *UnpinApplicationRequest, opts ...grpc.CallOption) (*UnpinApplicationResponse, error)
+	//
 	// ParseRepoURL returns structured data about a git repository URL
 	ParseRepoURL(ctx context.Context, in *ParseRepoURLRequest, opts ...grpc.CallOption) (*ParseRepoURLResponse, error)
 	//
 	// ValidateProviderToken check to see if the git provider token is still valid
 	ValidateProviderToken(ctx context.Context, in *ValidateProviderTokenRequest, opts ...grpc.CallOption) (*ValidateProviderTokenResponse, error)
+	//
+	// WatchApplications streams changes to applications and the Flux objects generated for them
+	WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error)
+	//
+	// StreamApplicationLogs streams the logs of the containers of the pods of the workloads an application reconciled
+	StreamApplicationLogs(ctx context.Context, in *StreamApplicationLogsRequest, opts ...grpc.CallOption) (Applications_StreamApplicationLogsClient, error)
 }
 
 type applicationsClient struct {
@@ -140,6 +162,15 @@ func (c *applicationsClient) GetChildObjects(ctx context.Context, in *GetChildOb
 	return out, nil
 }
 
+func (c *applicationsClient) ListApplicationEvents(ctx context.Context, in *ListApplicationEventsRequest, opts ...grpc.CallOption) (*ListApplicationEventsResponse, error) {
+	out := new(ListApplicationEventsResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ListApplicationEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) GetGithubDeviceCode(ctx context.Context, in *GetGithubDeviceCodeRequest, opts ...grpc.CallOption) (*GetGithubDeviceCodeResponse, error) {
 	out := new(GetGithubDeviceCodeResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/GetGithubDeviceCode", in, out, opts...)
@@ -203,6 +234,42 @@ func (c *applicationsClient) SyncApplication(ctx context.Context, in *SyncApplic
 	return out, nil
 }
 
+func (c *applicationsClient) SuspendApplication(ctx context.Context, in *SuspendApplicationRequest, opts ...grpc.CallOption) (*SuspendApplicationResponse, error) {
+	out := new(SuspendApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/SuspendApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) ResumeApplication(ctx context.Context, in *ResumeApplicationRequest, opts ...grpc.CallOption) (*ResumeApplicationResponse, error) {
+	out := new(ResumeApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ResumeApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) RollbackApplication(ctx context.Context, in *RollbackApplicationRequest, opts ...grpc.CallOption) (*RollbackApplicationResponse, error) {
+	out := new(RollbackApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/RollbackApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationsClient) UnpinApplication(ctx context.Context, in *UnpinApplicationRequest, opts ...grpc.CallOption) (*UnpinApplicationResponse, error) {
+	out := new(UnpinApplicationResponse)
+	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/UnpinApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationsClient) ParseRepoURL(ctx context.Context, in *ParseRepoURLRequest, opts ...grpc.CallOption) (*ParseRepoURLResponse, error) {
 	out := new(ParseRepoURLResponse)
 	err := c.cc.Invoke(ctx, "/wego_server.v1.Applications/ParseRepoURL", in, out, opts...)
@@ -221,6 +288,70 @@ func (c *applicationsClient) ValidateProviderToken(ctx context.Context, in *Vali
 	return out, nil
 }
 
+func (c *applicationsClient) WatchApplications(ctx context.Context, in *WatchApplicationsRequest, opts ...grpc.CallOption) (Applications_WatchApplicationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[0], "/wego_server.v1.Applications/WatchApplications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsWatchApplicationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_WatchApplicationsClient interface {
+	Recv() (*WatchApplicationsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationsWatchApplicationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsWatchApplicationsClient) Recv() (*WatchApplicationsResponse, error) {
+	m := new(WatchApplicationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationsClient) StreamApplicationLogs(ctx context.Context, in *StreamApplicationLogsRequest, opts ...grpc.CallOption) (Applications_StreamApplicationLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Applications_ServiceDesc.Streams[1], "/wego_server.v1.Applications/StreamApplicationLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationsStreamApplicationLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Applications_StreamApplicationLogsClient interface {
+	Recv() (*StreamApplicationLogsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationsStreamApplicationLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationsStreamApplicationLogsClient) Recv() (*StreamApplicationLogsResponse, error) {
+	m := new(StreamApplicationLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ApplicationsServer is the server API for Applications service.
 // All implementations must embed UnimplementedApplicationsServer
 // for forward compatibility
@@ -247,6 +378,10 @@ type ApplicationsServer interface {
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(context.Context, *GetChildObjectsReq) (*GetChildObjectsRes, error)
 	//
+	// ListApplicationEvents returns the Kubernetes events about an application, its Flux objects and the objects
+	// they reconciled, oldest first.
+	ListApplicationEvents(context.Context, *ListApplicationEventsRequest) (*ListApplicationEventsResponse, error)
+	//
 	// GetGithubDeviceCode retrieves a temporary device code for Github authentication.
 	// This code is used to start the Github device-flow.
 	GetGithubDeviceCode(context.Context, *GetGithubDeviceCodeRequest) (*GetGithubDeviceCodeResponse, error)
@@ -278,11 +413,29 @@ type ApplicationsServer interface {
 	// SyncApplication triggers the Application reconciliation loop.
 	SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error)
 	//
+	// SuspendApplication suspends the reconciliation of an Application's source and Kustomization or HelmRelease.
+	SuspendApplication(context.Context, *SuspendApplicationRequest) (*SuspendApplicationResponse, error)
+	//
+	// ResumeApplication resumes the reconciliation of an Application's source and Kustomization or HelmRelease.
+	ResumeApplication(context.Context, *ResumeApplicationRequest) (*ResumeApplicationResponse, error)
+	//
+	// RollbackApplication pins the source of an Application to a commit or tag via GitOps.
+	RollbackApplication(context.Context, *RollbackApplicationRequest) (*RollbackApplicationResponse, error)
+	//
+	// UnpinApplication makes the source of an Application track its branch again via GitOps.
+	UnpinApplication(context.Context, *UnpinApplicationRequest) (*UnpinApplicationResponse, error)
+	//
 	// ParseRepoURL returns structured data about a git repository URL
 	ParseRepoURL(context.Context, *ParseRepoURLRequest) (*ParseRepoURLResponse, error)
 	//
 	// ValidateProviderToken check to see if the git provider token is still valid
 	ValidateProviderToken(context.Context, *ValidateProviderTokenRequest) (*ValidateProviderTokenResponse, error)
+	//
+	// WatchApplications streams changes to applications and the Flux objects generated for them
+	WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error
+	//
+	// StreamApplicationLogs streams the logs of the containers of the pods of the workloads an application reconciled
+	StreamApplicationLogs(*StreamApplicationLogsRequest, Applications_StreamApplicationLogsServer) error
 	mustEmbedUnimplementedApplicationsServer()
 }
 
@@ -308,6 +461,9 @@ func (UnimplementedApplicationsServer) GetReconciledObjects(context.Context, *Ge
 func (UnimplementedApplicationsServer) GetChildObjects(context.Context, *GetChildObjectsReq) (*GetChildObjectsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChildObjects not implemented")
 }
+func (UnimplementedApplicationsServer) ListApplicationEvents(context.Context, *ListApplicationEventsRequest) (*ListApplicationEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplicationEvents not implemented")
+}
 func (UnimplementedApplicationsServer) GetGithubDeviceCode(context.Context, *GetGithubDeviceCodeRequest) (*GetGithubDeviceCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGithubDeviceCode not implemented")
 }
@@ -329,12 +485,30 @@ func (UnimplementedApplicationsServer) RemoveApplication(context.Context, *Remov
 func (UnimplementedApplicationsServer) SyncApplication(context.Context, *SyncApplicationRequest) (*SyncApplicationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncApplication not implemented")
 }
+func (UnimplementedApplicationsServer) SuspendApplication(context.Context, *SuspendApplicationRequest) (*SuspendApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendApplication not implemented")
+}
+func (UnimplementedApplicationsServer) ResumeApplication(context.Context, *ResumeApplicationRequest) (*ResumeApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeApplication not implemented")
+}
+func (UnimplementedApplicationsServer) RollbackApplication(context.Context, *RollbackApplicationRequest) (*RollbackApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackApplication not implemented")
+}
+func (UnimplementedApplicationsServer) UnpinApplication(context.Context, *UnpinApplicationRequest) (*UnpinApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinApplication not implemented")
+}
 func (UnimplementedApplicationsServer) ParseRepoURL(context.Context, *ParseRepoURLRequest) (*ParseRepoURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseRepoURL not implemented")
 }
 func (UnimplementedApplicationsServer) ValidateProviderToken(context.Context, *ValidateProviderTokenRequest) (*ValidateProviderTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateProviderToken not implemented")
 }
+func (UnimplementedApplicationsServer) WatchApplications(*WatchApplicationsRequest, Applications_WatchApplicationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchApplications not implemented")
+}
+func (UnimplementedApplicationsServer) StreamApplicationLogs(*StreamApplicationLogsRequest, Applications_StreamApplicationLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamApplicationLogs not implemented")
+}
 func (UnimplementedApplicationsServer) mustEmbedUnimplementedApplicationsServer() {}
 
 // UnsafeApplicationsServer may be embedded to opt out of forward compatibility for this service.
@@ -456,6 +630,24 @@ func _Applications_GetChildObjects_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_ListApplicationEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ListApplicationEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/ListApplicationEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ListApplicationEvents(ctx, req.(*ListApplicationEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_GetGithubDeviceCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetGithubDeviceCodeRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +774,78 @@ func _Applications_SyncApplication_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_SuspendApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).SuspendApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/SuspendApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).SuspendApplication(ctx, req.(*SuspendApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_ResumeApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).ResumeApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/ResumeApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).ResumeApplication(ctx, req.(*ResumeApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_RollbackApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).RollbackApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/RollbackApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).RollbackApplication(ctx, req.(*RollbackApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Applications_UnpinApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationsServer).UnpinApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wego_server.v1.Applications/UnpinApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationsServer).UnpinApplication(ctx, req.(*UnpinApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Applications_ParseRepoURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParseRepoURLRequest)
 	if err := dec(in); err != nil {
@@ -618,6 +882,48 @@ func _Applications_ValidateProviderToken_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Applications_WatchApplications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchApplicationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).WatchApplications(m, &applicationsWatchApplicationsServer{stream})
+}
+
+type Applications_WatchApplicationsServer interface {
+	Send(*WatchApplicationsResponse) error
+	grpc.ServerStream
+}
+
+type applicationsWatchApplicationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsWatchApplicationsServer) Send(m *WatchApplicationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Applications_StreamApplicationLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamApplicationLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationsServer).StreamApplicationLogs(m, &applicationsStreamApplicationLogsServer{stream})
+}
+
+type Applications_StreamApplicationLogsServer interface {
+	Send(*StreamApplicationLogsResponse) error
+	grpc.ServerStream
+}
+
+type applicationsStreamApplicationLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationsStreamApplicationLogsServer) Send(m *StreamApplicationLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Applications_ServiceDesc is the grpc.ServiceDesc for Applications service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -649,6 +955,10 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChildObjects",
 			Handler:    _Applications_GetChildObjects_Handler,
 		},
+		{
+			MethodName: "ListApplicationEvents",
+			Handler:    _Applications_ListApplicationEvents_Handler,
+		},
 		{
 			MethodName: "GetGithubDeviceCode",
 			Handler:    _Applications_GetGithubDeviceCode_Handler,
@@ -677,6 +987,22 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncApplication",
 			Handler:    _Applications_SyncApplication_Handler,
 		},
+		{
+			MethodName: "SuspendApplication",
+			Handler:    _Applications_SuspendApplication_Handler,
+		},
+		{
+			MethodName: "ResumeApplication",
+			Handler:    _Applications_ResumeApplication_Handler,
+		},
+		{
+			MethodName: "RollbackApplication",
+			Handler:    _Applications_RollbackApplication_Handler,
+		},
+		{
+			MethodName: "UnpinApplication",
+			Handler:    _Applications_UnpinApplication_Handler,
+		},
 		{
 			MethodName: "ParseRepoURL",
 			Handler:    _Applications_ParseRepoURL_Handler,
@@ -686,6 +1012,17 @@ var Applications_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Applications_ValidateProviderToken_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchApplications",
+			Handler:       _Applications_WatchApplications_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamApplicationLogs",
+			Handler:       _Applications_StreamApplicationLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/applications/applications.proto",
 }
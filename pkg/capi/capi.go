@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	"github.com/weaveworks/weave-gitops/pkg/output"
 )
 
 // TemplatesRetriever defines the interface that adapters
@@ -39,17 +41,17 @@ type CredentialsRetriever interface {
 }
 
 type Template struct {
-	Name        string
-	Description string
-	Provider    string
-	Error       string
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Provider    string `json:"provider"`
+	Error       string `json:"error,omitempty"`
 }
 
 type TemplateParameter struct {
-	Name        string
-	Description string
-	Required    bool
-	Options     []string
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Required    bool     `json:"required"`
+	Options     []string `json:"options,omitempty"`
 }
 
 type Credentials struct {
@@ -81,49 +83,48 @@ type CreatePullRequestFromTemplateParams struct {
 }
 
 type Profile struct {
-	Name              string
-	Home              string
-	Sources           []string
-	Description       string
-	Keywords          []string
-	Maintainers       []Maintainer
-	Icon              string
-	Annotations       map[string]string
-	KubeVersion       string
-	HelmRepository    HelmRepository
-	AvailableVersions []string
+	Name              string            `json:"name"`
+	Home              string            `json:"home,omitempty"`
+	Sources           []string          `json:"sources,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Keywords          []string          `json:"keywords,omitempty"`
+	Maintainers       []Maintainer      `json:"maintainers,omitempty"`
+	Icon              string            `json:"icon,omitempty"`
+	Annotations       map[string]string `json:"annotations,omitempty"`
+	KubeVersion       string            `json:"kubeVersion,omitempty"`
+	HelmRepository    HelmRepository    `json:"helmRepository"`
+	AvailableVersions []string          `json:"availableVersions"`
 }
 
 type HelmRepository struct {
-	Name      string
-	Namespace string
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
 }
 
 type Maintainer struct {
-	Name  string
-	Email string
-	Url   string
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Url   string `json:"url,omitempty"`
 }
 
 // GetTemplates uses a TemplatesRetriever adapter to show
 // a list of templates to the console.
-func GetTemplates(r TemplatesRetriever, w io.Writer) error {
+func GetTemplates(r TemplatesRetriever, w io.Writer, o output.Options) error {
 	ts, err := r.RetrieveTemplates()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve templates from %q: %w", r.Source(), err)
 	}
 
-	if len(ts) > 0 {
-		fmt.Fprintf(w, "NAME\tPROVIDER\tDESCRIPTION\tERROR\n")
-
-		for _, t := range ts {
-			fmt.Fprintf(w, "%s", t.Name)
-			fmt.Fprintf(w, "\t%s", t.Provider)
-			fmt.Fprintf(w, "\t%s", t.Description)
-			fmt.Fprintf(w, "\t%s", t.Error)
-			fmt.Fprintln(w, "")
+	if !o.IsTable() {
+		if ts == nil {
+			ts = []Template{}
 		}
 
+		return o.Print(w, ts)
+	}
+
+	if len(ts) > 0 {
+		printTemplates(ts, w)
 		return nil
 	}
 
@@ -134,23 +135,22 @@ func GetTemplates(r TemplatesRetriever, w io.Writer) error {
 
 // GetTemplatesByProvider uses a TemplatesRetriever adapter to show
 // a list of templates for a given provider to the console.
-func GetTemplatesByProvider(provider string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplatesByProvider(provider string, r TemplatesRetriever, w io.Writer, o output.Options) error {
 	ts, err := r.RetrieveTemplatesByProvider(provider)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve templates from %q: %w", r.Source(), err)
 	}
 
-	if len(ts) > 0 {
-		fmt.Fprintf(w, "NAME\tPROVIDER\tDESCRIPTION\tERROR\n")
-
-		for _, t := range ts {
-			fmt.Fprintf(w, "%s", t.Name)
-			fmt.Fprintf(w, "\t%s", t.Provider)
-			fmt.Fprintf(w, "\t%s", t.Description)
-			fmt.Fprintf(w, "\t%s", t.Error)
-			fmt.Fprintln(w, "")
+	if !o.IsTable() {
+		if ts == nil {
+			ts = []Template{}
 		}
 
+		return o.Print(w, ts)
+	}
+
+	if len(ts) > 0 {
+		printTemplates(ts, w)
 		return nil
 	}
 
@@ -161,12 +161,20 @@ func GetTemplatesByProvider(provider string, r TemplatesRetriever, w io.Writer)
 
 // GetTemplateParameters uses a TemplatesRetriever adapter
 // to show a list of parameters for a given template.
-func GetTemplateParameters(name string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplateParameters(name string, r TemplatesRetriever, w io.Writer, o output.Options) error {
 	ps, err := r.RetrieveTemplateParameters(name)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve parameters for template %q from %q: %w", name, r.Source(), err)
 	}
 
+	if !o.IsTable() {
+		if ps == nil {
+			ps = []TemplateParameter{}
+		}
+
+		return o.Print(w, ps)
+	}
+
 	if len(ps) > 0 {
 		fmt.Fprintf(w, "NAME\tREQUIRED\tDESCRIPTION\tOPTIONS\n")
 
@@ -227,20 +235,39 @@ func CreatePullRequestFromTemplate(params CreatePullRequestFromTemplateParams, r
 
 // GetCredentials uses a CredentialsRetriever adapter to show
 // a list of CAPI credentials.
-func GetCredentials(r CredentialsRetriever, w io.Writer) error {
+func GetCredentials(r CredentialsRetriever, w io.Writer, o output.Options) error {
 	cs, err := r.RetrieveCredentials()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve credentials from %q: %w", r.Source(), err)
 	}
 
+	if !o.IsTable() {
+		if cs == nil {
+			cs = []Credentials{}
+		}
+
+		return o.Print(w, cs)
+	}
+
 	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tINFRASTRUCTURE PROVIDER\n")
+		fmt.Fprintf(w, "NAME\tINFRASTRUCTURE PROVIDER")
+
+		if o.IsWide() {
+			fmt.Fprintf(w, "\tNAMESPACE\tKIND\tAPI VERSION")
+		}
+
+		fmt.Fprintln(w, "")
 
 		for _, c := range cs {
 			fmt.Fprintf(w, "%s", c.Name)
 			// Extract the infra provider name from ClusterKind
 			provider := c.Kind[:strings.Index(c.Kind, "Cluster")]
 			fmt.Fprintf(w, "\t%s", provider)
+
+			if o.IsWide() {
+				fmt.Fprintf(w, "\t%s\t%s\t%s/%s", c.Namespace, c.Kind, c.Group, c.Version)
+			}
+
 			fmt.Fprintln(w, "")
 		}
 
@@ -254,17 +281,32 @@ func GetCredentials(r CredentialsRetriever, w io.Writer) error {
 
 // GetTemplateProfiles uses a TemplatesRetriever adapter
 // to show a list of profiles for a given template.
-func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer) error {
+func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer, o output.Options) error {
 	ps, err := r.RetrieveTemplateProfiles(name)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve profiles for template %q from %q: %w", name, r.Source(), err)
 	}
 
+	if !o.IsTable() {
+		if ps == nil {
+			ps = []Profile{}
+		}
+
+		return o.Print(w, ps)
+	}
+
 	if len(ps) > 0 {
-		fmt.Fprintf(w, "NAME\tLATEST_VERSIONS\n")
+		fmt.Fprintf(w, "NAME\tLATEST_VERSIONS")
+
+		if o.IsWide() {
+			fmt.Fprintf(w, "\tDESCRIPTION\tHELM_REPOSITORY")
+		}
+
+		fmt.Fprintln(w, "")
 
 		for _, p := range ps {
-			if len(p.AvailableVersions) > 5 {
+			// The wide output shows all versions
+			if len(p.AvailableVersions) > 5 && !o.IsWide() {
 				p.AvailableVersions = p.AvailableVersions[len(p.AvailableVersions)-5:]
 			}
 
@@ -272,6 +314,11 @@ func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer) error {
 
 			fmt.Fprintf(w, "%s", p.Name)
 			fmt.Fprintf(w, "\t%s", latestVersions)
+
+			if o.IsWide() {
+				fmt.Fprintf(w, "\t%s\t%s/%s", p.Description, p.HelmRepository.Namespace, p.HelmRepository.Name)
+			}
+
 			fmt.Fprintln(w, "")
 		}
 
@@ -282,3 +329,15 @@ func GetTemplateProfiles(name string, r TemplatesRetriever, w io.Writer) error {
 
 	return nil
 }
+
+func printTemplates(ts []Template, w io.Writer) {
+	fmt.Fprintf(w, "NAME\tPROVIDER\tDESCRIPTION\tERROR\n")
+
+	for _, t := range ts {
+		fmt.Fprintf(w, "%s", t.Name)
+		fmt.Fprintf(w, "\t%s", t.Provider)
+		fmt.Fprintf(w, "\t%s", t.Description)
+		fmt.Fprintf(w, "\t%s", t.Error)
+		fmt.Fprintln(w, "")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaveworks/weave-gitops/pkg/capi"
+	"github.com/weaveworks/weave-gitops/pkg/output"
 )
 
 func TestGetTemplates(t *testing.T) {
@@ -63,7 +64,7 @@ func TestGetTemplates(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(tt.ts, nil, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplates(c, w)
+			err := capi.GetTemplates(c, w, output.Options{})
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -131,7 +132,7 @@ func TestGetTemplatesByProvider(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(tt.ts, nil, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplatesByProvider(tt.provider, c, w)
+			err := capi.GetTemplatesByProvider(tt.provider, c, w, output.Options{})
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -192,7 +193,7 @@ func TestGetTemplateParameters(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, tt.tps, nil, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplateParameters("foo", c, w)
+			err := capi.GetTemplateParameters("foo", c, w, output.Options{})
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -307,6 +308,7 @@ func TestGetCredentials(t *testing.T) {
 	tests := []struct {
 		name             string
 		creds            []capi.Credentials
+		opts             output.Options
 		err              error
 		expected         string
 		expectedErrorStr string
@@ -329,6 +331,34 @@ func TestGetCredentials(t *testing.T) {
 			},
 			expected: "NAME\tINFRASTRUCTURE PROVIDER\ncreds-a\tAWS\ncreds-b\tAzure\n",
 		},
+		{
+			name: "credentials found with wide output",
+			creds: []capi.Credentials{
+				{
+					Group:     "infrastructure.cluster.x-k8s.io",
+					Version:   "v1alpha3",
+					Name:      "creds-a",
+					Namespace: "default",
+					Kind:      "AWSCluster",
+				},
+			},
+			opts:     output.Options{Format: output.FormatWide},
+			expected: "NAME\tINFRASTRUCTURE PROVIDER\tNAMESPACE\tKIND\tAPI VERSION\ncreds-a\tAWS\tdefault\tAWSCluster\tinfrastructure.cluster.x-k8s.io/v1alpha3\n",
+		},
+		{
+			name: "credentials found with jsonpath output",
+			creds: []capi.Credentials{
+				{Name: "creds-a", Kind: "AWSCluster"},
+				{Name: "creds-b", Kind: "AzureCluster"},
+			},
+			opts:     output.Options{Format: output.FormatJSONPath, Template: "{[*].kind}"},
+			expected: "AWSCluster AzureCluster",
+		},
+		{
+			name:     "no credentials with json output",
+			opts:     output.Options{Format: output.FormatJSON},
+			expected: "[]\n",
+		},
 		{
 			name:             "error retrieving templates",
 			err:              fmt.Errorf("oops something went wrong"),
@@ -340,7 +370,7 @@ func TestGetCredentials(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, nil, tt.creds, nil, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetCredentials(c, w)
+			err := capi.GetCredentials(c, w, tt.opts)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -399,7 +429,7 @@ func TestGetTemplateProfiles(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := newFakeClient(nil, nil, nil, tt.fs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := capi.GetTemplateProfiles("profile-b", c, w)
+			err := capi.GetTemplateProfiles("profile-b", c, w, output.Options{})
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
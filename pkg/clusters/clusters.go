@@ -3,6 +3,8 @@ package clusters
 import (
 	"fmt"
 	"io"
+
+	"github.com/weaveworks/weave-gitops/pkg/output"
 )
 
 // ClustersRetriever defines the interface that adapters
@@ -27,17 +29,25 @@ type PullRequest struct {
 
 // GetClusters uses a ClustersRetriever adapter to show
 // a list of clusters to the console.
-func GetClusters(r ClustersRetriever, w io.Writer) error {
+func GetClusters(r ClustersRetriever, w io.Writer, o output.Options) error {
 	cs, err := r.RetrieveClusters()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
 
+	if !o.IsTable() {
+		if cs == nil {
+			cs = []Cluster{}
+		}
+
+		return o.Print(w, cs)
+	}
+
 	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\n")
+		printHeader(w, o)
 
 		for _, c := range cs {
-			printCluster(c, w)
+			printCluster(c, w, o)
 		}
 
 		return nil
@@ -50,18 +60,28 @@ func GetClusters(r ClustersRetriever, w io.Writer) error {
 
 // GetClusterByName uses a ClustersRetriever adapter to show
 // a cluster to the console given its name.
-func GetClusterByName(name string, r ClustersRetriever, w io.Writer) error {
+func GetClusterByName(name string, r ClustersRetriever, w io.Writer, o output.Options) error {
 	cs, err := r.RetrieveClusters()
 	if err != nil {
 		return fmt.Errorf("unable to retrieve clusters from %q: %w", r.Source(), err)
 	}
 
+	if !o.IsTable() {
+		for _, c := range cs {
+			if c.Name == name {
+				return o.Print(w, c)
+			}
+		}
+
+		return fmt.Errorf("cluster %q not found", name)
+	}
+
 	if len(cs) > 0 {
-		fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\n")
+		printHeader(w, o)
 
 		for _, c := range cs {
 			if c.Name == name {
-				printCluster(c, w)
+				printCluster(c, w, o)
 			}
 		}
 
@@ -106,17 +126,30 @@ type DeleteClustersParams struct {
 	CommitMessage    string
 }
 
-func printCluster(c Cluster, w io.Writer) {
+func printHeader(w io.Writer, o output.Options) {
+	if o.IsWide() {
+		fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\tPULL_REQUEST_TYPE\n")
+		return
+	}
+
+	fmt.Fprintf(w, "NAME\tSTATUS\tSTATUS_MESSAGE\n")
+}
+
+func printCluster(c Cluster, w io.Writer, o output.Options) {
 	if c.Status == "pullRequestCreated" && c.PullRequest.Type == "create" {
 		c.Status = "Creation PR"
 	} else if c.PullRequest.Type == "delete" {
 		c.Status = "Deletion PR"
 	}
 
-	if c.Status == "Creation PR" || c.Status == "Deletion PR" {
+	switch {
+	case o.IsWide():
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s", c.Name, c.Status, c.PullRequest.Url, c.PullRequest.Type)
+		fmt.Fprintln(w, "")
+	case c.Status == "Creation PR" || c.Status == "Deletion PR":
 		fmt.Fprintf(w, "%s\t%s\t%s", c.Name, c.Status, c.PullRequest.Url)
 		fmt.Fprintln(w, "")
-	} else {
+	default:
 		fmt.Fprintf(w, "%s\t%s", c.Name, c.Status)
 		fmt.Fprintln(w, "")
 	}
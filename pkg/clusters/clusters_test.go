@@ -8,12 +8,14 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaveworks/weave-gitops/pkg/clusters"
+	"github.com/weaveworks/weave-gitops/pkg/output"
 )
 
 func TestGetClusters(t *testing.T) {
 	tests := []struct {
 		name             string
 		cs               []clusters.Cluster
+		opts             output.Options
 		err              error
 		expected         string
 		expectedErrorStr string
@@ -83,13 +85,47 @@ func TestGetClusters(t *testing.T) {
 			},
 			expected: "NAME\tSTATUS\tSTATUS_MESSAGE\ncluster-a\tCreation PR\thttps://github.com/org/repo/pull/1\ncluster-b\tfoo\n",
 		},
+		{
+			name: "wide output",
+			cs: []clusters.Cluster{
+				{
+					Name:   "cluster-a",
+					Status: "pullRequestCreated",
+					PullRequest: clusters.PullRequest{
+						Type: "create",
+						Url:  "https://github.com/org/repo/pull/1",
+					},
+				},
+				{
+					Name:   "cluster-b",
+					Status: "foo",
+				},
+			},
+			opts:     output.Options{Format: output.FormatWide},
+			expected: "NAME\tSTATUS\tSTATUS_MESSAGE\tPULL_REQUEST_TYPE\ncluster-a\tCreation PR\thttps://github.com/org/repo/pull/1\tcreate\ncluster-b\tfoo\t\t\n",
+		},
+		{
+			name: "yaml output",
+			cs: []clusters.Cluster{
+				{
+					Name:   "cluster-a",
+					Status: "pullRequestCreated",
+					PullRequest: clusters.PullRequest{
+						Type: "create",
+						Url:  "https://github.com/org/repo/pull/1",
+					},
+				},
+			},
+			opts:     output.Options{Format: output.FormatYAML},
+			expected: "- name: cluster-a\n  pullRequest:\n    type: create\n    url: https://github.com/org/repo/pull/1\n  status: pullRequestCreated\n",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewFakeClient(tt.cs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := clusters.GetClusters(c, w)
+			err := clusters.GetClusters(c, w, tt.opts)
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
@@ -143,7 +179,7 @@ func TestGetClusterByName(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			c := NewFakeClient(tt.cs, "", tt.err)
 			w := new(bytes.Buffer)
-			err := clusters.GetClusterByName(tt.clusterName, c, w)
+			err := clusters.GetClusterByName(tt.clusterName, c, w, output.Options{})
 			assert.Equal(t, tt.expected, w.String())
 			if err != nil {
 				assert.EqualError(t, err, tt.expectedErrorStr)
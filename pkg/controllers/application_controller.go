@@ -0,0 +1,248 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+)
+
+const (
+	// ObjectNotFoundReason is used when the source or deployment object of an application does not exist yet.
+	ObjectNotFoundReason = "ObjectNotFound"
+	// InvalidSpecReason is used when the source or deployment type of an application is not supported.
+	InvalidSpecReason = "InvalidSpec"
+	// ReconciliationPendingReason is used when an object exists but has not reported a Ready condition yet.
+	ReconciliationPendingReason = "ReconciliationPending"
+	// InventoryUnavailableReason is used when the objects applied by a HelmRelease cannot be read from its storage secret.
+	InventoryUnavailableReason = "InventoryUnavailable"
+)
+
+// ApplicationReconciler writes the reconciled state of the Flux objects generated for an application
+// into the status of the Application.
+type ApplicationReconciler struct {
+	client.Client
+	// APIReader is used to read the Helm storage secrets without caching every secret in the cluster.
+	APIReader client.Reader
+}
+
+// +kubebuilder:rbac:groups=wego.weave.works,resources=apps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=wego.weave.works,resources=apps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories;helmrepositories;buckets;ocirepositories,verbs=get;list;watch
+// +kubebuilder:rbac:groups=kustomize.toolkit.fluxcd.io,resources=kustomizations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=helm.toolkit.fluxcd.io,resources=helmreleases,verbs=get;list;watch
+
+// Reconcile is called when an Application or one of the objects generated for it changes.
+// The source and deployment objects share the name and namespace of the Application.
+func (r *ApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logr.FromContextOrDiscard(ctx).WithValues("application", req.NamespacedName)
+
+	var app wego.Application
+	if err := r.Get(ctx, req.NamespacedName, &app); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	status, err := r.computeStatus(ctx, &app)
+	if err != nil {
+		log.Error(err, "failed computing application status")
+		return ctrl.Result{}, err
+	}
+
+	patch := client.MergeFrom(app.DeepCopy())
+	app.Status = status
+
+	if err := r.Status().Patch(ctx, &app, patch); err != nil {
+		log.Error(err, "failed updating application status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
+
+	toApplication := handler.EnqueueRequestsFromMapFunc(func(obj client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKeyFromObject(obj)}}
+	})
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&wego.Application{}).
+		Watches(&source.Kind{Type: &sourcev1.GitRepository{}}, toApplication).
+		Watches(&source.Kind{Type: &sourcev1.HelmRepository{}}, toApplication).
+		Watches(&source.Kind{Type: &sourcev1.Bucket{}}, toApplication).
+		Watches(&source.Kind{Type: &kustomizev2.Kustomization{}}, toApplication).
+		Watches(&source.Kind{Type: &helmv2.HelmRelease{}}, toApplication).
+		Complete(r)
+}
+
+func (r *ApplicationReconciler) computeStatus(ctx context.Context, app *wego.Application) (wego.ApplicationStatus, error) {
+	status := wego.ApplicationStatus{
+		ObservedGeneration: app.Generation,
+	}
+
+	// Start from a copy of the existing conditions so unchanged conditions keep their transition time.
+	for _, c := range app.Status.Conditions {
+		status.Conditions = append(status.Conditions, *c.DeepCopy())
+	}
+
+	src, deployment, err := applicationv2.FluxObjects(app)
+	if err != nil {
+		setCondition(&status, app, wego.SourceReadyCondition, metav1.ConditionFalse, InvalidSpecReason, err.Error())
+		setCondition(&status, app, wego.ReadyCondition, metav1.ConditionFalse, InvalidSpecReason, err.Error())
+
+		return status, nil
+	}
+
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	srcFound, err := r.getObject(ctx, name, src)
+	if err != nil {
+		return status, err
+	}
+
+	deploymentFound, err := r.getObject(ctx, name, deployment)
+	if err != nil {
+		return status, err
+	}
+
+	if srcFound {
+		mirrorReadyCondition(&status, app, wego.SourceReadyCondition, src)
+
+		switch s := src.(type) {
+		case *sourcev1.GitRepository:
+			status.Suspended = s.Spec.Suspend
+		case *sourcev1.HelmRepository:
+			status.Suspended = s.Spec.Suspend
+		case *sourcev1.Bucket:
+			status.Suspended = s.Spec.Suspend
+		case *unstructured.Unstructured:
+			status.Suspended, _, _ = unstructured.NestedBool(s.Object, "spec", "suspend")
+		}
+	} else {
+		setCondition(&status, app, wego.SourceReadyCondition, metav1.ConditionUnknown, ObjectNotFoundReason,
+			fmt.Sprintf("%s %q not found", src.GetObjectKind().GroupVersionKind().Kind, name))
+	}
+
+	if !deploymentFound {
+		setCondition(&status, app, wego.ReadyCondition, metav1.ConditionUnknown, ObjectNotFoundReason,
+			fmt.Sprintf("%s %q not found", deployment.GetObjectKind().GroupVersionKind().Kind, name))
+
+		return status, nil
+	}
+
+	mirrorReadyCondition(&status, app, wego.ReadyCondition, deployment)
+
+	switch d := deployment.(type) {
+	case *kustomizev2.Kustomization:
+		status.Suspended = status.Suspended || d.Spec.Suspend
+		status.LastAppliedRevision = d.Status.LastAppliedRevision
+
+		// Kustomizations carry their own inventory, so a condition left by an earlier
+		// helm deployment of the application no longer applies.
+		apimeta.RemoveStatusCondition(&status.Conditions, wego.InventoryAvailableCondition)
+
+		if d.Status.Inventory != nil {
+			status.InventoryCount = len(d.Status.Inventory.Entries)
+		}
+	case *helmv2.HelmRelease:
+		status.Suspended = status.Suspended || d.Spec.Suspend
+		status.LastAppliedRevision = d.Status.LastAppliedRevision
+
+		// The storage secret may not be readable by the controller, which should not keep
+		// the rest of the status from being reported.
+		objects, err := applicationv2.HelmReleaseObjects(ctx, r.APIReader, d)
+		if err != nil {
+			setCondition(&status, app, wego.InventoryAvailableCondition, metav1.ConditionFalse, InventoryUnavailableReason, err.Error())
+
+			return status, nil
+		}
+
+		apimeta.RemoveStatusCondition(&status.Conditions, wego.InventoryAvailableCondition)
+		status.InventoryCount = len(objects)
+	}
+
+	return status, nil
+}
+
+// getObject fetches obj and reports whether it exists. The GroupVersionKind of obj is always set
+// so that it can be used in messages.
+func (r *ApplicationReconciler) getObject(ctx context.Context, name types.NamespacedName, obj client.Object) (bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, r.Scheme())
+	if err != nil {
+		return false, err
+	}
+
+	defer obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if err := r.Get(ctx, name, obj); err != nil {
+		// The OCIRepository CRD is not installed by older flux versions
+		if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get %s %q: %w", gvk.Kind, name, err)
+	}
+
+	return true, nil
+}
+
+// mirrorReadyCondition copies the Ready condition of obj into the application status under conditionType.
+func mirrorReadyCondition(status *wego.ApplicationStatus, app *wego.Application, conditionType string, obj client.Object) {
+	var conditions []metav1.Condition
+
+	switch o := obj.(type) {
+	case *sourcev1.GitRepository:
+		conditions = o.Status.Conditions
+	case *sourcev1.HelmRepository:
+		conditions = o.Status.Conditions
+	case *sourcev1.Bucket:
+		conditions = o.Status.Conditions
+	case *unstructured.Unstructured:
+		conditions, _ = applicationv2.UnstructuredConditions(o)
+	case *kustomizev2.Kustomization:
+		conditions = o.Status.Conditions
+	case *helmv2.HelmRelease:
+		conditions = o.Status.Conditions
+	}
+
+	ready := apimeta.FindStatusCondition(conditions, meta.ReadyCondition)
+	if ready == nil {
+		setCondition(status, app, conditionType, metav1.ConditionUnknown, ReconciliationPendingReason,
+			fmt.Sprintf("%s %q has not been reconciled yet", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName()))
+
+		return
+	}
+
+	setCondition(status, app, conditionType, ready.Status, ready.Reason, ready.Message)
+}
+
+func setCondition(status *wego.ApplicationStatus, app *wego.Application, conditionType string, conditionStatus metav1.ConditionStatus, reason, message string) {
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
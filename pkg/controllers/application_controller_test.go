@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+var appName = types.NamespacedName{Name: "my-app", Namespace: "wego-system"}
+
+func TestReconcileKustomizeApplication(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace, Generation: 2},
+		Spec: wego.ApplicationSpec{
+			SourceType:     wego.SourceTypeGit,
+			DeploymentType: wego.DeploymentTypeKustomize,
+		},
+	}
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Status: sourcev1.GitRepositoryStatus{
+			Conditions: []metav1.Condition{readyCondition(metav1.ConditionTrue, "stored artifact")},
+		},
+	}
+	kust := &kustomizev2.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec:       kustomizev2.KustomizationSpec{Suspend: true},
+		Status: kustomizev2.KustomizationStatus{
+			LastAppliedRevision: "main/abc123",
+			Conditions:          []metav1.Condition{readyCondition(metav1.ConditionFalse, "health check failed")},
+			Inventory: &kustomizev2.ResourceInventory{
+				Entries: []kustomizev2.ResourceRef{
+					{ID: "default_my-deployment_apps_Deployment", Version: "v1"},
+					{ID: "default_my-service__Service", Version: "v1"},
+				},
+			},
+		},
+	}
+
+	reconciler := setupReconciler(app, repo, kust)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	assert.Equal(t, int64(2), result.Status.ObservedGeneration)
+	assert.Equal(t, "main/abc123", result.Status.LastAppliedRevision)
+	assert.True(t, result.Status.Suspended)
+	assert.Equal(t, 2, result.Status.InventoryCount)
+
+	sourceReady := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+	assert.Equal(t, metav1.ConditionTrue, sourceReady.Status)
+	assert.Equal(t, "stored artifact", sourceReady.Message)
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, "health check failed", ready.Message)
+}
+
+func TestReconcileHelmApplicationWithoutRelease(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec: wego.ApplicationSpec{
+			SourceType:     wego.SourceTypeHelm,
+			DeploymentType: wego.DeploymentTypeHelm,
+		},
+	}
+	repo := &sourcev1.HelmRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec:       sourcev1.HelmRepositorySpec{Suspend: true},
+	}
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Status: helmv2.HelmReleaseStatus{
+			LastAppliedRevision: "1.0.0",
+			Conditions:          []metav1.Condition{readyCondition(metav1.ConditionTrue, "release reconciliation succeeded")},
+		},
+	}
+
+	reconciler := setupReconciler(app, repo, hr)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	assert.Equal(t, "1.0.0", result.Status.LastAppliedRevision)
+	assert.True(t, result.Status.Suspended)
+	assert.Equal(t, 0, result.Status.InventoryCount)
+
+	sourceReady := apimeta.FindStatusCondition(result.Status.Conditions, wego.SourceReadyCondition)
+	assert.Equal(t, metav1.ConditionUnknown, sourceReady.Status)
+	assert.Equal(t, ReconciliationPendingReason, sourceReady.Reason)
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+}
+
+func TestReconcileHelmApplicationWithForbiddenStorage(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec: wego.ApplicationSpec{
+			SourceType:     wego.SourceTypeHelm,
+			DeploymentType: wego.DeploymentTypeHelm,
+		},
+	}
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Status: helmv2.HelmReleaseStatus{
+			LastAppliedRevision: "1.0.0",
+			LastReleaseRevision: 1,
+			Conditions:          []metav1.Condition{readyCondition(metav1.ConditionTrue, "release reconciliation succeeded")},
+		},
+	}
+
+	reconciler := setupReconciler(app, hr)
+	reconciler.APIReader = forbiddenReader{}
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	assert.Equal(t, "1.0.0", result.Status.LastAppliedRevision)
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+
+	inventory := apimeta.FindStatusCondition(result.Status.Conditions, wego.InventoryAvailableCondition)
+	assert.Equal(t, metav1.ConditionFalse, inventory.Status)
+	assert.Equal(t, InventoryUnavailableReason, inventory.Reason)
+}
+
+func TestReconcileKustomizeApplicationClearsInventoryCondition(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec: wego.ApplicationSpec{
+			SourceType:     wego.SourceTypeGit,
+			DeploymentType: wego.DeploymentTypeKustomize,
+		},
+		Status: wego.ApplicationStatus{
+			Conditions: []metav1.Condition{{
+				Type:               wego.InventoryAvailableCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             InventoryUnavailableReason,
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+	kust := &kustomizev2.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Status: kustomizev2.KustomizationStatus{
+			Conditions: []metav1.Condition{readyCondition(metav1.ConditionTrue, "applied revision")},
+		},
+	}
+
+	reconciler := setupReconciler(app, kust)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	assert.Nil(t, apimeta.FindStatusCondition(result.Status.Conditions, wego.InventoryAvailableCondition))
+}
+
+func TestReconcileMissingFluxObjects(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+	}
+
+	reconciler := setupReconciler(app)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	for _, conditionType := range []string{wego.SourceReadyCondition, wego.ReadyCondition} {
+		condition := apimeta.FindStatusCondition(result.Status.Conditions, conditionType)
+		assert.Equal(t, metav1.ConditionUnknown, condition.Status)
+		assert.Equal(t, ObjectNotFoundReason, condition.Reason)
+	}
+}
+
+func TestReconcileInvalidSpec(t *testing.T) {
+	app := &wego.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: appName.Name, Namespace: appName.Namespace},
+		Spec:       wego.ApplicationSpec{SourceType: "svn"},
+	}
+
+	reconciler := setupReconciler(app)
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+
+	result := &wego.Application{}
+	assert.NoError(t, reconciler.Get(context.Background(), appName, result))
+
+	ready := apimeta.FindStatusCondition(result.Status.Conditions, wego.ReadyCondition)
+	assert.Equal(t, metav1.ConditionFalse, ready.Status)
+	assert.Equal(t, InvalidSpecReason, ready.Reason)
+}
+
+func TestReconcileDeletedApplication(t *testing.T) {
+	reconciler := setupReconciler()
+
+	_, err := reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: appName})
+	assert.NoError(t, err)
+}
+
+func readyCondition(status metav1.ConditionStatus, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               meta.ReadyCondition,
+		Status:             status,
+		Reason:             "Reconciled",
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// forbiddenReader behaves like a client without access to any object.
+type forbiddenReader struct{}
+
+func (forbiddenReader) Get(_ context.Context, key client.ObjectKey, _ client.Object) error {
+	return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, key.Name, errors.New("forbidden"))
+}
+
+func (forbiddenReader) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", errors.New("forbidden"))
+}
+
+func setupReconciler(objects ...client.Object) *ApplicationReconciler {
+	fakeClient := fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(objects...).Build()
+
+	return &ApplicationReconciler{
+		Client:    fakeClient,
+		APIReader: fakeClient,
+	}
+}
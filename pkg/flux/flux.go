@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
-	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
 	"github.com/weaveworks/weave-gitops/pkg/runner"
@@ -29,7 +28,6 @@ type Flux interface {
 	CreateSecretGit(name string, repoUrl gitproviders.RepoURL, namespace string) ([]byte, error)
 	GetVersion() (string, error)
 	GetAllResourcesStatus(name string, namespace string) ([]byte, error)
-	SuspendOrResumeApp(pause wego.SuspendActionType, name, namespace, deploymentType string) ([]byte, error)
 	GetLatestStatusAllNamespaces() ([]string, error)
 	PreCheck() (string, error)
 }
@@ -108,7 +106,7 @@ func (f *FluxClient) CreateSourceGit(name string, repoUrl gitproviders.RepoURL,
 	}
 
 	if secretRef != "" {
-		args = append(args, "--secret-ref", secretRef, "--url", repoUrl.String())
+		args = append(args, "--secret-ref", secretRef, "--url", repoUrl.CloneURL())
 	} else {
 		args = append(args, "--url", makePublicUrl(repoUrl))
 	}
@@ -307,14 +305,6 @@ func (f *FluxClient) fluxPath() (string, error) {
 	return fmt.Sprintf("%v/flux-%v", path, version.FluxVersion), nil
 }
 
-func (f *FluxClient) SuspendOrResumeApp(suspend wego.SuspendActionType, name, namespace string, deploymentType string) ([]byte, error) {
-	args := []string{
-		string(suspend), deploymentType, name, fmt.Sprintf("--namespace=%s", namespace),
-	}
-
-	return f.runFluxCmd(args...)
-}
-
 func (f *FluxClient) PreCheck() (string, error) {
 	args := []string{
 		"check",
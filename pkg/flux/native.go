@@ -0,0 +1,205 @@
+package flux
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/runner"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ManifestsEnvVar selects how the manifests of flux objects are generated.
+	// When set to NativeManifests they are built in process instead of by the flux binary.
+	ManifestsEnvVar = "WEAVE_GITOPS_FLUX_MANIFESTS"
+	NativeManifests = "native"
+)
+
+const (
+	sourceInterval      = 30 * time.Second
+	kustomizeInterval   = time.Minute
+	helmReleaseInterval = 5 * time.Minute
+)
+
+// NewClient returns the flux client selected by the WEAVE_GITOPS_FLUX_MANIFESTS environment variable.
+func NewClient(osysClient osys.Osys, cliRunner runner.Runner) Flux {
+	fluxClient := New(osysClient, cliRunner)
+
+	if osysClient.Getenv(ManifestsEnvVar) == NativeManifests {
+		return NewNativeClient(fluxClient)
+	}
+
+	return fluxClient
+}
+
+// NativeClient builds the manifests of sources, Kustomizations and HelmReleases from the flux API types,
+// producing the same output as the `--export` flag of the flux CLI. Everything else is delegated to the
+// wrapped client.
+type NativeClient struct {
+	Flux
+}
+
+var _ Flux = &NativeClient{}
+
+func NewNativeClient(fluxClient Flux) *NativeClient {
+	return &NativeClient{
+		Flux: fluxClient,
+	}
+}
+
+func (n *NativeClient) CreateSourceGit(name string, repoUrl gitproviders.RepoURL, branch string, secretRef string, namespace string) ([]byte, error) {
+	url := makePublicUrl(repoUrl)
+	if secretRef != "" {
+		url = repoUrl.CloneURL()
+	}
+
+	source := sourcev1.GitRepository{
+		TypeMeta:   typeMeta(sourcev1.GroupVersion.String(), sourcev1.GitRepositoryKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: sourcev1.GitRepositorySpec{
+			URL:       url,
+			Interval:  metav1.Duration{Duration: sourceInterval},
+			Reference: &sourcev1.GitRepositoryRef{Branch: branch},
+		},
+	}
+
+	if secretRef != "" {
+		source.Spec.SecretRef = &meta.LocalObjectReference{Name: secretRef}
+	}
+
+	out, err := exportManifest(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source git: %w", err)
+	}
+
+	return out, nil
+}
+
+func (n *NativeClient) CreateSourceHelm(name string, url string, namespace string) ([]byte, error) {
+	source := sourcev1.HelmRepository{
+		TypeMeta:   typeMeta(sourcev1.GroupVersion.String(), sourcev1.HelmRepositoryKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: sourcev1.HelmRepositorySpec{
+			URL:      url,
+			Interval: metav1.Duration{Duration: sourceInterval},
+		},
+	}
+
+	out, err := exportManifest(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source helm: %w", err)
+	}
+
+	return out, nil
+}
+
+func (n *NativeClient) CreateKustomization(name string, source string, path string, namespace string) ([]byte, error) {
+	kustomization := kustomizev2.Kustomization{
+		TypeMeta:   typeMeta(kustomizev2.GroupVersion.String(), kustomizev2.KustomizationKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: kustomizev2.KustomizationSpec{
+			Interval: metav1.Duration{Duration: kustomizeInterval},
+			Path:     safeRelativePath(path),
+			Prune:    true,
+			SourceRef: kustomizev2.CrossNamespaceSourceReference{
+				Kind: sourcev1.GitRepositoryKind,
+				Name: source,
+			},
+		},
+	}
+
+	out, err := exportManifest(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kustomization: %w", err)
+	}
+
+	return out, nil
+}
+
+func (n *NativeClient) CreateHelmReleaseGitRepository(name, source, chartPath, namespace, targetNamespace string) ([]byte, error) {
+	out, err := exportManifest(helmRelease(name, sourcev1.GitRepositoryKind, source, chartPath, namespace, targetNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm release git repo: %w", err)
+	}
+
+	return out, nil
+}
+
+func (n *NativeClient) CreateHelmReleaseHelmRepository(name, chart, namespace, targetNamespace string) ([]byte, error) {
+	out, err := exportManifest(helmRelease(name, sourcev1.HelmRepositoryKind, name, chart, namespace, targetNamespace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create helm release helm repo: %w", err)
+	}
+
+	return out, nil
+}
+
+func helmRelease(name, sourceKind, sourceName, chart, namespace, targetNamespace string) helmv2.HelmRelease {
+	return helmv2.HelmRelease{
+		TypeMeta:   typeMeta(helmv2.GroupVersion.String(), helmv2.HelmReleaseKind),
+		ObjectMeta: objectMeta(name, namespace),
+		Spec: helmv2.HelmReleaseSpec{
+			Interval:        metav1.Duration{Duration: helmReleaseInterval},
+			TargetNamespace: targetNamespace,
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart: chart,
+					SourceRef: helmv2.CrossNamespaceObjectReference{
+						Kind: sourceKind,
+						Name: sourceName,
+					},
+				},
+			},
+		},
+	}
+}
+
+func typeMeta(apiVersion, kind string) metav1.TypeMeta {
+	return metav1.TypeMeta{
+		APIVersion: apiVersion,
+		Kind:       kind,
+	}
+}
+
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+	}
+}
+
+// safeRelativePath flattens a path relative to the root of a source the same way as the --path flag of the flux CLI.
+func safeRelativePath(p string) string {
+	cleaned := strings.TrimPrefix(path.Clean("/"+strings.TrimSpace(p)), "/")
+	if !strings.HasPrefix(cleaned, ".") {
+		cleaned = "./" + cleaned
+	}
+
+	return cleaned
+}
+
+// exportManifest serializes an object like the `--export` flag of the flux CLI.
+// Fields are sorted by yaml.Marshal so the output is deterministic.
+func exportManifest(obj interface{}) ([]byte, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.Replace(data, []byte("  creationTimestamp: null\n"), []byte(""), 1)
+	data = bytes.Replace(data, []byte("status: {}\n"), []byte(""), 1)
+
+	out := append([]byte("---\n"), data...)
+
+	return append(out, '\n'), nil
+}
@@ -0,0 +1,89 @@
+package flux_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/osys"
+	"github.com/weaveworks/weave-gitops/pkg/osys/osysfakes"
+	cliRunner "github.com/weaveworks/weave-gitops/pkg/runner"
+)
+
+type generateManifest func(f flux.Flux) ([]byte, error)
+
+var _ = Describe("NativeClient", func() {
+	podinfoURL := func() gitproviders.RepoURL {
+		repoURL, err := gitproviders.NewRepoURL("ssh://git@github.com/weaveworks/podinfo.git")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		return repoURL
+	}
+
+	// The golden files hold the output of the flux CLI. When the flux binary is installed,
+	// its output is checked against them too, so that both implementations stay in sync.
+	DescribeTable("generates the same manifests as the flux CLI", func(golden string, generate generateManifest) {
+		expected, err := os.ReadFile(filepath.Join("testdata", golden))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		out, err := generate(flux.NewNativeClient(fluxClient))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(out)).To(Equal(string(expected)))
+
+		Expect(runner.RunCallCount()).To(Equal(0))
+
+		cliClient := flux.New(osys.New(), &cliRunner.CLIRunner{})
+
+		exePath, err := cliClient.GetExePath()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		if _, err := os.Stat(exePath); err == nil {
+			cliOut, err := generate(cliClient)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(string(cliOut)).To(Equal(string(expected)))
+		}
+	},
+		Entry("private GitRepository", "source_git_private.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateSourceGit("podinfo", podinfoURL(), "main", "wego-github-podinfo", wego.DefaultNamespace)
+		}),
+		Entry("private GitRepository over HTTPS", "source_git_https.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateSourceGit("podinfo", podinfoURL().WithGitAuth(gitproviders.GitAuthToken), "main", "wego-github-podinfo", wego.DefaultNamespace)
+		}),
+		Entry("public GitRepository", "source_git_public.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateSourceGit("podinfo", podinfoURL(), "main", "", wego.DefaultNamespace)
+		}),
+		Entry("HelmRepository", "source_helm.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateSourceHelm("podinfo", "https://stefanprodan.github.io/podinfo", wego.DefaultNamespace)
+		}),
+		Entry("Kustomization", "kustomization.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateKustomization("podinfo", "podinfo", "kustomize", wego.DefaultNamespace)
+		}),
+		Entry("HelmRelease for a GitRepository", "helmrelease_git.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateHelmReleaseGitRepository("podinfo", "podinfo", "./charts/podinfo", wego.DefaultNamespace, "")
+		}),
+		Entry("HelmRelease for a HelmRepository", "helmrelease_helm.golden.yaml", func(f flux.Flux) ([]byte, error) {
+			return f.CreateHelmReleaseHelmRepository("podinfo", "podinfo", wego.DefaultNamespace, "apps")
+		}),
+	)
+
+	It("delegates everything else to the wrapped client", func() {
+		_, err := flux.NewNativeClient(fluxClient).CreateSecretGit("podinfo", podinfoURL(), wego.DefaultNamespace)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(runner.RunCallCount()).To(Equal(1))
+	})
+
+	It("is selected by the environment", func() {
+		osysClient := &osysfakes.FakeOsys{}
+		Expect(flux.NewClient(osysClient, runner)).To(BeAssignableToTypeOf(&flux.FluxClient{}))
+
+		osysClient.GetenvReturns(flux.NativeManifests)
+		Expect(flux.NewClient(osysClient, runner)).To(BeAssignableToTypeOf(&flux.NativeClient{}))
+		Expect(osysClient.GetenvArgsForCall(1)).To(Equal(flux.ManifestsEnvVar))
+	})
+})
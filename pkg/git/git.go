@@ -29,6 +29,8 @@ import (
 var (
 	ErrNoGitRepository = errors.New("no git repository")
 	ErrNoStagedFiles   = errors.New("no staged files")
+	// ErrNonFastForward is returned by Push when the remote branch has commits that are not in the local branch.
+	ErrNonFastForward = errors.New("the remote branch has changed")
 )
 
 type Author struct {
@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
@@ -41,13 +42,29 @@ type GoGit struct {
 	auth       transport.AuthMethod
 	repository *gogit.Repository
 	git        wrapper.Git
+	signer     Signer
 }
 
-func New(auth transport.AuthMethod, wrapper wrapper.Git) Git {
-	return &GoGit{
+// Option configures the git client.
+type Option func(*GoGit)
+
+// URLRewriter is implemented by the authentication methods that access repositories with another URL than
+// the one they are referenced with, like tokens that are only accepted over HTTPS.
+type URLRewriter interface {
+	RewriteURL(url string) string
+}
+
+func New(auth transport.AuthMethod, wrapper wrapper.Git, opts ...Option) Git {
+	g := &GoGit{
 		auth: auth,
 		git:  wrapper,
 	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
 }
 
 // Open opens a git repository in the provided path, and returns a repository.
@@ -82,7 +99,7 @@ func (g *GoGit) Init(path, url, branch string) (bool, error) {
 
 	if _, err = r.CreateRemote(&config.RemoteConfig{
 		Name: gogit.DefaultRemoteName,
-		URLs: []string{url},
+		URLs: []string{g.remoteURL(url)},
 	}); err != nil {
 		return false, err
 	}
@@ -135,7 +152,7 @@ func (g *GoGit) Clone(ctx context.Context, path, url, branch string) (bool, erro
 func (g *GoGit) clone(ctx context.Context, path, url, branch string, depth int) (*gogit.Repository, error) {
 	branchRef := plumbing.NewBranchReferenceName(branch)
 	r, err := g.git.PlainCloneContext(ctx, path, false, &gogit.CloneOptions{
-		URL:           url,
+		URL:           g.remoteURL(url),
 		Auth:          g.auth,
 		RemoteName:    gogit.DefaultRemoteName,
 		ReferenceName: branchRef,
@@ -297,19 +314,100 @@ func (g *GoGit) Commit(message Commit, filters ...func(string) bool) (string, er
 		return "", fmt.Errorf("failed to commit changes: %w", err)
 	}
 
+	if g.signer != nil {
+		commit, err = g.sign(commit)
+		if err != nil {
+			return "", fmt.Errorf("failed to sign the commit: %w", err)
+		}
+	}
+
 	return commit.String(), nil
 }
 
+// sign replaces the HEAD commit with a copy of it that is signed, and returns the hash of the copy.
+func (g *GoGit) sign(hash plumbing.Hash) (plumbing.Hash, error) {
+	commit, err := g.repository.CommitObject(hash)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	unsigned := g.repository.Storer.NewEncodedObject()
+	if err := commit.EncodeWithoutSignature(unsigned); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	reader, err := unsigned.Reader()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	defer reader.Close()
+
+	signature, err := g.signer.Sign(reader)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	commit.PGPSignature = signature
+
+	signed := g.repository.Storer.NewEncodedObject()
+	if err := commit.Encode(signed); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	signedHash, err := g.repository.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	head, err := g.repository.Head()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	if err := g.repository.Storer.SetReference(plumbing.NewHashReference(head.Name(), signedHash)); err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return signedHash, nil
+}
+
+// remoteURL returns the URL the authentication method accesses the repository at url with.
+func (g *GoGit) remoteURL(url string) string {
+	if rewriter, ok := g.auth.(URLRewriter); ok {
+		return rewriter.RewriteURL(url)
+	}
+
+	return url
+}
+
 func (g *GoGit) Push(ctx context.Context) error {
 	if g.repository == nil {
 		return ErrNoGitRepository
 	}
 
-	return g.repository.PushContext(ctx, &gogit.PushOptions{
+	err := g.repository.PushContext(ctx, &gogit.PushOptions{
 		RemoteName: gogit.DefaultRemoteName,
 		Auth:       g.auth,
 		Progress:   nil,
 	})
+	if err != nil && isNonFastForward(err) {
+		return fmt.Errorf("%w: %s", ErrNonFastForward, err)
+	}
+
+	return err
+}
+
+// isNonFastForward reports whether a push was rejected because the remote branch moved. go-git
+// detects it before pushing, but only returns gogit.ErrNonFastForwardUpdate from some code paths,
+// and servers report it as "non-fast-forward" or "fetch first", so the messages are matched too.
+func isNonFastForward(err error) bool {
+	if errors.Is(err, gogit.ErrNonFastForwardUpdate) {
+		return true
+	}
+
+	msg := err.Error()
+
+	return strings.Contains(msg, "non-fast-forward") || strings.Contains(msg, "fetch first")
 }
 
 // Status returns true if no files in the repository have been modified.
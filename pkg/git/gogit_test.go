@@ -428,6 +428,37 @@ var _ = Describe("Push", func() {
 		err = gitClient.Push(context.Background())
 		Expect(err).Should(MatchError("remote not found"))
 	})
+
+	It("fails with ErrNonFastForward when the remote branch moved", func() {
+		remote := filepath.Join(dir, "remote.git")
+		_, err := gogit.PlainInit(remote, true)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		commitAndPush := func(client git.Git, path string) error {
+			Expect(client.Write(path, []byte("testing"))).To(Succeed())
+
+			_, err := client.Commit(git.Commit{
+				Author:  git.Author{Name: "test", Email: "test@example.com"},
+				Message: "test commit",
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			return client.Push(context.Background())
+		}
+
+		_, err = gitClient.Clone(context.Background(), filepath.Join(dir, "first"), remote, "main")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(commitAndPush(gitClient, "first.txt")).To(Succeed())
+
+		stale := git.New(nil, wrapper.NewGoGit())
+		_, err = stale.Clone(context.Background(), filepath.Join(dir, "stale"), remote, "main")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(commitAndPush(gitClient, "second.txt")).To(Succeed())
+
+		err = commitAndPush(stale, "third.txt")
+		Expect(errors.Is(err, git.ErrNonFastForward)).To(BeTrue(), err.Error())
+	})
 })
 
 var _ = Describe("Remove", func() {
@@ -0,0 +1,244 @@
+package git
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+// SigningFormat is the format of the keys commits are signed with.
+type SigningFormat string
+
+const (
+	SigningFormatOpenPGP SigningFormat = "openpgp"
+	SigningFormatSSH     SigningFormat = "ssh"
+)
+
+const (
+	sshSignatureBegin     = "-----BEGIN SSH SIGNATURE-----"
+	sshSignatureEnd       = "-----END SSH SIGNATURE-----"
+	sshSignatureMagic     = "SSHSIG"
+	sshSignatureVersion   = 1
+	sshSignatureNamespace = "git"
+	sshSignatureHash      = "sha512"
+	sshSignatureLineWidth = 70
+)
+
+// Signer signs commits.
+type Signer interface {
+	// Sign returns the armored signature of a commit, as stored in its gpgsig header.
+	Sign(message io.Reader) (string, error)
+	// PublicKey returns the public key the signatures can be verified with, in a form NewVerifier accepts.
+	PublicKey() ([]byte, error)
+}
+
+// WithSigner makes the git client sign the commits it makes. A nil signer leaves commits unsigned.
+func WithSigner(signer Signer) Option {
+	return func(g *GoGit) {
+		g.signer = signer
+	}
+}
+
+// NewSigner returns a signer for an armored OpenPGP private key or an OpenSSH private key. The passphrase
+// is only used when the key is encrypted.
+func NewSigner(format SigningFormat, key, passphrase []byte) (Signer, error) {
+	switch format {
+	case SigningFormatOpenPGP:
+		return newOpenPGPSigner(key, passphrase)
+	case SigningFormatSSH:
+		return newSSHSigner(key, passphrase)
+	}
+
+	return nil, fmt.Errorf("unsupported signing format %q, must be one of %s or %s", format, SigningFormatOpenPGP, SigningFormatSSH)
+}
+
+// LoadSigner returns a signer for the private key in keyFile.
+func LoadSigner(format SigningFormat, keyFile string, passphrase []byte) (Signer, error) {
+	key, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the signing key: %w", err)
+	}
+
+	return NewSigner(format, key, passphrase)
+}
+
+type openPGPSigner struct {
+	entity *openpgp.Entity
+}
+
+func newOpenPGPSigner(key, passphrase []byte) (*openPGPSigner, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the OpenPGP signing key: %w", err)
+	}
+
+	if len(entities) != 1 {
+		return nil, fmt.Errorf("the OpenPGP signing key must contain a single entity, found %d", len(entities))
+	}
+
+	entity := entities[0]
+
+	if entity.PrivateKey == nil {
+		return nil, errors.New("the OpenPGP signing key has no private key")
+	}
+
+	if entity.PrivateKey.Encrypted {
+		if err := entity.PrivateKey.Decrypt(passphrase); err != nil {
+			return nil, fmt.Errorf("failed to decrypt the OpenPGP signing key: %w", err)
+		}
+	}
+
+	for _, subkey := range entity.Subkeys {
+		if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+			if err := subkey.PrivateKey.Decrypt(passphrase); err != nil {
+				return nil, fmt.Errorf("failed to decrypt the OpenPGP signing subkey: %w", err)
+			}
+		}
+	}
+
+	return &openPGPSigner{entity: entity}, nil
+}
+
+func (s *openPGPSigner) Sign(message io.Reader) (string, error) {
+	var signature bytes.Buffer
+
+	if err := openpgp.ArmoredDetachSign(&signature, s.entity, message, nil); err != nil {
+		return "", fmt.Errorf("failed to sign with the OpenPGP key: %w", err)
+	}
+
+	return signature.String(), nil
+}
+
+func (s *openPGPSigner) PublicKey() ([]byte, error) {
+	var key bytes.Buffer
+
+	w, err := armor.Encode(&key, openpgp.PublicKeyType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.entity.Serialize(w); err != nil {
+		return nil, fmt.Errorf("failed to serialize the OpenPGP public key: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return key.Bytes(), nil
+}
+
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+func newSSHSigner(key, passphrase []byte) (*sshSigner, error) {
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		var missing *ssh.PassphraseMissingError
+		if !errors.As(err, &missing) {
+			return nil, fmt.Errorf("failed to read the SSH signing key: %w", err)
+		}
+
+		signer, err = ssh.ParsePrivateKeyWithPassphrase(key, passphrase)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt the SSH signing key: %w", err)
+		}
+	}
+
+	return &sshSigner{signer: signer}, nil
+}
+
+// Sign signs the message in the format of ssh-keygen -Y sign, which git uses for SSH signatures.
+func (s *sshSigner) Sign(message io.Reader) (string, error) {
+	signed, err := sshSignedData(message)
+	if err != nil {
+		return "", err
+	}
+
+	var signature *ssh.Signature
+
+	// RSA keys must not sign with SHA-1
+	if algorithmSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signed, ssh.SigAlgoRSASHA2512)
+	} else {
+		signature, err = s.signer.Sign(rand.Reader, signed)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to sign with the SSH key: %w", err)
+	}
+
+	blob := ssh.Marshal(sshSignatureBlob{
+		Version:       sshSignatureVersion,
+		PublicKey:     s.signer.PublicKey().Marshal(),
+		Namespace:     sshSignatureNamespace,
+		HashAlgorithm: sshSignatureHash,
+		Signature:     ssh.Marshal(signature),
+	})
+
+	return armorSSHSignature(append([]byte(sshSignatureMagic), blob...)), nil
+}
+
+func (s *sshSigner) PublicKey() ([]byte, error) {
+	return ssh.MarshalAuthorizedKey(s.signer.PublicKey()), nil
+}
+
+// sshSignatureBlob is the content of an SSH signature, after its magic preamble.
+type sshSignatureBlob struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData returns the data an SSH signature of message is computed on.
+func sshSignedData(message io.Reader) ([]byte, error) {
+	hash := sha512.New()
+	if _, err := io.Copy(hash, message); err != nil {
+		return nil, fmt.Errorf("failed to read the signed message: %w", err)
+	}
+
+	data := ssh.Marshal(struct {
+		Namespace     string
+		Reserved      string
+		HashAlgorithm string
+		Hash          []byte
+	}{
+		Namespace:     sshSignatureNamespace,
+		HashAlgorithm: sshSignatureHash,
+		Hash:          hash.Sum(nil),
+	})
+
+	return append([]byte(sshSignatureMagic), data...), nil
+}
+
+func armorSSHSignature(signature []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(signature)
+
+	var b strings.Builder
+
+	b.WriteString(sshSignatureBegin + "\n")
+
+	for len(encoded) > sshSignatureLineWidth {
+		b.WriteString(encoded[:sshSignatureLineWidth] + "\n")
+		encoded = encoded[sshSignatureLineWidth:]
+	}
+
+	b.WriteString(encoded + "\n")
+	b.WriteString(sshSignatureEnd + "\n")
+
+	return b.String()
+}
@@ -0,0 +1,197 @@
+package git_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/git/wrapper"
+)
+
+func openPGPKey(passphrase []byte) (*openpgp.Entity, []byte) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	if passphrase != nil {
+		Expect(entity.PrivateKey.Encrypt(passphrase)).To(Succeed())
+
+		for _, subkey := range entity.Subkeys {
+			Expect(subkey.PrivateKey.Encrypt(passphrase)).To(Succeed())
+		}
+	}
+
+	var key bytes.Buffer
+
+	w, err := armor.Encode(&key, openpgp.PrivateKeyType, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(entity.SerializePrivateWithoutSigning(w, nil)).To(Succeed())
+	Expect(w.Close()).To(Succeed())
+
+	return entity, key.Bytes()
+}
+
+func rsaKey() []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func ed25519Key() []byte {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func commitSigned(signer git.Signer) *object.Commit {
+	client := git.New(nil, wrapper.NewGoGit(), git.WithSigner(signer))
+
+	_, err := client.Init(dir, "https://github.com/github/gitignore", "main")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	Expect(client.Write("test.txt", []byte("testing"))).To(Succeed())
+
+	hash, err := client.Commit(git.Commit{
+		Author:  git.Author{Name: "test", Email: "test@example.com"},
+		Message: "signed commit",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	repo, err := gogit.PlainOpen(dir)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	head, err := repo.Head()
+	Expect(err).ShouldNot(HaveOccurred())
+	Expect(head.Hash().String()).To(Equal(hash))
+
+	commit, err := repo.CommitObject(plumbing.NewHash(hash))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	return commit
+}
+
+var _ = Describe("Signed commits", func() {
+	It("signs commits with an OpenPGP key", func() {
+		_, key := openPGPKey([]byte("secret"))
+
+		signer, err := git.NewSigner(git.SigningFormatOpenPGP, key, []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+
+		commit := commitSigned(signer)
+		Expect(commit.PGPSignature).To(HavePrefix("-----BEGIN PGP SIGNATURE-----"))
+		Expect(commit.Message).To(Equal("signed commit"))
+
+		publicKey, err := signer.PublicKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = commit.Verify(string(publicKey))
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier, err := git.NewVerifier(publicKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.VerifyCommit(commit)).To(Succeed())
+	})
+
+	It("signs commits with SSH keys", func() {
+		for _, key := range [][]byte{rsaKey(), ed25519Key()} {
+			signer, err := git.NewSigner(git.SigningFormatSSH, key, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			commit := commitSigned(signer)
+			Expect(commit.PGPSignature).To(HavePrefix("-----BEGIN SSH SIGNATURE-----"))
+
+			publicKey, err := signer.PublicKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			verifier, err := git.NewVerifier(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(verifier.VerifyCommit(commit)).To(Succeed())
+
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		}
+	})
+
+	It("fails with the wrong passphrase", func() {
+		_, key := openPGPKey([]byte("secret"))
+
+		_, err := git.NewSigner(git.SigningFormatOpenPGP, key, []byte("wrong"))
+		Expect(err).To(MatchError(ContainSubstring("failed to decrypt the OpenPGP signing key")))
+	})
+
+	It("fails with an unsupported format", func() {
+		_, err := git.NewSigner("x509", nil, nil)
+		Expect(err).To(MatchError(`unsupported signing format "x509", must be one of openpgp or ssh`))
+	})
+})
+
+var _ = Describe("Verifier", func() {
+	It("does not verify unsigned commits", func() {
+		_, err := gitClient.Init(dir, "https://github.com/github/gitignore", "main")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(gitClient.Write("test.txt", []byte("testing"))).To(Succeed())
+
+		hash, err := gitClient.Commit(git.Commit{Author: git.Author{Name: "test", Email: "test@example.com"}, Message: "unsigned"})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		repo, err := gogit.PlainOpen(dir)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		commit, err := repo.CommitObject(plumbing.NewHash(hash))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		verifier, err := git.NewVerifier()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.VerifyCommit(commit)).To(MatchError(git.ErrNotSigned))
+	})
+
+	It("does not trust signatures of other keys", func() {
+		signer, err := git.NewSigner(git.SigningFormatSSH, ed25519Key(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err := git.NewSigner(git.SigningFormatSSH, ed25519Key(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		otherKey, err := other.PublicKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		_, pgpKey := openPGPKey(nil)
+		pgpSigner, err := git.NewSigner(git.SigningFormatOpenPGP, pgpKey, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		pgpPublicKey, err := pgpSigner.PublicKey()
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier, err := git.NewVerifier(otherKey, pgpPublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(bytes.NewReader([]byte("payload")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.Verify([]byte("payload"), signature)).To(MatchError(git.ErrUntrustedSignature))
+
+		signature, err = other.Sign(bytes.NewReader([]byte("payload")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.Verify([]byte("payload"), signature)).To(Succeed())
+		Expect(verifier.Verify([]byte("tampered"), signature)).To(MatchError(ContainSubstring("invalid SSH signature")))
+
+		signature, err = pgpSigner.Sign(bytes.NewReader([]byte("payload")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.Verify([]byte("payload"), signature)).To(Succeed())
+	})
+})
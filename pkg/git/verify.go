@@ -0,0 +1,184 @@
+package git
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	pgperrors "github.com/ProtonMail/go-crypto/openpgp/errors"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"golang.org/x/crypto/ssh"
+)
+
+const pgpSignatureBegin = "-----BEGIN PGP SIGNATURE-----"
+
+var (
+	// ErrNotSigned is returned when verifying a commit that has no signature.
+	ErrNotSigned = errors.New("the commit is not signed")
+	// ErrUntrustedSignature is returned when a signature is not made by one of the trusted keys.
+	ErrUntrustedSignature = errors.New("the signature is not made by a trusted key")
+)
+
+// Verifier verifies OpenPGP and SSH commit signatures against a set of trusted public keys.
+type Verifier struct {
+	pgpKeys openpgp.EntityList
+	sshKeys []ssh.PublicKey
+}
+
+// NewVerifier returns a verifier trusting the given keys. Each key is either an armored OpenPGP public key
+// ring or SSH public keys in the authorized_keys format.
+func NewVerifier(trustedKeys ...[]byte) (*Verifier, error) {
+	v := &Verifier{}
+
+	for _, key := range trustedKeys {
+		if bytes.Contains(key, []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----")) {
+			entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(key))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the OpenPGP public key: %w", err)
+			}
+
+			v.pgpKeys = append(v.pgpKeys, entities...)
+
+			continue
+		}
+
+		rest := key
+		for len(bytes.TrimSpace(rest)) > 0 {
+			publicKey, _, _, next, err := ssh.ParseAuthorizedKey(rest)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the SSH public key: %w", err)
+			}
+
+			v.sshKeys = append(v.sshKeys, publicKey)
+			rest = next
+		}
+	}
+
+	return v, nil
+}
+
+// VerifyCommit verifies the signature of a commit. It returns ErrNotSigned if the commit has no signature.
+func (v *Verifier) VerifyCommit(commit *object.Commit) error {
+	if commit.PGPSignature == "" {
+		return ErrNotSigned
+	}
+
+	payload := &plumbing.MemoryObject{}
+	if err := commit.EncodeWithoutSignature(payload); err != nil {
+		return fmt.Errorf("failed to encode the commit: %w", err)
+	}
+
+	reader, err := payload.Reader()
+	if err != nil {
+		return err
+	}
+
+	var message bytes.Buffer
+	if _, err := message.ReadFrom(reader); err != nil {
+		return err
+	}
+
+	return v.Verify(message.Bytes(), commit.PGPSignature)
+}
+
+// Verify verifies the armored signature of the payload, which is a commit without its signature header.
+func (v *Verifier) Verify(payload []byte, signature string) error {
+	signature = strings.TrimSpace(signature)
+
+	switch {
+	case signature == "":
+		return ErrNotSigned
+	case strings.HasPrefix(signature, pgpSignatureBegin):
+		return v.verifyOpenPGP(payload, signature)
+	case strings.HasPrefix(signature, sshSignatureBegin):
+		return v.verifySSH(payload, signature)
+	}
+
+	return errors.New("unsupported signature format")
+}
+
+func (v *Verifier) verifyOpenPGP(payload []byte, signature string) error {
+	if len(v.pgpKeys) == 0 {
+		return ErrUntrustedSignature
+	}
+
+	_, err := openpgp.CheckArmoredDetachedSignature(v.pgpKeys, bytes.NewReader(payload), strings.NewReader(signature), nil)
+	if err != nil {
+		if errors.Is(err, pgperrors.ErrUnknownIssuer) {
+			return ErrUntrustedSignature
+		}
+
+		return fmt.Errorf("invalid OpenPGP signature: %w", err)
+	}
+
+	return nil
+}
+
+func (v *Verifier) verifySSH(payload []byte, signature string) error {
+	encoded := strings.TrimSuffix(strings.TrimPrefix(signature, sshSignatureBegin), sshSignatureEnd)
+
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return fmt.Errorf("invalid SSH signature encoding: %w", err)
+	}
+
+	if !bytes.HasPrefix(blob, []byte(sshSignatureMagic)) {
+		return errors.New("invalid SSH signature preamble")
+	}
+
+	var sig sshSignatureBlob
+	if err := ssh.Unmarshal(blob[len(sshSignatureMagic):], &sig); err != nil {
+		return fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	if sig.Version != sshSignatureVersion {
+		return fmt.Errorf("unsupported SSH signature version %d", sig.Version)
+	}
+
+	if sig.Namespace != sshSignatureNamespace {
+		return fmt.Errorf("unexpected SSH signature namespace %q", sig.Namespace)
+	}
+
+	if sig.HashAlgorithm != sshSignatureHash {
+		return fmt.Errorf("unsupported SSH signature hash algorithm %q", sig.HashAlgorithm)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid SSH signature public key: %w", err)
+	}
+
+	if !v.trustsSSHKey(publicKey) {
+		return ErrUntrustedSignature
+	}
+
+	var inner ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &inner); err != nil {
+		return fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	signed, err := sshSignedData(bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	if err := publicKey.Verify(signed, &inner); err != nil {
+		return fmt.Errorf("invalid SSH signature: %w", err)
+	}
+
+	return nil
+}
+
+func (v *Verifier) trustsSSHKey(publicKey ssh.PublicKey) bool {
+	for _, key := range v.sshKeys {
+		if bytes.Equal(key.Marshal(), publicKey.Marshal()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -5,3 +5,9 @@ package gitproviders
 type Client interface {
 	GetProvider(repoUrl RepoURL, getAccountType AccountTypeGetter) (GitProvider, error)
 }
+
+// TokenGetter is implemented by the clients that can return the token of the git provider of a
+// repository, to authenticate git operations over HTTPS with.
+type TokenGetter interface {
+	GetToken(repoUrl RepoURL) (string, error)
+}
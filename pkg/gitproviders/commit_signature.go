@@ -0,0 +1,94 @@
+package gitproviders
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/google/go-github/v41/github"
+)
+
+// CommitSignature returns the signature of a commit and the payload it signs, the commit without its
+// signature header. The signature is empty when the commit is not signed or when the provider does not
+// report signatures.
+func CommitSignature(commit gitprovider.Commit) (payload []byte, signature string) {
+	switch c := commit.APIObject().(type) {
+	case *github.Commit:
+		if c.Verification != nil {
+			return []byte(c.Verification.GetPayload()), c.Verification.GetSignature()
+		}
+	case *giteaCommit:
+		return []byte(c.Commit.Verification.Payload), c.Commit.Verification.Signature
+	case *object.Commit:
+		return plainCommitSignature(c)
+	}
+
+	return nil, ""
+}
+
+// plainCommitSignature returns the signature of a commit read with git, which is signed without its
+// signature header like the commits of the APIs.
+func plainCommitSignature(c *object.Commit) ([]byte, string) {
+	if c.PGPSignature == "" {
+		return nil, ""
+	}
+
+	encoded := &plumbing.MemoryObject{}
+	if err := c.EncodeWithoutSignature(encoded); err != nil {
+		return nil, ""
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, ""
+	}
+	defer reader.Close()
+
+	payload, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, ""
+	}
+
+	return payload, c.PGPSignature
+}
+
+// getGitHubCommits lists the commits with the GitHub client directly, as the commits listed by
+// go-git-providers do not have their signature.
+func getGitHubCommits(ctx context.Context, client *github.Client, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	repoCommits, _, err := client.Repositories.ListCommits(ctx, repoUrl.Owner(), repoUrl.RepositoryName(), &github.CommitsListOptions{
+		SHA:         targetBranch,
+		ListOptions: github.ListOptions{PerPage: pageSize, Page: pageToken},
+	})
+	if err != nil {
+		if isEmptyRepoError(err) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits: %s", err)
+	}
+
+	result := []gitprovider.Commit{}
+
+	for _, rc := range repoCommits {
+		c := rc.GetCommit()
+		c.SHA = rc.SHA
+		c.URL = rc.HTMLURL
+
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       rc.GetSHA(),
+				TreeSha:   c.GetTree().GetSHA(),
+				Author:    c.GetAuthor().GetName(),
+				Message:   c.GetMessage(),
+				CreatedAt: c.GetAuthor().GetDate(),
+				URL:       rc.GetHTMLURL(),
+			},
+			object: c,
+		})
+	}
+
+	return result, nil
+}
@@ -46,6 +46,10 @@ func (p *dryrunProvider) UploadDeployKey(_ context.Context, repoUrl RepoURL, dep
 	return nil
 }
 
+func (p *dryrunProvider) DeleteDeployKey(_ context.Context, repoUrl RepoURL, deployKey []byte) error {
+	return nil
+}
+
 func (p *dryrunProvider) CreatePullRequest(_ context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
 	return nil, nil
 }
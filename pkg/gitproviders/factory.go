@@ -12,9 +12,12 @@ import (
 type GitProviderName string
 
 const (
-	GitProviderGitHub GitProviderName = "github"
-	GitProviderGitLab GitProviderName = "gitlab"
-	tokenTypeOauth    string          = "oauth2"
+	GitProviderGitHub          GitProviderName = "github"
+	GitProviderGitLab          GitProviderName = "gitlab"
+	GitProviderBitbucketServer GitProviderName = "bitbucket-server"
+	GitProviderGitea           GitProviderName = "gitea"
+	GitProviderGit             GitProviderName = "git"
+	tokenTypeOauth             string          = "oauth2"
 )
 
 // Config defines the configuration for connecting to a GitProvider.
@@ -25,6 +25,19 @@ type FakeGitProvider struct {
 		result1 gitprovider.PullRequest
 		result2 error
 	}
+	DeleteDeployKeyStub        func(context.Context, gitproviders.RepoURL, []byte) error
+	deleteDeployKeyMutex       sync.RWMutex
+	deleteDeployKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 gitproviders.RepoURL
+		arg3 []byte
+	}
+	deleteDeployKeyReturns struct {
+		result1 error
+	}
+	deleteDeployKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeployKeyExistsStub        func(context.Context, gitproviders.RepoURL) (bool, error)
 	deployKeyExistsMutex       sync.RWMutex
 	deployKeyExistsArgsForCall []struct {
@@ -221,6 +234,74 @@ func (fake *FakeGitProvider) CreatePullRequestReturnsOnCall(i int, result1 gitpr
 	}{result1, result2}
 }
 
+func (fake *FakeGitProvider) DeleteDeployKey(arg1 context.Context, arg2 gitproviders.RepoURL, arg3 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.deleteDeployKeyMutex.Lock()
+	ret, specificReturn := fake.deleteDeployKeyReturnsOnCall[len(fake.deleteDeployKeyArgsForCall)]
+	fake.deleteDeployKeyArgsForCall = append(fake.deleteDeployKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 gitproviders.RepoURL
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.DeleteDeployKeyStub
+	fakeReturns := fake.deleteDeployKeyReturns
+	fake.recordInvocation("DeleteDeployKey", []interface{}{arg1, arg2, arg3Copy})
+	fake.deleteDeployKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyCallCount() int {
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
+	return len(fake.deleteDeployKeyArgsForCall)
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyCalls(stub func(context.Context, gitproviders.RepoURL, []byte) error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = stub
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyArgsForCall(i int) (context.Context, gitproviders.RepoURL, []byte) {
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
+	argsForCall := fake.deleteDeployKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyReturns(result1 error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = nil
+	fake.deleteDeployKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeGitProvider) DeleteDeployKeyReturnsOnCall(i int, result1 error) {
+	fake.deleteDeployKeyMutex.Lock()
+	defer fake.deleteDeployKeyMutex.Unlock()
+	fake.DeleteDeployKeyStub = nil
+	if fake.deleteDeployKeyReturnsOnCall == nil {
+		fake.deleteDeployKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteDeployKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeGitProvider) DeployKeyExists(arg1 context.Context, arg2 gitproviders.RepoURL) (bool, error) {
 	fake.deployKeyExistsMutex.Lock()
 	ret, specificReturn := fake.deployKeyExistsReturnsOnCall[len(fake.deployKeyExistsArgsForCall)]
@@ -806,6 +887,8 @@ func (fake *FakeGitProvider) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.createPullRequestMutex.RLock()
 	defer fake.createPullRequestMutex.RUnlock()
+	fake.deleteDeployKeyMutex.RLock()
+	defer fake.deleteDeployKeyMutex.RUnlock()
 	fake.deployKeyExistsMutex.RLock()
 	defer fake.deployKeyExistsMutex.RUnlock()
 	fake.getCommitsMutex.RLock()
@@ -0,0 +1,124 @@
+package gitproviders
+
+import (
+	"context"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	providerRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gitops",
+		Subsystem: "git_provider",
+		Name:      "requests_total",
+		Help:      "Number of git provider API calls by provider domain and operation.",
+	}, []string{"provider", "operation"})
+
+	providerErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gitops",
+		Subsystem: "git_provider",
+		Name:      "errors_total",
+		Help:      "Number of failed git provider API calls by provider domain and operation.",
+	}, []string{"provider", "operation"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(providerRequestsTotal, providerErrorsTotal)
+}
+
+type metricsProvider struct {
+	provider GitProvider
+}
+
+// NewMetricsProvider wraps a GitProvider to count its API calls and their errors.
+func NewMetricsProvider(provider GitProvider) GitProvider {
+	return &metricsProvider{
+		provider: provider,
+	}
+}
+
+func (p *metricsProvider) record(operation string, err error) {
+	domain := p.provider.GetProviderDomain()
+
+	providerRequestsTotal.WithLabelValues(domain, operation).Inc()
+
+	if err != nil {
+		providerErrorsTotal.WithLabelValues(domain, operation).Inc()
+	}
+}
+
+func (p *metricsProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	exists, err := p.provider.RepositoryExists(ctx, repoUrl)
+	p.record("RepositoryExists", err)
+
+	return exists, err
+}
+
+func (p *metricsProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	exists, err := p.provider.DeployKeyExists(ctx, repoUrl)
+	p.record("DeployKeyExists", err)
+
+	return exists, err
+}
+
+func (p *metricsProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	branch, err := p.provider.GetDefaultBranch(ctx, repoUrl)
+	p.record("GetDefaultBranch", err)
+
+	return branch, err
+}
+
+func (p *metricsProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	visibility, err := p.provider.GetRepoVisibility(ctx, repoUrl)
+	p.record("GetRepoVisibility", err)
+
+	return visibility, err
+}
+
+func (p *metricsProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	err := p.provider.UploadDeployKey(ctx, repoUrl, deployKey)
+	p.record("UploadDeployKey", err)
+
+	return err
+}
+
+func (p *metricsProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	err := p.provider.DeleteDeployKey(ctx, repoUrl, deployKey)
+	p.record("DeleteDeployKey", err)
+
+	return err
+}
+
+func (p *metricsProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	pr, err := p.provider.CreatePullRequest(ctx, repoUrl, prInfo)
+	p.record("CreatePullRequest", err)
+
+	return pr, err
+}
+
+func (p *metricsProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	commits, err := p.provider.GetCommits(ctx, repoUrl, targetBranch, pageSize, pageToken)
+	p.record("GetCommits", err)
+
+	return commits, err
+}
+
+func (p *metricsProvider) GetProviderDomain() string {
+	return p.provider.GetProviderDomain()
+}
+
+func (p *metricsProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	files, err := p.provider.GetRepoDirFiles(ctx, repoUrl, dirPath, targetBranch)
+	p.record("GetRepoDirFiles", err)
+
+	return files, err
+}
+
+func (p *metricsProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	err := p.provider.MergePullRequest(ctx, repoUrl, pullRequestNumber, commitMesage)
+	p.record("MergePullRequest", err)
+
+	return err
+}
@@ -0,0 +1,50 @@
+package gitproviders
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/weaveworks/weave-gitops/pkg/vendorfakes/fakegitprovider"
+)
+
+var _ = Describe("MetricsProvider", func() {
+	var (
+		repoClient *fakegitprovider.OrgRepositoriesClient
+		provider   GitProvider
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		repoClient = &fakegitprovider.OrgRepositoriesClient{}
+
+		client := &fakegitprovider.Client{}
+		client.OrgRepositoriesReturns(repoClient)
+		client.ProviderIDReturns(gitprovider.ProviderID(GitProviderGitHub))
+
+		provider = NewMetricsProvider(orgGitProvider{domain: "github.com", provider: client})
+	})
+
+	It("counts the calls and errors of the provider", func() {
+		requests := providerRequestsTotal.WithLabelValues("github.com", "RepositoryExists")
+		errs := providerErrorsTotal.WithLabelValues("github.com", "RepositoryExists")
+
+		before := testutil.ToFloat64(requests)
+		beforeErrs := testutil.ToFloat64(errs)
+
+		exists, err := provider.RepositoryExists(ctx, RepoURL{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		repoClient.GetReturns(nil, errors.New("rate limited"))
+
+		_, err = provider.RepositoryExists(ctx, RepoURL{})
+		Expect(err).To(MatchError(ContainSubstring("rate limited")))
+
+		Expect(testutil.ToFloat64(requests) - before).To(Equal(2.0))
+		Expect(testutil.ToFloat64(errs) - beforeErrs).To(Equal(1.0))
+	})
+})
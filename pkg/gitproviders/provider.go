@@ -35,6 +35,7 @@ type GitProvider interface {
 	GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error)
 	GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error)
 	UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error
+	DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error
 	CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error)
 	GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error)
 	GetProviderDomain() string
@@ -55,6 +56,26 @@ type PullRequestInfo struct {
 type AccountTypeGetter func(provider gitprovider.Client, domain string, owner string) (ProviderAccountType, error)
 
 func New(config Config, owner string, getAccountType AccountTypeGetter) (GitProvider, error) {
+	// Bitbucket Server and Gitea are not covered by go-git-providers and do not
+	// distinguish between user and organization repositories.
+	switch config.Provider {
+	case GitProviderBitbucketServer:
+		if err := validateRestConfig(config); err != nil {
+			return nil, err
+		}
+
+		return newBitbucketServerGitProvider(config.Hostname, config.Token, nil), nil
+	case GitProviderGitea:
+		if err := validateRestConfig(config); err != nil {
+			return nil, err
+		}
+
+		return newGiteaGitProvider(config.Hostname, config.Token, nil), nil
+	case GitProviderGit:
+		// Repositories without an API are accessed with git, a token is only needed for https
+		return newPlainGitProvider(config.Hostname, config.Token), nil
+	}
+
 	provider, domain, err := buildGitProvider(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build git provider: %w", err)
@@ -78,6 +99,18 @@ func New(config Config, owner string, getAccountType AccountTypeGetter) (GitProv
 	}, nil
 }
 
+func validateRestConfig(config Config) error {
+	if config.Token == "" {
+		return fmt.Errorf("failed to build git provider: no git provider token present")
+	}
+
+	if config.Hostname == "" {
+		return fmt.Errorf("failed to build git provider: a hostname is required for %s", config.Provider)
+	}
+
+	return nil
+}
+
 func deployKeyExists(ctx context.Context, repo gitprovider.UserRepository) (bool, error) {
 	_, err := repo.DeployKeys().Get(ctx, DeployKeyName)
 	if err != nil && !strings.Contains(err.Error(), "key is already in use") {
@@ -111,6 +144,41 @@ func uploadDeployKey(ctx context.Context, repo gitprovider.UserRepository, deplo
 	return nil
 }
 
+// deleteDeployKey deletes the deploy key with the public key deployKey. Several keys are named
+// DeployKeyName while they are rotated, so keys are matched by their key.
+func deleteDeployKey(ctx context.Context, repo gitprovider.UserRepository, deployKey []byte) error {
+	keys, err := repo.DeployKeys().List(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting deploy keys: %w", err)
+	}
+
+	for _, k := range keys {
+		info := k.Get()
+		if info.Name != DeployKeyName || !SameDeployKey(info.Key, deployKey) {
+			continue
+		}
+
+		if err := k.Delete(ctx); err != nil {
+			return fmt.Errorf("error deleting deploy key %s: %w", DeployKeyName, err)
+		}
+	}
+
+	return nil
+}
+
+// SameDeployKey returns true when two authorized_keys formatted public keys have the same type and key,
+// ignoring their comments.
+func SameDeployKey(a, b []byte) bool {
+	fieldsA := strings.Fields(string(a))
+	fieldsB := strings.Fields(string(b))
+
+	if len(fieldsA) < 2 || len(fieldsB) < 2 {
+		return false
+	}
+
+	return fieldsA[0] == fieldsB[0] && fieldsA[1] == fieldsB[1]
+}
+
 func createPullRequest(ctx context.Context, repo gitprovider.UserRepository, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
 	repoInfo := repo.Get()
 
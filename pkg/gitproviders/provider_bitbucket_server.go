@@ -0,0 +1,410 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+const bitbucketServerPageLimit = 1000
+
+// ErrFileDeletionUnsupported is returned when a pull request would delete files, which the
+// Bitbucket Server file edit API cannot do. Deletions have to be pushed to the branch with git
+// and the pull request created with SkipAddingFilesOnCreation, as removing an application does.
+var ErrFileDeletionUnsupported = errors.New("deleting files is not supported by the Bitbucket Server API, push the deletion with git before opening the pull request")
+
+// bitbucketServerGitProvider implements GitProvider against the Bitbucket Server (formerly Stash) REST API.
+// Repository owners map to project keys, personal repositories use the "~username" project key.
+type bitbucketServerGitProvider struct {
+	domain   string
+	webURL   string
+	client   restClient
+	keys     restClient
+	branches restClient
+}
+
+var _ GitProvider = bitbucketServerGitProvider{}
+
+func newBitbucketServerGitProvider(hostname, token string, httpClient *http.Client) bitbucketServerGitProvider {
+	baseURL := apiBaseURL(hostname)
+	authorization := "Bearer " + token
+
+	return bitbucketServerGitProvider{
+		domain:   hostname,
+		webURL:   baseURL,
+		client:   newRestClient(baseURL+"/rest/api/1.0", authorization, httpClient),
+		keys:     newRestClient(baseURL+"/rest/keys/1.0", authorization, httpClient),
+		branches: newRestClient(baseURL+"/rest/branch-utils/1.0", authorization, httpClient),
+	}
+}
+
+type bitbucketServerRepository struct {
+	Slug   string `json:"slug"`
+	Public bool   `json:"public"`
+}
+
+type bitbucketServerRef struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId,omitempty"`
+}
+
+type bitbucketServerSSHKey struct {
+	Key struct {
+		ID    int64  `json:"id,omitempty"`
+		Text  string `json:"text"`
+		Label string `json:"label"`
+	} `json:"key"`
+	Permission string `json:"permission"`
+}
+
+type bitbucketServerCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name string `json:"name"`
+	} `json:"author"`
+	AuthorTimestamp int64 `json:"authorTimestamp"`
+}
+
+type bitbucketServerPullRequest struct {
+	ID      int    `json:"id"`
+	Version int    `json:"version"`
+	State   string `json:"state"`
+	Links   struct {
+		Self []struct {
+			Href string `json:"href"`
+		} `json:"self"`
+	} `json:"links"`
+}
+
+func (p bitbucketServerGitProvider) repoPath(repoUrl RepoURL) string {
+	return fmt.Sprintf("/projects/%s/repos/%s", url.PathEscape(repoUrl.Owner()), url.PathEscape(repoUrl.RepositoryName()))
+}
+
+func (p bitbucketServerGitProvider) getRepo(ctx context.Context, repoUrl RepoURL) (*bitbucketServerRepository, error) {
+	repo := &bitbucketServerRepository{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl), nil, nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %s/%s: %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+	}
+
+	return repo, nil
+}
+
+func (p bitbucketServerGitProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.getRepo(ctx, repoUrl); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get verify repository exists  %w", err)
+	}
+
+	return true, nil
+}
+
+func (p bitbucketServerGitProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	var keys struct {
+		Values []bitbucketServerSSHKey `json:"values"`
+	}
+
+	query := url.Values{"limit": {strconv.Itoa(bitbucketServerPageLimit)}}
+	if err := p.keys.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/ssh", query, nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s: %w", DeployKeyName, err)
+	}
+
+	for _, k := range keys.Values {
+		if k.Key.Label == DeployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p bitbucketServerGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	key := bitbucketServerSSHKey{Permission: "REPO_WRITE"}
+	key.Key.Text = strings.TrimSpace(string(deployKey))
+	key.Key.Label = DeployKeyName
+
+	if err := p.keys.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/ssh", nil, key, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return ErrRepositoryNoPermissionsOrDoesNotExist
+		}
+
+		return fmt.Errorf("error uploading deploy key %w", err)
+	}
+
+	return nil
+}
+
+func (p bitbucketServerGitProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	var keys struct {
+		Values []bitbucketServerSSHKey `json:"values"`
+	}
+
+	query := url.Values{"limit": {strconv.Itoa(bitbucketServerPageLimit)}}
+	if err := p.keys.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/ssh", query, nil, &keys); err != nil {
+		return fmt.Errorf("error getting deploy keys: %w", err)
+	}
+
+	for _, k := range keys.Values {
+		if k.Key.Label != DeployKeyName || !SameDeployKey([]byte(k.Key.Text), deployKey) {
+			continue
+		}
+
+		if err := p.keys.do(ctx, http.MethodDelete, fmt.Sprintf("%s/ssh/%d", p.repoPath(repoUrl), k.Key.ID), nil, nil, nil); err != nil {
+			return fmt.Errorf("error deleting deploy key %s: %w", DeployKeyName, err)
+		}
+	}
+
+	return nil
+}
+
+func (p bitbucketServerGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	ref := &bitbucketServerRef{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/branches/default", nil, nil, ref); err != nil {
+		return "main", fmt.Errorf("error getting default branch: %w", err)
+	}
+
+	return ref.DisplayID, nil
+}
+
+func (p bitbucketServerGitProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.Public {
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic), nil
+	}
+
+	return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate), nil
+}
+
+func (p bitbucketServerGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.TargetBranch == "" {
+		defaultBranch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		prInfo.TargetBranch = defaultBranch
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		// Check every file before creating the branch so that nothing is left half done.
+		for _, file := range prInfo.Files {
+			if file.Content == nil {
+				return nil, fmt.Errorf("could not remove %s: %w", *file.Path, ErrFileDeletionUnsupported)
+			}
+		}
+
+		branch := map[string]string{
+			"name":       prInfo.NewBranch,
+			"startPoint": "refs/heads/" + prInfo.TargetBranch,
+		}
+
+		if err := p.branches.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/branches", nil, branch, nil); err != nil {
+			return nil, fmt.Errorf("error creating branch %s: %w", prInfo.NewBranch, err)
+		}
+
+		for _, file := range prInfo.Files {
+			if err := p.commitFile(ctx, repoUrl, prInfo.NewBranch, prInfo.CommitMessage, file); err != nil {
+				return nil, fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
+			}
+		}
+	}
+
+	body := map[string]interface{}{
+		"title":       prInfo.Title,
+		"description": prInfo.Description,
+		"fromRef":     bitbucketServerRef{ID: "refs/heads/" + prInfo.NewBranch},
+		"toRef":       bitbucketServerRef{ID: "refs/heads/" + prInfo.TargetBranch},
+	}
+
+	pr := &bitbucketServerPullRequest{}
+	if err := p.client.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/pull-requests", nil, body, pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
+	}
+
+	return toBitbucketServerPullRequest(pr), nil
+}
+
+// commitFile creates or updates a single file on a branch through the file edit API.
+// The content of file must not be nil.
+func (p bitbucketServerGitProvider) commitFile(ctx context.Context, repoUrl RepoURL, branch, message string, file gitprovider.CommitFile) error {
+	filePath := p.repoPath(repoUrl) + "/browse/" + escapePath(*file.Path)
+
+	exists := true
+	if err := p.client.do(ctx, http.MethodGet, filePath, url.Values{"at": {branch}, "type": {"true"}}, nil, nil); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return err
+		}
+
+		exists = false
+	}
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	fields := map[string]string{
+		"content": *file.Content,
+		"message": message,
+		"branch":  branch,
+	}
+
+	if exists {
+		commits, err := p.listCommits(ctx, repoUrl, branch, 1, 0)
+		if err != nil {
+			return err
+		}
+
+		if len(commits) > 0 {
+			fields["sourceCommitId"] = commits[0].ID
+		}
+	}
+
+	for k, v := range fields {
+		if err := form.WriteField(k, v); err != nil {
+			return err
+		}
+	}
+
+	if err := form.Close(); err != nil {
+		return err
+	}
+
+	res, err := p.client.send(ctx, http.MethodPut, filePath, nil, body, form.FormDataContentType())
+	if err != nil {
+		return err
+	}
+
+	return res.Body.Close()
+}
+
+func (p bitbucketServerGitProvider) listCommits(ctx context.Context, repoUrl RepoURL, branch string, pageSize, pageToken int) ([]bitbucketServerCommit, error) {
+	var commits struct {
+		Values []bitbucketServerCommit `json:"values"`
+	}
+
+	query := url.Values{
+		"until": {branch},
+		"limit": {strconv.Itoa(pageSize)},
+		"start": {strconv.Itoa(pageSize * pageToken)},
+	}
+
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/commits", query, nil, &commits); err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	return commits.Values, nil
+}
+
+func (p bitbucketServerGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	commits, err := p.listCommits(ctx, repoUrl, targetBranch, pageSize, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []gitprovider.Commit{}
+
+	for i := range commits {
+		c := commits[i]
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       c.ID,
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: millisToTime(c.AuthorTimestamp),
+				URL:       fmt.Sprintf("%s%s/commits/%s", p.webURL, p.repoPath(repoUrl), c.ID),
+			},
+			object: &c,
+		})
+	}
+
+	return result, nil
+}
+
+func (p bitbucketServerGitProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory. The dirPath must point to a directory, not a file.
+// Like the other providers, subdirectories are not read.
+func (p bitbucketServerGitProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	var listing struct {
+		Values []string `json:"values"`
+	}
+
+	query := url.Values{
+		"at":    {targetBranch},
+		"limit": {strconv.Itoa(bitbucketServerPageLimit)},
+	}
+
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/files/"+escapePath(dirPath), query, nil, &listing); err != nil {
+		return nil, err
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for _, name := range listing.Values {
+		// The files API lists the whole tree below the directory
+		if strings.Contains(name, "/") {
+			continue
+		}
+
+		filePath := strings.TrimPrefix(strings.TrimSuffix(dirPath, "/")+"/"+name, "/")
+
+		content, err := p.client.raw(ctx, p.repoPath(repoUrl)+"/raw/"+escapePath(filePath), url.Values{"at": {targetBranch}})
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", filePath, err)
+		}
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    gitprovider.StringVar(filePath),
+			Content: gitprovider.StringVar(string(content)),
+		})
+	}
+
+	return files, nil
+}
+
+// MergePullRequest merges a pull request given the repository's URL and the PR's number with a commit message.
+func (p bitbucketServerGitProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	prPath := fmt.Sprintf("%s/pull-requests/%d", p.repoPath(repoUrl), pullRequestNumber)
+
+	pr := &bitbucketServerPullRequest{}
+	if err := p.client.do(ctx, http.MethodGet, prPath, nil, nil, pr); err != nil {
+		return fmt.Errorf("error getting pull request %d: %w", pullRequestNumber, err)
+	}
+
+	// Bitbucket Server uses optimistic locking on pull requests
+	query := url.Values{"version": {strconv.Itoa(pr.Version)}}
+
+	return p.client.do(ctx, http.MethodPost, prPath+"/merge", query, map[string]string{"message": commitMesage}, nil)
+}
+
+func toBitbucketServerPullRequest(pr *bitbucketServerPullRequest) gitprovider.PullRequest {
+	webURL := ""
+	if len(pr.Links.Self) > 0 {
+		webURL = pr.Links.Self[0].Href
+	}
+
+	return restPullRequest{
+		info: gitprovider.PullRequestInfo{
+			Merged: pr.State == "MERGED",
+			Number: pr.ID,
+			WebURL: webURL,
+		},
+		object: pr,
+	}
+}
@@ -0,0 +1,294 @@
+package gitproviders
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Bitbucket Server Provider", func() {
+	const repoPath = "/rest/api/1.0/projects/ACME/repos/podinfo"
+
+	var (
+		ctx      context.Context
+		mux      *http.ServeMux
+		server   *httptest.Server
+		provider GitProvider
+		repoUrl  RepoURL
+	)
+
+	writeJSON := func(w http.ResponseWriter, status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+	}
+
+	readJSON := func(r *http.Request) map[string]interface{} {
+		body := map[string]interface{}{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+		return body
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		provider = newBitbucketServerGitProvider(server.URL, "the-token", server.Client())
+		repoUrl = RepoURL{owner: "ACME", repoName: "podinfo"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("RepositoryExists", func() {
+		It("returns true when the repository exists", func() {
+			mux.HandleFunc(repoPath, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer the-token"))
+				writeJSON(w, http.StatusOK, map[string]interface{}{"slug": "podinfo"})
+			})
+
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("returns false when the repository is not found", func() {
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	It("reads the default branch and visibility of the repository", func() {
+		mux.HandleFunc(repoPath+"/branches/default", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": "refs/heads/develop", "displayId": "develop"})
+		})
+		mux.HandleFunc(repoPath, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"slug": "podinfo", "public": true})
+		})
+
+		branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(branch).To(Equal("develop"))
+
+		visibility, err := provider.GetRepoVisibility(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*visibility).To(Equal(gitprovider.RepositoryVisibilityPublic))
+	})
+
+	Describe("deploy keys", func() {
+		const keysPath = "/rest/keys/1.0/projects/ACME/repos/podinfo/ssh"
+
+		It("finds an existing deploy key", func() {
+			mux.HandleFunc(keysPath, func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"values": []map[string]interface{}{{"key": map[string]interface{}{"label": DeployKeyName}}},
+				})
+			})
+
+			exists, err := provider.DeployKeyExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("uploads a writable deploy key", func() {
+			var uploaded map[string]interface{}
+
+			mux.HandleFunc(keysPath, func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodPost))
+				uploaded = readJSON(r)
+				writeJSON(w, http.StatusCreated, uploaded)
+			})
+
+			Expect(provider.UploadDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA\n"))).To(Succeed())
+			Expect(uploaded).To(HaveKeyWithValue("permission", "REPO_WRITE"))
+			Expect(uploaded).To(HaveKeyWithValue("key", map[string]interface{}{"text": "ssh-ed25519 AAAA", "label": DeployKeyName}))
+		})
+
+		It("deletes the deploy key with the given key", func() {
+			deleted := []string{}
+
+			mux.HandleFunc(keysPath, func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, map[string]interface{}{
+					"values": []map[string]interface{}{
+						{"key": map[string]interface{}{"id": 1, "label": DeployKeyName, "text": "ssh-ed25519 AAAA"}},
+						{"key": map[string]interface{}{"id": 2, "label": DeployKeyName, "text": "ssh-ed25519 BBBB"}},
+					},
+				})
+			})
+			mux.HandleFunc(keysPath+"/", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodDelete))
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			Expect(provider.DeleteDeployKey(ctx, repoUrl, []byte("ssh-ed25519 BBBB"))).To(Succeed())
+			Expect(deleted).To(Equal([]string{keysPath + "/2"}))
+		})
+	})
+
+	It("creates a pull request with the given files", func() {
+		var (
+			branch  map[string]interface{}
+			created map[string]string
+			updated map[string]string
+			pr      map[string]interface{}
+		)
+
+		readForm := func(r *http.Request) map[string]string {
+			Expect(r.ParseMultipartForm(1 << 20)).To(Succeed())
+
+			form := map[string]string{}
+			for k, v := range r.MultipartForm.Value {
+				form[k] = v[0]
+			}
+
+			return form
+		}
+
+		mux.HandleFunc("/rest/branch-utils/1.0/projects/ACME/repos/podinfo/branches", func(w http.ResponseWriter, r *http.Request) {
+			branch = readJSON(r)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+		mux.HandleFunc(repoPath+"/browse/apps/new.yaml", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			Expect(r.Method).To(Equal(http.MethodPut))
+			created = readForm(r)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+		mux.HandleFunc(repoPath+"/browse/apps/old.yaml", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				Expect(r.URL.Query().Get("at")).To(Equal("new-branch"))
+				writeJSON(w, http.StatusOK, map[string]interface{}{"type": "FILE"})
+
+				return
+			}
+
+			updated = readForm(r)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+		mux.HandleFunc(repoPath+"/commits", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("until")).To(Equal("new-branch"))
+			writeJSON(w, http.StatusOK, map[string]interface{}{"values": []map[string]interface{}{{"id": "abc"}}})
+		})
+		mux.HandleFunc(repoPath+"/pull-requests", func(w http.ResponseWriter, r *http.Request) {
+			pr = readJSON(r)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{
+				"id":    7,
+				"state": "OPEN",
+				"links": map[string]interface{}{
+					"self": []map[string]interface{}{{"href": "https://bitbucket.example.com/projects/ACME/repos/podinfo/pull-requests/7"}},
+				},
+			})
+		})
+
+		res, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+			Title:         "Add app",
+			Description:   "Adds an app",
+			CommitMessage: "add app",
+			TargetBranch:  "main",
+			NewBranch:     "new-branch",
+			Files: []gitprovider.CommitFile{
+				{Path: gitprovider.StringVar("apps/new.yaml"), Content: gitprovider.StringVar("new")},
+				{Path: gitprovider.StringVar("apps/old.yaml"), Content: gitprovider.StringVar("old")},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Get().Number).To(Equal(7))
+		Expect(res.Get().WebURL).To(Equal("https://bitbucket.example.com/projects/ACME/repos/podinfo/pull-requests/7"))
+
+		Expect(branch).To(Equal(map[string]interface{}{"name": "new-branch", "startPoint": "refs/heads/main"}))
+		Expect(created).To(Equal(map[string]string{"content": "new", "message": "add app", "branch": "new-branch"}))
+		Expect(updated).To(HaveKeyWithValue("sourceCommitId", "abc"))
+		Expect(pr).To(HaveKeyWithValue("fromRef", map[string]interface{}{"id": "refs/heads/new-branch"}))
+		Expect(pr).To(HaveKeyWithValue("toRef", map[string]interface{}{"id": "refs/heads/main"}))
+	})
+
+	It("refuses to delete files before creating the branch", func() {
+		branchCreated := false
+		mux.HandleFunc("/rest/branch-utils/1.0/projects/ACME/repos/podinfo/branches", func(w http.ResponseWriter, r *http.Request) {
+			branchCreated = true
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+
+		_, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+			TargetBranch: "main",
+			NewBranch:    "new-branch",
+			Files: []gitprovider.CommitFile{
+				{Path: gitprovider.StringVar("apps/new.yaml"), Content: gitprovider.StringVar("kind: Application")},
+				{Path: gitprovider.StringVar("apps/old.yaml")},
+			},
+		})
+		Expect(errors.Is(err, ErrFileDeletionUnsupported)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("apps/old.yaml")))
+		Expect(branchCreated).To(BeFalse())
+	})
+
+	It("returns the commits of a branch", func() {
+		mux.HandleFunc(repoPath+"/commits", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("until")).To(Equal("main"))
+			Expect(r.URL.Query().Get("limit")).To(Equal("10"))
+			Expect(r.URL.Query().Get("start")).To(Equal("20"))
+
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"values": []map[string]interface{}{{
+					"id":              "abc",
+					"message":         "first",
+					"author":          map[string]interface{}{"name": "Jane"},
+					"authorTimestamp": 1641092645000,
+				}},
+			})
+		})
+
+		commits, err := provider.GetCommits(ctx, repoUrl, "main", 10, 2)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(commits).To(HaveLen(1))
+		Expect(commits[0].Get().Sha).To(Equal("abc"))
+		Expect(commits[0].Get().Author).To(Equal("Jane"))
+		Expect(commits[0].Get().CreatedAt.Unix()).To(Equal(int64(1641092645)))
+		Expect(commits[0].Get().URL).To(Equal(server.URL + "/projects/ACME/repos/podinfo/commits/abc"))
+	})
+
+	It("reads the files of a directory", func() {
+		mux.HandleFunc(repoPath+"/files/apps", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("at")).To(Equal("main"))
+			writeJSON(w, http.StatusOK, map[string]interface{}{"values": []string{"a.yaml", "nested/b.yaml"}})
+		})
+		mux.HandleFunc(repoPath+"/raw/apps/a.yaml", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "kind: ConfigMap")
+		})
+
+		files, err := provider.GetRepoDirFiles(ctx, repoUrl, "apps", "main")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(*files[0].Path).To(Equal("apps/a.yaml"))
+		Expect(*files[0].Content).To(Equal("kind: ConfigMap"))
+	})
+
+	It("merges a pull request at its current version", func() {
+		mux.HandleFunc(repoPath+"/pull-requests/7", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"id": 7, "version": 3})
+		})
+		mux.HandleFunc(repoPath+"/pull-requests/7/merge", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Query().Get("version")).To(Equal("3"))
+			Expect(readJSON(r)).To(Equal(map[string]interface{}{"message": "merge it"}))
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+
+		Expect(provider.MergePullRequest(ctx, repoUrl, 7, "merge it")).To(Succeed())
+	})
+})
@@ -0,0 +1,394 @@
+package gitproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/go-git/go-billy/v5/memfs"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// defaultGitBranch is the branch of repositories without commits.
+const defaultGitBranch = "main"
+
+// plainGitProvider implements GitProvider for repositories that are only reachable with git, like
+// repositories on an SSH server or a local path. Everything is read with ls-remote and clones, and
+// pull requests are pushed as branches to be merged by hand.
+type plainGitProvider struct {
+	domain string
+	auth   transport.AuthMethod
+	out    io.Writer
+}
+
+var _ GitProvider = plainGitProvider{}
+
+// newPlainGitProvider returns a provider authenticating with token over HTTPS when it is set. SSH
+// repositories are accessed with the keys of the SSH agent.
+func newPlainGitProvider(hostname, token string) plainGitProvider {
+	var auth transport.AuthMethod
+	if token != "" {
+		auth = &http.BasicAuth{Username: "git", Password: token}
+	}
+
+	return plainGitProvider{
+		domain: hostname,
+		auth:   auth,
+		out:    os.Stdout,
+	}
+}
+
+func (p plainGitProvider) listRefs(ctx context.Context, repoUrl RepoURL) ([]*plumbing.Reference, error) {
+	remote := gogit.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: gogit.DefaultRemoteName,
+		URLs: []string{repoUrl.CloneURL()},
+	})
+
+	refs, err := remote.ListContext(ctx, &gogit.ListOptions{Auth: p.auth})
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return []*plumbing.Reference{}, nil
+		}
+
+		return nil, fmt.Errorf("error listing the references of %s: %w", repoUrl, err)
+	}
+
+	return refs, nil
+}
+
+// clone clones the branch into memory, without checking it out.
+func (p plainGitProvider) clone(ctx context.Context, repoUrl RepoURL, branch string) (*gogit.Repository, error) {
+	repo, err := gogit.CloneContext(ctx, memory.NewStorage(), nil, &gogit.CloneOptions{
+		URL:           repoUrl.CloneURL(),
+		Auth:          p.auth,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+		NoCheckout:    true,
+		Tags:          gogit.NoTags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error cloning branch %s of %s: %w", branch, repoUrl, err)
+	}
+
+	return repo, nil
+}
+
+func (p plainGitProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.listRefs(ctx, repoUrl); err != nil {
+		if errors.Is(err, transport.ErrRepositoryNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeployKeyExists returns false, as the keys authorized by a git server cannot be listed. The deploy
+// key in the cluster, when there is one, is assumed to be authorized.
+func (p plainGitProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	return false, nil
+}
+
+// UploadDeployKey prints the deploy key for it to be authorized on the git server by hand, git operations
+// use the SSH agent in the meantime. Local repositories do not need one.
+func (p plainGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	if repoUrl.URL().Scheme == "file" {
+		return nil
+	}
+
+	fmt.Fprintf(p.out, "Authorize this deploy key on %s to give access to %s:\n%s\n", repoUrl.URL().Host, repoUrl, deployKey)
+
+	return nil
+}
+
+// DeleteDeployKey prints the deploy key for it to be removed from the git server by hand.
+func (p plainGitProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	if repoUrl.URL().Scheme == "file" {
+		return nil
+	}
+
+	fmt.Fprintf(p.out, "The deploy key is no longer used by %s and can be removed from %s:\n%s\n", repoUrl, repoUrl.URL().Host, deployKey)
+
+	return nil
+}
+
+// GetDefaultBranch returns the branch HEAD points to on the remote, like git clone does.
+func (p plainGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	refs, err := p.listRefs(ctx, repoUrl)
+	if err != nil {
+		return "", err
+	}
+
+	if len(refs) == 0 {
+		return defaultGitBranch, nil
+	}
+
+	var head *plumbing.Reference
+
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD {
+			head = ref
+		}
+	}
+
+	if head == nil {
+		return "", fmt.Errorf("no HEAD found in %s", repoUrl)
+	}
+
+	if head.Type() == plumbing.SymbolicReference {
+		return head.Target().Short(), nil
+	}
+
+	// Servers that do not advertise the target of HEAD: pick a branch at the same commit
+	for _, ref := range refs {
+		if ref.Name().IsBranch() && ref.Hash() == head.Hash() {
+			return ref.Name().Short(), nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find the branch HEAD points to in %s", repoUrl)
+}
+
+// GetRepoVisibility returns private, as git does not tell whether a repository can be read without
+// credentials.
+func (p plainGitProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	visibility := gitprovider.RepositoryVisibilityPrivate
+
+	return &visibility, nil
+}
+
+// CreatePullRequest pushes the files to a new branch. There are no pull requests with git alone, so the
+// branch has to be merged by hand; the returned pull request has no number.
+func (p plainGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.TargetBranch == "" {
+		defaultBranch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		prInfo.TargetBranch = defaultBranch
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		if err := p.pushBranch(ctx, repoUrl, prInfo); err != nil {
+			return nil, fmt.Errorf("error creating branch %s: %w", prInfo.NewBranch, err)
+		}
+	}
+
+	return restPullRequest{
+		info: gitprovider.PullRequestInfo{
+			WebURL: fmt.Sprintf("%s (merge branch %s into %s)", repoUrl, prInfo.NewBranch, prInfo.TargetBranch),
+		},
+	}, nil
+}
+
+// pushBranch commits the files of the pull request on top of its target branch and pushes them to its
+// new branch.
+func (p plainGitProvider) pushBranch(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) error {
+	repo, err := gogit.CloneContext(ctx, memory.NewStorage(), memfs.New(), &gogit.CloneOptions{
+		URL:           repoUrl.CloneURL(),
+		Auth:          p.auth,
+		ReferenceName: plumbing.NewBranchReferenceName(prInfo.TargetBranch),
+		SingleBranch:  true,
+		Tags:          gogit.NoTags,
+	})
+	if err != nil {
+		return fmt.Errorf("error cloning branch %s: %w", prInfo.TargetBranch, err)
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	newBranch := plumbing.NewBranchReferenceName(prInfo.NewBranch)
+	if err := wt.Checkout(&gogit.CheckoutOptions{Branch: newBranch, Create: true}); err != nil {
+		return err
+	}
+
+	for _, file := range prInfo.Files {
+		if err := writeWorktreeFile(wt, file); err != nil {
+			return err
+		}
+	}
+
+	_, err = wt.Commit(prInfo.CommitMessage, &gogit.CommitOptions{
+		Author: &object.Signature{Name: "Weave Gitops", Email: "weave-gitops@weave.works", When: time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("error committing files: %w", err)
+	}
+
+	return repo.PushContext(ctx, &gogit.PushOptions{
+		RemoteName: gogit.DefaultRemoteName,
+		Auth:       p.auth,
+		RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("%s:%s", newBranch, newBranch))},
+	})
+}
+
+// writeWorktreeFile creates, updates or deletes (when the content is nil) a file of the worktree.
+func writeWorktreeFile(wt *gogit.Worktree, file gitprovider.CommitFile) error {
+	if file.Content == nil {
+		if _, err := wt.Remove(*file.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing file %s: %w", *file.Path, err)
+		}
+
+		return nil
+	}
+
+	if err := wt.Filesystem.MkdirAll(path.Dir(*file.Path), 0755); err != nil {
+		return err
+	}
+
+	f, err := wt.Filesystem.Create(*file.Path)
+	if err != nil {
+		return fmt.Errorf("error writing file %s: %w", *file.Path, err)
+	}
+
+	_, err = f.Write([]byte(*file.Content))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return fmt.Errorf("error writing file %s: %w", *file.Path, err)
+	}
+
+	_, err = wt.Add(*file.Path)
+
+	return err
+}
+
+// GetCommits returns a page of the history of the branch. Pages start at 1.
+func (p plainGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	page := pageToken
+	if page < 1 {
+		page = 1
+	}
+
+	repo, err := p.clone(ctx, repoUrl, targetBranch)
+	if err != nil {
+		if errors.Is(err, transport.ErrEmptyRemoteRepository) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	iter, err := repo.Log(&gogit.LogOptions{From: head.Hash()})
+	if err != nil {
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+	defer iter.Close()
+
+	result := []gitprovider.Commit{}
+	skip := (page - 1) * pageSize
+
+	for len(result) < pageSize {
+		c, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error getting commits: %w", err)
+		}
+
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       c.Hash.String(),
+				TreeSha:   c.TreeHash.String(),
+				Author:    c.Author.Name,
+				Message:   c.Message,
+				CreatedAt: c.Author.When,
+			},
+			object: c,
+		})
+	}
+
+	return result, nil
+}
+
+func (p plainGitProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory. The dirPath must point to a directory, not a file.
+// Like the other providers, subdirectories are not read.
+func (p plainGitProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	repo, err := p.clone(ctx, repoUrl, targetBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := root.Tree(path.Clean(dirPath))
+	if err != nil {
+		return nil, fmt.Errorf("error getting directory %s: %w", dirPath, err)
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for _, entry := range tree.Entries {
+		if !entry.Mode.IsFile() {
+			continue
+		}
+
+		file, err := tree.TreeEntryFile(&entry)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := file.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", entry.Name, err)
+		}
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    gitprovider.StringVar(path.Join(dirPath, entry.Name)),
+			Content: gitprovider.StringVar(content),
+		})
+	}
+
+	return files, nil
+}
+
+// MergePullRequest fails, as the branches pushed by CreatePullRequest have to be merged by hand.
+func (p plainGitProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	return fmt.Errorf("pull requests cannot be merged in %s, the repository has no git provider API", repoUrl)
+}
@@ -0,0 +1,173 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Plain git Provider", func() {
+	var (
+		ctx      context.Context
+		dir      string
+		out      *bytes.Buffer
+		provider GitProvider
+		repoUrl  RepoURL
+	)
+
+	// commit commits a file in the work repository and pushes it to the main branch of the remote.
+	commit := func(work *gogit.Repository, path, content string) {
+		wt, err := work.Worktree()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(wt.Filesystem.Root(), filepath.Dir(path)), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(wt.Filesystem.Root(), path), []byte(content), 0644)).To(Succeed())
+
+		_, err = wt.Add(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = wt.Commit("Add "+path, &gogit.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(work.Push(&gogit.PushOptions{
+			RefSpecs: []config.RefSpec{"refs/heads/master:refs/heads/main"},
+		})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+
+		dir, err = ioutil.TempDir("", "plain-git-provider-")
+		Expect(err).NotTo(HaveOccurred())
+
+		remoteDir := filepath.Join(dir, "config.git")
+		remote, err := gogit.PlainInit(remoteDir, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.NewBranchReferenceName("main")))).To(Succeed())
+
+		work, err := gogit.PlainInit(filepath.Join(dir, "work"), false)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = work.CreateRemote(&config.RemoteConfig{Name: gogit.DefaultRemoteName, URLs: []string{remoteDir}})
+		Expect(err).NotTo(HaveOccurred())
+
+		commit(work, "README.md", "config")
+		commit(work, "apps/podinfo/app.yaml", "kind: Application")
+
+		repoUrl, err = NewRepoURL("file://" + remoteDir)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = &bytes.Buffer{}
+		plain := newPlainGitProvider("", "")
+		plain.out = out
+		provider = plain
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("is used for local repositories", func() {
+		Expect(repoUrl.Provider()).To(Equal(GitProviderGit))
+
+		p, err := New(Config{Provider: GitProviderGit}, repoUrl.Owner(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p).To(BeAssignableToTypeOf(plainGitProvider{}))
+	})
+
+	It("checks the repository exists", func() {
+		exists, err := provider.RepositoryExists(ctx, repoUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("gets the default branch from HEAD", func() {
+		branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(branch).To(Equal("main"))
+	})
+
+	It("gets the commits page by page", func() {
+		commits, err := provider.GetCommits(ctx, repoUrl, "main", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(HaveLen(1))
+		Expect(commits[0].Get().Message).To(Equal("Add apps/podinfo/app.yaml"))
+
+		commits, err = provider.GetCommits(ctx, repoUrl, "main", 1, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(HaveLen(1))
+		Expect(commits[0].Get().Message).To(Equal("Add README.md"))
+		Expect(commits[0].Get().Author).To(Equal("test"))
+
+		payload, signature := CommitSignature(commits[0])
+		Expect(payload).To(BeNil())
+		Expect(signature).To(BeEmpty())
+	})
+
+	It("gets the files of a directory", func() {
+		files, err := provider.GetRepoDirFiles(ctx, repoUrl, "apps/podinfo", "main")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(*files[0].Path).To(Equal("apps/podinfo/app.yaml"))
+		Expect(*files[0].Content).To(Equal("kind: Application"))
+	})
+
+	It("pushes a branch instead of creating a pull request", func() {
+		path := "apps/podinfo/app.yaml"
+		content := "kind: Application\nmetadata:\n  name: podinfo\n"
+
+		pr, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+			Title:         "Gitops add podinfo",
+			CommitMessage: "Add application manifests",
+			NewBranch:     "podinfo-branch",
+			Files:         []gitprovider.CommitFile{{Path: &path, Content: &content}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pr.Get().WebURL).To(ContainSubstring("merge branch podinfo-branch into main"))
+
+		files, err := provider.GetRepoDirFiles(ctx, repoUrl, "apps/podinfo", "podinfo-branch")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*files[0].Content).To(Equal(content))
+
+		commits, err := provider.GetCommits(ctx, repoUrl, "podinfo-branch", 10, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(commits).To(HaveLen(3))
+		Expect(commits[0].Get().Message).To(Equal("Add application manifests"))
+
+		Expect(provider.MergePullRequest(ctx, repoUrl, pr.Get().Number, "merge")).To(MatchError(ContainSubstring("cannot be merged")))
+	})
+
+	It("prints the deploy keys of repositories on a git server", func() {
+		viper.Set("git-host-types", "git.acme.org=git")
+		serverUrl, err := NewRepoURL("ssh://git@git.acme.org/config.git")
+		Expect(err).NotTo(HaveOccurred())
+
+		exists, err := provider.DeployKeyExists(ctx, serverUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+
+		Expect(provider.UploadDeployKey(ctx, serverUrl, []byte("ssh-ed25519 AAAA"))).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Authorize this deploy key on git.acme.org"))
+		Expect(out.String()).To(ContainSubstring("ssh-ed25519 AAAA"))
+
+		out.Reset()
+		Expect(provider.UploadDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA"))).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,353 @@
+package gitproviders
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// giteaGitProvider implements GitProvider against the Gitea REST API (v1).
+type giteaGitProvider struct {
+	domain string
+	client restClient
+}
+
+var _ GitProvider = giteaGitProvider{}
+
+func newGiteaGitProvider(hostname, token string, httpClient *http.Client) giteaGitProvider {
+	baseURL := apiBaseURL(hostname)
+
+	return giteaGitProvider{
+		domain: hostname,
+		client: newRestClient(baseURL+"/api/v1", "token "+token, httpClient),
+	}
+}
+
+type giteaRepository struct {
+	DefaultBranch string `json:"default_branch"`
+	Private       bool   `json:"private"`
+	Internal      bool   `json:"internal"`
+}
+
+type giteaDeployKey struct {
+	ID       int64  `json:"id,omitempty"`
+	Title    string `json:"title"`
+	Key      string `json:"key"`
+	ReadOnly bool   `json:"read_only"`
+}
+
+type giteaCommit struct {
+	Sha     string `json:"sha"`
+	HTMLURL string `json:"html_url"`
+	Commit  struct {
+		Message string `json:"message"`
+		Author  struct {
+			Name string    `json:"name"`
+			Date time.Time `json:"date"`
+		} `json:"author"`
+		Tree struct {
+			Sha string `json:"sha"`
+		} `json:"tree"`
+		Verification struct {
+			Signature string `json:"signature"`
+			Payload   string `json:"payload"`
+		} `json:"verification"`
+	} `json:"commit"`
+}
+
+type giteaContent struct {
+	Type    string `json:"type"`
+	Path    string `json:"path"`
+	Sha     string `json:"sha"`
+	Content string `json:"content"`
+}
+
+type giteaPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	Merged  bool   `json:"merged"`
+}
+
+func (p giteaGitProvider) repoPath(repoUrl RepoURL) string {
+	return fmt.Sprintf("/repos/%s/%s", url.PathEscape(repoUrl.Owner()), url.PathEscape(repoUrl.RepositoryName()))
+}
+
+func (p giteaGitProvider) getRepo(ctx context.Context, repoUrl RepoURL) (*giteaRepository, error) {
+	repo := &giteaRepository{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl), nil, nil, repo); err != nil {
+		return nil, fmt.Errorf("error getting repository %s/%s: %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+	}
+
+	return repo, nil
+}
+
+func (p giteaGitProvider) RepositoryExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	if _, err := p.getRepo(ctx, repoUrl); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("could not get verify repository exists  %w", err)
+	}
+
+	return true, nil
+}
+
+func (p giteaGitProvider) DeployKeyExists(ctx context.Context, repoUrl RepoURL) (bool, error) {
+	keys := []giteaDeployKey{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/keys", nil, nil, &keys); err != nil {
+		return false, fmt.Errorf("error getting deploy key %s: %w", DeployKeyName, err)
+	}
+
+	for _, k := range keys {
+		if k.Title == DeployKeyName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (p giteaGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	key := giteaDeployKey{
+		Title:    DeployKeyName,
+		Key:      string(deployKey),
+		ReadOnly: false,
+	}
+
+	if err := p.client.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/keys", nil, key, nil); err != nil {
+		if errors.Is(err, gitprovider.ErrNotFound) {
+			return ErrRepositoryNoPermissionsOrDoesNotExist
+		}
+
+		return fmt.Errorf("error uploading deploy key %w", err)
+	}
+
+	return nil
+}
+
+func (p giteaGitProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	keys := []giteaDeployKey{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/keys", nil, nil, &keys); err != nil {
+		return fmt.Errorf("error getting deploy keys: %w", err)
+	}
+
+	for _, k := range keys {
+		if k.Title != DeployKeyName || !SameDeployKey([]byte(k.Key), deployKey) {
+			continue
+		}
+
+		if err := p.client.do(ctx, http.MethodDelete, fmt.Sprintf("%s/keys/%d", p.repoPath(repoUrl), k.ID), nil, nil, nil); err != nil {
+			return fmt.Errorf("error deleting deploy key %s: %w", DeployKeyName, err)
+		}
+	}
+
+	return nil
+}
+
+func (p giteaGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return "main", err
+	}
+
+	return repo.DefaultBranch, nil
+}
+
+func (p giteaGitProvider) GetRepoVisibility(ctx context.Context, repoUrl RepoURL) (*gitprovider.RepositoryVisibility, error) {
+	repo, err := p.getRepo(ctx, repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case repo.Internal:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityInternal), nil
+	case repo.Private:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPrivate), nil
+	default:
+		return gitprovider.RepositoryVisibilityVar(gitprovider.RepositoryVisibilityPublic), nil
+	}
+}
+
+func (p giteaGitProvider) CreatePullRequest(ctx context.Context, repoUrl RepoURL, prInfo PullRequestInfo) (gitprovider.PullRequest, error) {
+	if prInfo.TargetBranch == "" {
+		defaultBranch, err := p.GetDefaultBranch(ctx, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		prInfo.TargetBranch = defaultBranch
+	}
+
+	if !prInfo.SkipAddingFilesOnCreation {
+		branch := map[string]string{
+			"new_branch_name": prInfo.NewBranch,
+			"old_branch_name": prInfo.TargetBranch,
+		}
+
+		if err := p.client.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/branches", nil, branch, nil); err != nil {
+			return nil, fmt.Errorf("error creating branch %s: %w", prInfo.NewBranch, err)
+		}
+
+		for _, file := range prInfo.Files {
+			if err := p.commitFile(ctx, repoUrl, prInfo.NewBranch, prInfo.CommitMessage, file); err != nil {
+				return nil, fmt.Errorf("error creating commit %s: %w", prInfo.NewBranch, err)
+			}
+		}
+	}
+
+	pr := &giteaPullRequest{}
+	body := map[string]string{
+		"title": prInfo.Title,
+		"body":  prInfo.Description,
+		"head":  prInfo.NewBranch,
+		"base":  prInfo.TargetBranch,
+	}
+
+	if err := p.client.do(ctx, http.MethodPost, p.repoPath(repoUrl)+"/pulls", nil, body, pr); err != nil {
+		return nil, fmt.Errorf("error creating pull request %s: %w", prInfo.Title, err)
+	}
+
+	return restPullRequest{
+		info: gitprovider.PullRequestInfo{
+			Merged: pr.Merged,
+			Number: pr.Number,
+			WebURL: pr.HTMLURL,
+		},
+		object: pr,
+	}, nil
+}
+
+// commitFile creates, updates or deletes (when the content is nil) a single file on a branch.
+func (p giteaGitProvider) commitFile(ctx context.Context, repoUrl RepoURL, branch, message string, file gitprovider.CommitFile) error {
+	filePath := p.repoPath(repoUrl) + "/contents/" + escapePath(*file.Path)
+
+	existing := &giteaContent{}
+	if err := p.client.do(ctx, http.MethodGet, filePath, url.Values{"ref": {branch}}, nil, existing); err != nil {
+		if !errors.Is(err, gitprovider.ErrNotFound) {
+			return err
+		}
+
+		existing = nil
+	}
+
+	body := map[string]string{
+		"branch":  branch,
+		"message": message,
+	}
+
+	if existing != nil {
+		body["sha"] = existing.Sha
+	}
+
+	if file.Content == nil {
+		if existing == nil {
+			return nil
+		}
+
+		return p.client.do(ctx, http.MethodDelete, filePath, nil, body, nil)
+	}
+
+	body["content"] = base64.StdEncoding.EncodeToString([]byte(*file.Content))
+
+	method := http.MethodPost
+	if existing != nil {
+		method = http.MethodPut
+	}
+
+	return p.client.do(ctx, method, filePath, nil, body, nil)
+}
+
+func (p giteaGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetBranch string, pageSize int, pageToken int) ([]gitprovider.Commit, error) {
+	// Gitea pages start at 1
+	page := pageToken
+	if page < 1 {
+		page = 1
+	}
+
+	query := url.Values{
+		"sha":   {targetBranch},
+		"limit": {strconv.Itoa(pageSize)},
+		"page":  {strconv.Itoa(page)},
+	}
+
+	commits := []giteaCommit{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/commits", query, nil, &commits); err != nil {
+		// Gitea answers with a conflict when the repository has no commits yet
+		if isStatus(err, http.StatusConflict) {
+			return []gitprovider.Commit{}, nil
+		}
+
+		return nil, fmt.Errorf("error getting commits: %w", err)
+	}
+
+	result := []gitprovider.Commit{}
+
+	for i := range commits {
+		c := commits[i]
+		result = append(result, restCommit{
+			info: gitprovider.CommitInfo{
+				Sha:       c.Sha,
+				TreeSha:   c.Commit.Tree.Sha,
+				Author:    c.Commit.Author.Name,
+				Message:   c.Commit.Message,
+				CreatedAt: c.Commit.Author.Date,
+				URL:       c.HTMLURL,
+			},
+			object: &c,
+		})
+	}
+
+	return result, nil
+}
+
+func (p giteaGitProvider) GetProviderDomain() string {
+	return p.domain
+}
+
+// GetRepoDirFiles returns the files found in a directory. The dirPath must point to a directory, not a file.
+// Like the other providers, subdirectories are not read.
+func (p giteaGitProvider) GetRepoDirFiles(ctx context.Context, repoUrl RepoURL, dirPath, targetBranch string) ([]*gitprovider.CommitFile, error) {
+	entries := []giteaContent{}
+	if err := p.client.do(ctx, http.MethodGet, p.repoPath(repoUrl)+"/contents/"+escapePath(dirPath), url.Values{"ref": {targetBranch}}, nil, &entries); err != nil {
+		return nil, err
+	}
+
+	files := []*gitprovider.CommitFile{}
+
+	for _, entry := range entries {
+		if entry.Type != "file" {
+			continue
+		}
+
+		content, err := p.client.raw(ctx, p.repoPath(repoUrl)+"/raw/"+escapePath(entry.Path), url.Values{"ref": {targetBranch}})
+		if err != nil {
+			return nil, fmt.Errorf("error getting file %s: %w", entry.Path, err)
+		}
+
+		files = append(files, &gitprovider.CommitFile{
+			Path:    gitprovider.StringVar(entry.Path),
+			Content: gitprovider.StringVar(string(content)),
+		})
+	}
+
+	return files, nil
+}
+
+// MergePullRequest merges a pull request given the repository's URL and the PR's number with a commit message.
+func (p giteaGitProvider) MergePullRequest(ctx context.Context, repoUrl RepoURL, pullRequestNumber int, commitMesage string) error {
+	body := map[string]string{
+		"Do":                "merge",
+		"MergeMessageField": commitMesage,
+	}
+
+	return p.client.do(ctx, http.MethodPost, fmt.Sprintf("%s/pulls/%d/merge", p.repoPath(repoUrl), pullRequestNumber), nil, body, nil)
+}
@@ -0,0 +1,273 @@
+package gitproviders
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gitea Provider", func() {
+	var (
+		ctx      context.Context
+		mux      *http.ServeMux
+		server   *httptest.Server
+		provider GitProvider
+		repoUrl  RepoURL
+	)
+
+	writeJSON := func(w http.ResponseWriter, status int, body interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+	}
+
+	readJSON := func(r *http.Request) map[string]interface{} {
+		body := map[string]interface{}{}
+		Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+
+		return body
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		provider = newGiteaGitProvider(server.URL, "the-token", server.Client())
+		repoUrl = RepoURL{owner: "acme", repoName: "podinfo"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("RepositoryExists", func() {
+		It("returns true when the repository exists", func() {
+			mux.HandleFunc("/api/v1/repos/acme/podinfo", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Authorization")).To(Equal("token the-token"))
+				writeJSON(w, http.StatusOK, map[string]interface{}{"default_branch": "main"})
+			})
+
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("returns false when the repository is not found", func() {
+			exists, err := provider.RepositoryExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	It("reads the default branch and visibility of the repository", func() {
+		mux.HandleFunc("/api/v1/repos/acme/podinfo", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"default_branch": "develop", "private": true})
+		})
+
+		branch, err := provider.GetDefaultBranch(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(branch).To(Equal("develop"))
+
+		visibility, err := provider.GetRepoVisibility(ctx, repoUrl)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*visibility).To(Equal(gitprovider.RepositoryVisibilityPrivate))
+	})
+
+	Describe("deploy keys", func() {
+		It("finds an existing deploy key", func() {
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/keys", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, []map[string]interface{}{{"id": 1, "title": DeployKeyName}})
+			})
+
+			exists, err := provider.DeployKeyExists(ctx, repoUrl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+		})
+
+		It("uploads a writable deploy key", func() {
+			var uploaded map[string]interface{}
+
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/keys", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodPost))
+				uploaded = readJSON(r)
+				writeJSON(w, http.StatusCreated, uploaded)
+			})
+
+			Expect(provider.UploadDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA"))).To(Succeed())
+			Expect(uploaded).To(HaveKeyWithValue("title", DeployKeyName))
+			Expect(uploaded).To(HaveKeyWithValue("key", "ssh-ed25519 AAAA"))
+			Expect(uploaded).To(HaveKeyWithValue("read_only", false))
+		})
+
+		It("reports a missing repository when uploading", func() {
+			err := provider.UploadDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA"))
+			Expect(err).To(MatchError(ErrRepositoryNoPermissionsOrDoesNotExist))
+		})
+
+		It("deletes the deploy key with the given key", func() {
+			deleted := []string{}
+
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/keys", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, []map[string]interface{}{
+					{"id": 1, "title": DeployKeyName, "key": "ssh-ed25519 AAAA old"},
+					{"id": 2, "title": DeployKeyName, "key": "ssh-ed25519 BBBB new"},
+					{"id": 3, "title": "other", "key": "ssh-ed25519 AAAA"},
+				})
+			})
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/keys/", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Method).To(Equal(http.MethodDelete))
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			Expect(provider.DeleteDeployKey(ctx, repoUrl, []byte("ssh-ed25519 AAAA\n"))).To(Succeed())
+			Expect(deleted).To(Equal([]string{"/api/v1/repos/acme/podinfo/keys/1"}))
+		})
+	})
+
+	It("creates a pull request with the given files", func() {
+		var (
+			branch  map[string]interface{}
+			created map[string]interface{}
+			updated map[string]interface{}
+			pr      map[string]interface{}
+		)
+
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/branches", func(w http.ResponseWriter, r *http.Request) {
+			branch = readJSON(r)
+			writeJSON(w, http.StatusCreated, branch)
+		})
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/contents/apps/new.yaml", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			Expect(r.Method).To(Equal(http.MethodPost))
+			created = readJSON(r)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{})
+		})
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/contents/apps/old.yaml", func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				Expect(r.URL.Query().Get("ref")).To(Equal("new-branch"))
+				writeJSON(w, http.StatusOK, map[string]interface{}{"type": "file", "sha": "abc"})
+
+				return
+			}
+
+			Expect(r.Method).To(Equal(http.MethodPut))
+			updated = readJSON(r)
+			writeJSON(w, http.StatusOK, map[string]interface{}{})
+		})
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/pulls", func(w http.ResponseWriter, r *http.Request) {
+			pr = readJSON(r)
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"number": 7, "html_url": "https://gitea.example.com/acme/podinfo/pulls/7"})
+		})
+
+		res, err := provider.CreatePullRequest(ctx, repoUrl, PullRequestInfo{
+			Title:         "Add app",
+			Description:   "Adds an app",
+			CommitMessage: "add app",
+			TargetBranch:  "main",
+			NewBranch:     "new-branch",
+			Files: []gitprovider.CommitFile{
+				{Path: gitprovider.StringVar("apps/new.yaml"), Content: gitprovider.StringVar("new")},
+				{Path: gitprovider.StringVar("apps/old.yaml"), Content: gitprovider.StringVar("old")},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Get().Number).To(Equal(7))
+		Expect(res.Get().WebURL).To(Equal("https://gitea.example.com/acme/podinfo/pulls/7"))
+
+		Expect(branch).To(Equal(map[string]interface{}{"new_branch_name": "new-branch", "old_branch_name": "main"}))
+		Expect(created).To(HaveKeyWithValue("content", base64.StdEncoding.EncodeToString([]byte("new"))))
+		Expect(created).ToNot(HaveKey("sha"))
+		Expect(updated).To(HaveKeyWithValue("sha", "abc"))
+		Expect(updated).To(HaveKeyWithValue("branch", "new-branch"))
+		Expect(pr).To(Equal(map[string]interface{}{"title": "Add app", "body": "Adds an app", "head": "new-branch", "base": "main"}))
+	})
+
+	Describe("GetCommits", func() {
+		It("returns the commits of a branch", func() {
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/commits", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("sha")).To(Equal("main"))
+				Expect(r.URL.Query().Get("limit")).To(Equal("10"))
+				Expect(r.URL.Query().Get("page")).To(Equal("1"))
+
+				commit := map[string]interface{}{
+					"sha":      "abc",
+					"html_url": "https://gitea.example.com/acme/podinfo/commit/abc",
+					"commit": map[string]interface{}{
+						"message": "first",
+						"author":  map[string]interface{}{"name": "Jane", "date": "2022-01-02T03:04:05Z"},
+						"verification": map[string]interface{}{
+							"signature": "-----BEGIN SSH SIGNATURE-----",
+							"payload":   "tree abc",
+						},
+					},
+				}
+				writeJSON(w, http.StatusOK, []interface{}{commit})
+			})
+
+			commits, err := provider.GetCommits(ctx, repoUrl, "main", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(HaveLen(1))
+			Expect(commits[0].Get().Sha).To(Equal("abc"))
+			Expect(commits[0].Get().Author).To(Equal("Jane"))
+			Expect(commits[0].Get().Message).To(Equal("first"))
+			Expect(commits[0].Get().CreatedAt.Year()).To(Equal(2022))
+
+			payload, signature := CommitSignature(commits[0])
+			Expect(string(payload)).To(Equal("tree abc"))
+			Expect(signature).To(Equal("-----BEGIN SSH SIGNATURE-----"))
+		})
+
+		It("returns no commits for an empty repository", func() {
+			mux.HandleFunc("/api/v1/repos/acme/podinfo/commits", func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusConflict, map[string]interface{}{"message": "Git Repository is empty."})
+			})
+
+			commits, err := provider.GetCommits(ctx, repoUrl, "main", 10, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commits).To(BeEmpty())
+		})
+	})
+
+	It("reads the files of a directory", func() {
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/contents/apps", func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, []map[string]interface{}{
+				{"type": "file", "path": "apps/a.yaml"},
+				{"type": "dir", "path": "apps/nested"},
+			})
+		})
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/raw/apps/a.yaml", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("ref")).To(Equal("main"))
+			fmt.Fprint(w, "kind: ConfigMap")
+		})
+
+		files, err := provider.GetRepoDirFiles(ctx, repoUrl, "apps", "main")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(*files[0].Path).To(Equal("apps/a.yaml"))
+		Expect(*files[0].Content).To(Equal("kind: ConfigMap"))
+	})
+
+	It("merges a pull request", func() {
+		var merge map[string]interface{}
+
+		mux.HandleFunc("/api/v1/repos/acme/podinfo/pulls/7/merge", func(w http.ResponseWriter, r *http.Request) {
+			merge = readJSON(r)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		Expect(provider.MergePullRequest(ctx, repoUrl, 7, "merge it")).To(Succeed())
+		Expect(merge).To(Equal(map[string]interface{}{"Do": "merge", "MergeMessageField": "merge it"}))
+	})
+})
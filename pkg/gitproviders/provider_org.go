@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-github/v41/github"
 )
 
 type orgGitProvider struct {
@@ -55,6 +56,15 @@ func (p orgGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, de
 	return uploadDeployKey(ctx, orgRepo, deployKeyInfo)
 }
 
+func (p orgGitProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	orgRepo, err := p.getOrgRepo(ctx, repoUrl)
+	if err != nil {
+		return fmt.Errorf("error getting org repo reference for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+	}
+
+	return deleteDeployKey(ctx, orgRepo, deployKey)
+}
+
 func (p orgGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
 	repoInfoRef, err := p.getRepoInfoFromUrl(ctx, repoUrl)
 	if err != nil {
@@ -119,6 +129,10 @@ func (p orgGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, targetB
 		return nil, fmt.Errorf("error getting repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
 	}
 
+	if client, ok := p.provider.Raw().(*github.Client); ok {
+		return getGitHubCommits(ctx, client, repoUrl, targetBranch, pageSize, pageToken)
+	}
+
 	return getCommits(ctx, orgRepo, targetBranch, pageSize, pageToken)
 }
 
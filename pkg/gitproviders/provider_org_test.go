@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-github/v41/github"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/weave-gitops/pkg/vendorfakes/fakegitprovider"
@@ -308,6 +312,34 @@ var _ = Describe("Org Provider", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(commits[0].Get().Sha).To(Equal("commit-sha"))
 		})
+
+		It("returns the signatures of GitHub commits", func() {
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mux.HandleFunc("/repos/"+repoUrl.Owner()+"/"+repoUrl.RepositoryName()+"/commits", func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Query().Get("sha")).To(Equal("target-branch"))
+				fmt.Fprint(w, `[{"sha": "commit-sha", "html_url": "https://github.com/commit-sha", "commit": {
+					"message": "signed", "author": {"name": "Jane"},
+					"verification": {"verified": true, "signature": "-----BEGIN PGP SIGNATURE-----", "payload": "tree abc"}}}]`)
+			})
+
+			client := github.NewClient(nil)
+			client.BaseURL, _ = url.Parse(server.URL + "/")
+			gitProviderClient.RawReturns(client)
+
+			commits, err := orgProvider.GetCommits(context.Background(), repoUrl, "target-branch", 1, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(commitClient.ListPageCallCount()).To(Equal(0))
+			Expect(commits).To(HaveLen(1))
+			Expect(commits[0].Get().Sha).To(Equal("commit-sha"))
+			Expect(commits[0].Get().Message).To(Equal("signed"))
+
+			payload, signature := CommitSignature(commits[0])
+			Expect(string(payload)).To(Equal("tree abc"))
+			Expect(signature).To(Equal("-----BEGIN PGP SIGNATURE-----"))
+		})
 	})
 
 	Describe("GetProviderDomain", func() {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/google/go-github/v41/github"
 )
 
 type userGitProvider struct {
@@ -55,6 +56,15 @@ func (p userGitProvider) UploadDeployKey(ctx context.Context, repoUrl RepoURL, d
 	return uploadDeployKey(ctx, userRepo, deployKeyInfo)
 }
 
+func (p userGitProvider) DeleteDeployKey(ctx context.Context, repoUrl RepoURL, deployKey []byte) error {
+	userRepo, err := p.getUserRepo(ctx, repoUrl)
+	if err != nil {
+		return fmt.Errorf("error getting user repo reference for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
+	}
+
+	return deleteDeployKey(ctx, userRepo, deployKey)
+}
+
 func (p userGitProvider) GetDefaultBranch(ctx context.Context, repoUrl RepoURL) (string, error) {
 	repoInfoRef, err := p.getRepoInfoFromUrl(ctx, repoUrl)
 	if err != nil {
@@ -108,6 +118,10 @@ func (p userGitProvider) GetCommits(ctx context.Context, repoUrl RepoURL, target
 		return nil, fmt.Errorf("error getting repo for owner %s, repo %s, %w", repoUrl.Owner(), repoUrl.RepositoryName(), err)
 	}
 
+	if client, ok := p.provider.Raw().(*github.Client); ok {
+		return getGitHubCommits(ctx, client, repoUrl, targetBranch, pageSize, pageToken)
+	}
+
 	return getCommits(ctx, userRepo, targetBranch, pageSize, pageToken)
 }
 
@@ -16,6 +16,34 @@ type RepositoryURLProtocol string
 const RepositoryURLProtocolHTTPS RepositoryURLProtocol = "https"
 const RepositoryURLProtocolSSH RepositoryURLProtocol = "ssh"
 
+// GitAuth is how git operations and flux authenticate to a repository.
+type GitAuth string
+
+const (
+	// GitAuthDeployKey authenticates over SSH with a deploy key uploaded to the git provider.
+	GitAuthDeployKey GitAuth = "deploy-key"
+	// GitAuthToken authenticates over HTTPS with the token of the git provider.
+	GitAuthToken GitAuth = "token"
+	// GitAuthGitHubApp authenticates over HTTPS with short-lived GitHub App installation tokens.
+	GitAuthGitHubApp GitAuth = "github-app"
+)
+
+// ParseGitAuth returns the GitAuth named s. An empty string is valid and means that the authentication
+// is not specified.
+func ParseGitAuth(s string) (GitAuth, error) {
+	switch auth := GitAuth(s); auth {
+	case "", GitAuthDeployKey, GitAuthToken, GitAuthGitHubApp:
+		return auth, nil
+	}
+
+	return "", fmt.Errorf("unsupported git authentication %q, must be one of %s, %s or %s", s, GitAuthDeployKey, GitAuthToken, GitAuthGitHubApp)
+}
+
+// IsToken returns true when the authentication uses a token over HTTPS.
+func (a GitAuth) IsToken() bool {
+	return a == GitAuthToken || a == GitAuthGitHubApp
+}
+
 type RepoURL struct {
 	repoName   string
 	owner      string
@@ -23,6 +51,7 @@ type RepoURL struct {
 	normalized string
 	provider   GitProviderName
 	protocol   RepositoryURLProtocol
+	auth       GitAuth
 }
 
 func NewRepoURL(uri string) (RepoURL, error) {
@@ -85,10 +114,55 @@ func (n RepoURL) Protocol() RepositoryURLProtocol {
 	return n.protocol
 }
 
+// GitAuth returns how the repository is authenticated to, or an empty string when it is not specified.
+func (n RepoURL) GitAuth() GitAuth {
+	return n.auth
+}
+
+// WithGitAuth returns a copy of the URL authenticated to with auth. The repositories authenticated to with
+// a token are accessed over HTTPS.
+func (n RepoURL) WithGitAuth(auth GitAuth) RepoURL {
+	n.auth = auth
+
+	n.protocol = RepositoryURLProtocolSSH
+	if auth.IsToken() {
+		n.protocol = RepositoryURLProtocolHTTPS
+	}
+
+	return n
+}
+
+// CloneURL returns the URL git and flux access the repository with, for its protocol.
+func (n RepoURL) CloneURL() string {
+	if n.protocol != RepositoryURLProtocolHTTPS {
+		return n.normalized
+	}
+
+	path := n.url.Path
+	// Bitbucket Server serves https clones under /scm/<project>/<repo>
+	if n.provider == GitProviderBitbucketServer && !strings.HasPrefix(path, "/scm/") {
+		path = "/scm" + path
+	}
+
+	// The port of an SSH URL is not the port of the HTTPS server
+	return fmt.Sprintf("https://%s%s", n.url.Hostname(), path)
+}
+
 func getOwnerFromUrl(url url.URL, providerName GitProviderName) (string, error) {
 	url.Path = strings.TrimPrefix(url.Path, "/")
 
+	// Bitbucket Server serves https clones under /scm/<project>/<repo>
+	if providerName == GitProviderBitbucketServer {
+		url.Path = strings.TrimPrefix(url.Path, "scm/")
+	}
+
 	parts := strings.Split(url.Path, "/")
+
+	// Repositories served by git alone are not necessarily grouped by owner
+	if providerName == GitProviderGit {
+		return strings.Join(parts[:len(parts)-1], "/"), nil
+	}
+
 	if len(parts) < 2 {
 		return "", fmt.Errorf("could not get owner from url %v", url.String())
 	}
@@ -118,12 +192,21 @@ func detectGitProviderFromUrl(raw string, gitHostTypes map[string]string) (GitPr
 	gitHostTypes[github.DefaultDomain] = string(GitProviderGitHub)
 	gitHostTypes[gitlab.DefaultDomain] = string(GitProviderGitLab)
 
-	provider := gitHostTypes[u.Host]
-	if provider == "" {
-		return "", fmt.Errorf("no git providers found for %q", raw)
+	// Local repositories can only be accessed with git
+	if u.Scheme == "file" {
+		return GitProviderGit, nil
 	}
 
-	return GitProviderName(provider), nil
+	provider := GitProviderName(gitHostTypes[u.Host])
+
+	switch provider {
+	case "":
+		return "", fmt.Errorf("no git providers found for %q", raw)
+	case GitProviderGitHub, GitProviderGitLab, GitProviderBitbucketServer, GitProviderGitea, GitProviderGit:
+		return provider, nil
+	default:
+		return "", fmt.Errorf("unsupported git provider %q for host %q", provider, u.Host)
+	}
 }
 
 // Hacks around "scp" formatted urls ($user@$host:$path)
@@ -146,6 +229,11 @@ func normalizeRepoURLString(url string) (string, error) {
 	// A trailing slash causes problems when naming secrets.
 	url = strings.TrimSuffix(url, "/")
 
+	// Local repositories are often not named *.git
+	if strings.HasPrefix(url, "file://") {
+		return url, nil
+	}
+
 	if !strings.HasSuffix(url, ".git") {
 		url = url + ".git"
 	}
@@ -18,6 +18,25 @@ var _ = DescribeTable("detectGitProviderFromUrl", func(input string, expected Gi
 	Entry("ssh+gitlab", "ssh://git@gitlab.com/weaveworks/weave-gitops.git", GitProviderGitLab),
 )
 
+var _ = Describe("detectGitProviderFromUrl with custom hosts", func() {
+	It("detects self-hosted providers", func() {
+		hosts := map[string]string{"bitbucket.acme.org:7999": "bitbucket-server", "gitea.acme.org": "gitea"}
+
+		result, err := detectGitProviderFromUrl("ssh://git@bitbucket.acme.org:7999/acme/podinfo.git", hosts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(GitProviderBitbucketServer))
+
+		result, err = detectGitProviderFromUrl("git@gitea.acme.org:acme/podinfo.git", hosts)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(GitProviderGitea))
+	})
+
+	It("rejects unknown provider types", func() {
+		_, err := detectGitProviderFromUrl("git@git.acme.org:acme/podinfo.git", map[string]string{"git.acme.org": "svn"})
+		Expect(err).To(MatchError(ContainSubstring("unsupported git provider \"svn\"")))
+	})
+})
+
 var _ = Describe("get owner from url", func() {
 	DescribeTable("getOwnerFromUrl", func(normalizedUrl string, providerName GitProviderName, expected string) {
 		u, err := url.Parse(normalizedUrl)
@@ -29,6 +48,9 @@ var _ = Describe("get owner from url", func() {
 		Entry("github", "ssh://git@github.com/weaveworks/weave-gitops.git", GitProviderGitHub, "weaveworks"),
 		Entry("gitlab", "ssh://git@gitlab.com/weaveworks/weave-gitops.git", GitProviderGitLab, "weaveworks"),
 		Entry("gitlab with subgroup", "ssh://git@gitlab.com/weaveworks/sub_group/weave-gitops.git", GitProviderGitLab, "weaveworks/sub_group"),
+		Entry("bitbucket server", "ssh://git@bitbucket.acme.org:7999/acme/weave-gitops.git", GitProviderBitbucketServer, "acme"),
+		Entry("bitbucket server https clone", "ssh://git@bitbucket.acme.org/scm/acme/weave-gitops.git", GitProviderBitbucketServer, "acme"),
+		Entry("gitea", "ssh://git@gitea.acme.org/weaveworks/weave-gitops.git", GitProviderGitea, "weaveworks"),
 	)
 
 	It("missing owner", func() {
@@ -135,4 +157,54 @@ var _ = DescribeTable("NewRepoURL", func(input, gitProviderEnv string, expected
 			provider: "gitlab",
 			protocol: RepositoryURLProtocolSSH,
 		}),
+	Entry(
+		"git server",
+		"git@git.acme.org:config.git",
+		"git.acme.org=git",
+		expectedRepoURL{
+			s:        "ssh://git@git.acme.org/config.git",
+			owner:    "",
+			name:     "config",
+			provider: GitProviderGit,
+			protocol: RepositoryURLProtocolSSH,
+		}),
+	Entry("local repository", "file:///srv/git/config", "", expectedRepoURL{
+		s:        "file:///srv/git/config",
+		owner:    "srv/git",
+		name:     "config",
+		provider: GitProviderGit,
+		protocol: RepositoryURLProtocolSSH,
+	}),
 )
+
+var _ = DescribeTable("CloneURL", func(input string, auth GitAuth, expected string) {
+	viper.Set("git-host-types", map[string]string{"bitbucket.acme.org:7999": "bitbucket-server"})
+	defer viper.Set("git-host-types", map[string]string{})
+
+	repoUrl, err := NewRepoURL(input)
+	Expect(err).NotTo(HaveOccurred())
+
+	repoUrl = repoUrl.WithGitAuth(auth)
+	Expect(repoUrl.GitAuth()).To(Equal(auth))
+	Expect(repoUrl.CloneURL()).To(Equal(expected))
+},
+	Entry("deploy key", "https://github.com/someuser/podinfo", GitAuthDeployKey, "ssh://git@github.com/someuser/podinfo.git"),
+	Entry("token", "git@github.com:someuser/podinfo.git", GitAuthToken, "https://github.com/someuser/podinfo.git"),
+	Entry("github app", "ssh://git@github.com/someuser/podinfo.git", GitAuthGitHubApp, "https://github.com/someuser/podinfo.git"),
+	Entry("bitbucket server token", "ssh://git@bitbucket.acme.org:7999/acme/podinfo.git", GitAuthToken, "https://bitbucket.acme.org/scm/acme/podinfo.git"),
+)
+
+var _ = Describe("ParseGitAuth", func() {
+	It("parses the authentication modes", func() {
+		for _, s := range []string{"", "deploy-key", "token", "github-app"} {
+			auth, err := ParseGitAuth(s)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(auth).To(Equal(GitAuth(s)))
+		}
+	})
+
+	It("fails with an unsupported mode", func() {
+		_, err := ParseGitAuth("password")
+		Expect(err).To(MatchError(`unsupported git authentication "password", must be one of deploy-key, token or github-app`))
+	})
+})
@@ -0,0 +1,187 @@
+package gitproviders
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+)
+
+// restClient is a minimal JSON client for the git providers that are not covered by go-git-providers.
+type restClient struct {
+	baseURL       string
+	authorization string
+	client        *http.Client
+}
+
+func newRestClient(baseURL, authorization string, client *http.Client) restClient {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+
+	return restClient{
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		authorization: authorization,
+		client:        client,
+	}
+}
+
+// do sends a request with an optional JSON body and decodes a JSON response into out if out is not nil.
+// A 404 response is reported as gitprovider.ErrNotFound.
+func (c restClient) do(ctx context.Context, method, path string, query url.Values, in, out interface{}) error {
+	var body io.Reader
+
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("could not encode request body: %w", err)
+		}
+
+		body = bytes.NewReader(b)
+	}
+
+	res, err := c.send(ctx, method, path, query, body, "application/json")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("could not decode response from %s %s: %w", method, path, err)
+	}
+
+	return nil
+}
+
+// raw fetches the body of a response without decoding it.
+func (c restClient) raw(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	res, err := c.send(ctx, http.MethodGet, path, query, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return ioutil.ReadAll(res.Body)
+}
+
+func (c restClient) send(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string) (*http.Response, error) {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u = u + "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", c.authorization)
+
+	if body != nil && contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s %s failed: %w", method, path, err)
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	msg, _ := ioutil.ReadAll(res.Body)
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s %s: %w", method, path, gitprovider.ErrNotFound)
+	}
+
+	return nil, &apiError{Method: method, Path: path, StatusCode: res.StatusCode, Body: strings.TrimSpace(string(msg))}
+}
+
+// apiError is returned by restClient for unsuccessful responses other than 404.
+type apiError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s %s returned %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+func isStatus(err error, statusCode int) bool {
+	var apiErr *apiError
+
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// restCommit implements gitprovider.Commit for the REST based providers.
+type restCommit struct {
+	info   gitprovider.CommitInfo
+	object interface{}
+}
+
+func (c restCommit) APIObject() interface{} {
+	return c.object
+}
+
+func (c restCommit) Get() gitprovider.CommitInfo {
+	return c.info
+}
+
+// restPullRequest implements gitprovider.PullRequest for the REST based providers.
+type restPullRequest struct {
+	info   gitprovider.PullRequestInfo
+	object interface{}
+}
+
+func (p restPullRequest) APIObject() interface{} {
+	return p.object
+}
+
+func (p restPullRequest) Get() gitprovider.PullRequestInfo {
+	return p.info
+}
+
+// apiBaseURL returns the https base address for a provider API from a configured hostname,
+// dropping any ssh port that came from the repository URL.
+func apiBaseURL(hostname string) string {
+	if strings.HasPrefix(hostname, "https://") || strings.HasPrefix(hostname, "http://") {
+		return hostname
+	}
+
+	return "https://" + strings.SplitN(hostname, ":", 2)[0]
+}
+
+func millisToTime(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
+
+// escapePath escapes every segment of a repository path while keeping the separators.
+func escapePath(p string) string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
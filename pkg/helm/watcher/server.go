@@ -3,6 +3,8 @@ package watcher
 import (
 	"io/ioutil"
 
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	"github.com/fluxcd/pkg/runtime/events"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -13,6 +15,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/controllers"
 	"github.com/weaveworks/weave-gitops/pkg/helm"
 	//+kubebuilder:scaffold:imports
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/cache"
@@ -58,6 +62,18 @@ func NewWatcher(opts Options) (*Watcher, error) {
 		return nil, err
 	}
 
+	if err := kustomizev2.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	if err := helmv2.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	if err := wego.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+
 	return &Watcher{
 		cache:               opts.Cache,
 		repoManager:         helm.NewRepoManager(opts.KubeClient, tempDir),
@@ -116,6 +132,13 @@ func (w *Watcher) StartWatcher() error {
 		return err
 	}
 
+	if err = (&controllers.ApplicationReconciler{
+		Client: mgr.GetClient(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ApplicationReconciler")
+		return err
+	}
+
 	setupLog.Info("starting manager")
 
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -0,0 +1,41 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// ClientsetGetter implementations should create a Kubernetes clientset from a context.
+// The clientset is needed for the subresources the controller-runtime client does not
+// support, like the logs of pods.
+type ClientsetGetter interface {
+	Clientset(ctx context.Context) (kubernetes.Interface, error)
+}
+
+var _ ClientsetGetter = &DefaultClientsetGetter{}
+
+// DefaultClientsetGetter implements the ClientsetGetter interface and uses a ConfigGetter
+// to get a *rest.Config and create a Kubernetes clientset.
+type DefaultClientsetGetter struct {
+	configGetter ConfigGetter
+}
+
+// NewDefaultClientsetGetter creates a new DefaultClientsetGetter
+func NewDefaultClientsetGetter(configGetter ConfigGetter) ClientsetGetter {
+	return &DefaultClientsetGetter{
+		configGetter: configGetter,
+	}
+}
+
+// Clientset creates a new Kubernetes clientset using the *rest.Config returned from its
+// ConfigGetter.
+func (g *DefaultClientsetGetter) Clientset(ctx context.Context) (kubernetes.Interface, error) {
+	clientset, err := kubernetes.NewForConfig(g.configGetter.Config(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("could not create kube clientset: %w", err)
+	}
+
+	return clientset, nil
+}
@@ -0,0 +1,340 @@
+package kube
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InformersGetter implementations should create informers from a context.
+type InformersGetter interface {
+	Informers(ctx context.Context, namespace string) (cache.Informers, error)
+}
+
+var _ InformersGetter = &DefaultInformersGetter{}
+
+// DefaultInformersGetter implements the InformersGetter interface and uses a ConfigGetter
+// to get a *rest.Config and create informers. Informers are shared by all the callers that
+// end up with the same cluster, impersonated user and namespace, so a single watch per
+// object kind is opened no matter how many clients are streaming. Shared informers are
+// stopped once the contexts of all their callers are done, and the event handlers added by a
+// caller are removed when the context passed to GetInformer is done.
+type DefaultInformersGetter struct {
+	configGetter  ConfigGetter
+	schemeBuilder runtime.SchemeBuilder
+
+	mu     sync.Mutex
+	shared map[string]*sharedInformers
+}
+
+// NewDefaultInformersGetter creates a new DefaultInformersGetter
+func NewDefaultInformersGetter(configGetter ConfigGetter, schemeBuilder ...func(*runtime.Scheme) error) InformersGetter {
+	return &DefaultInformersGetter{
+		configGetter:  configGetter,
+		schemeBuilder: schemeBuilder,
+		shared:        map[string]*sharedInformers{},
+	}
+}
+
+// Informers returns an informer cache limited to namespace, or cluster wide when namespace
+// is empty, using the *rest.Config returned from its ConfigGetter. The cache is only released
+// when ctx is done.
+func (g *DefaultInformersGetter) Informers(ctx context.Context, namespace string) (cache.Informers, error) {
+	cfg := g.configGetter.Config(ctx)
+	key := informersKey(cfg, namespace)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	shared, ok := g.shared[key]
+	if !ok {
+		var err error
+
+		shared, err = g.newSharedInformers(key, cfg, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		g.shared[key] = shared
+	}
+
+	shared.refs++
+
+	go func() {
+		<-ctx.Done()
+		g.release(key, shared)
+	}()
+
+	return shared, nil
+}
+
+func (g *DefaultInformersGetter) newSharedInformers(key string, cfg *rest.Config, namespace string) (*sharedInformers, error) {
+	scheme := CreateScheme()
+
+	if err := g.schemeBuilder.AddToScheme(scheme); err != nil {
+		return nil, fmt.Errorf("could not build scheme: %w", err)
+	}
+
+	informers, err := cache.New(cfg, cache.Options{
+		Scheme:    scheme,
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create informers: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	shared := &sharedInformers{
+		Informers: informers,
+		ctx:       ctx,
+		stop:      cancel,
+		done:      make(chan struct{}),
+		handlers:  map[cache.Informer]*handlerSet{},
+	}
+
+	// Informers that failed to start are dropped so that the next caller starts new ones.
+	shared.failed = func() { g.evict(key, shared) }
+
+	return shared, nil
+}
+
+func (g *DefaultInformersGetter) release(key string, shared *sharedInformers) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	shared.refs--
+	if shared.refs > 0 {
+		return
+	}
+
+	shared.stop()
+
+	if g.shared[key] == shared {
+		delete(g.shared, key)
+	}
+}
+
+func (g *DefaultInformersGetter) evict(key string, shared *sharedInformers) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	shared.stop()
+
+	if g.shared[key] == shared {
+		delete(g.shared, key)
+	}
+}
+
+// informersKey identifies the informers that can be shared by the callers using cfg.
+func informersKey(cfg *rest.Config, namespace string) string {
+	groups := append([]string{}, cfg.Impersonate.Groups...)
+	sort.Strings(groups)
+
+	return strings.Join([]string{cfg.Host, cfg.Impersonate.UserName, strings.Join(groups, ","), namespace}, "\x00")
+}
+
+// sharedInformers is an informer cache used by several callers. It is started by the first
+// caller and keeps running until all the callers are gone, whatever context Start is called with.
+type sharedInformers struct {
+	cache.Informers
+
+	ctx    context.Context
+	stop   context.CancelFunc
+	failed func()
+	start  sync.Once
+	done   chan struct{}
+	err    error
+	// refs is guarded by the mutex of the DefaultInformersGetter
+	refs int
+
+	mu       sync.Mutex
+	handlers map[cache.Informer]*handlerSet
+}
+
+// GetInformer returns the shared informer for obj. Event handlers added to it are removed once
+// ctx is done.
+func (s *sharedInformers) GetInformer(ctx context.Context, obj client.Object) (cache.Informer, error) {
+	informer, err := s.Informers.GetInformer(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.callerInformer(ctx, informer), nil
+}
+
+// GetInformerForKind returns the shared informer for gvk. Event handlers added to it are removed
+// once ctx is done.
+func (s *sharedInformers) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind) (cache.Informer, error) {
+	informer, err := s.Informers.GetInformerForKind(ctx, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.callerInformer(ctx, informer), nil
+}
+
+func (s *sharedInformers) callerInformer(ctx context.Context, informer cache.Informer) cache.Informer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, ok := s.handlers[informer]
+	if !ok {
+		set = &handlerSet{informer: informer, handlers: map[*queuedHandler]struct{}{}}
+		informer.AddEventHandler(set)
+		s.handlers[informer] = set
+	}
+
+	return &callerInformer{Informer: informer, ctx: ctx, handlers: set}
+}
+
+// Start starts the shared informers if they are not running yet and blocks until ctx is done
+// or the informers stop.
+func (s *sharedInformers) Start(ctx context.Context) error {
+	s.start.Do(func() {
+		go func() {
+			s.err = s.Informers.Start(s.ctx)
+			if s.err != nil {
+				s.failed()
+			}
+
+			close(s.done)
+		}()
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-s.done:
+		return s.err
+	}
+}
+
+// callerInformer is a shared informer as seen by one caller.
+type callerInformer struct {
+	cache.Informer
+
+	ctx      context.Context
+	handlers *handlerSet
+}
+
+func (i *callerInformer) AddEventHandler(handler toolscache.ResourceEventHandler) {
+	i.handlers.add(i.ctx, handler)
+}
+
+// AddEventHandlerWithResyncPeriod adds handler like AddEventHandler. Handlers share the resync
+// period of the informer.
+func (i *callerInformer) AddEventHandlerWithResyncPeriod(handler toolscache.ResourceEventHandler, _ time.Duration) {
+	i.handlers.add(i.ctx, handler)
+}
+
+// handlerSet is the only event handler registered on a shared informer. It hands the events out
+// to the handlers of the callers, which can be removed unlike handlers registered on the informer.
+type handlerSet struct {
+	informer cache.Informer
+
+	mu       sync.Mutex
+	handlers map[*queuedHandler]struct{}
+}
+
+// add registers handler until ctx is done. Like an informer does for a new handler, the objects
+// already in the store of the informer are delivered as added first.
+func (s *handlerSet) add(ctx context.Context, handler toolscache.ResourceEventHandler) {
+	h := &queuedHandler{handler: handler, ready: make(chan struct{}, 1)}
+
+	s.mu.Lock()
+
+	if informer, ok := s.informer.(toolscache.SharedIndexInformer); ok && informer.GetStore() != nil {
+		for _, obj := range informer.GetStore().List() {
+			obj := obj
+			h.push(func(handler toolscache.ResourceEventHandler) { handler.OnAdd(obj) })
+		}
+	}
+
+	s.handlers[h] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		h.run(ctx)
+
+		s.mu.Lock()
+		delete(s.handlers, h)
+		s.mu.Unlock()
+	}()
+}
+
+func (s *handlerSet) push(event func(toolscache.ResourceEventHandler)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for h := range s.handlers {
+		h.push(event)
+	}
+}
+
+func (s *handlerSet) OnAdd(obj interface{}) {
+	s.push(func(handler toolscache.ResourceEventHandler) { handler.OnAdd(obj) })
+}
+
+func (s *handlerSet) OnUpdate(oldObj, newObj interface{}) {
+	s.push(func(handler toolscache.ResourceEventHandler) { handler.OnUpdate(oldObj, newObj) })
+}
+
+func (s *handlerSet) OnDelete(obj interface{}) {
+	s.push(func(handler toolscache.ResourceEventHandler) { handler.OnDelete(obj) })
+}
+
+// queuedHandler delivers events to a handler from its own goroutine, so that a slow handler
+// does not hold up the other handlers of the informer.
+type queuedHandler struct {
+	handler toolscache.ResourceEventHandler
+
+	mu     sync.Mutex
+	events []func(toolscache.ResourceEventHandler)
+	ready  chan struct{}
+}
+
+func (h *queuedHandler) push(event func(toolscache.ResourceEventHandler)) {
+	h.mu.Lock()
+	h.events = append(h.events, event)
+	h.mu.Unlock()
+
+	select {
+	case h.ready <- struct{}{}:
+	default:
+	}
+}
+
+// run delivers the queued events until ctx is done.
+func (h *queuedHandler) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.ready:
+		}
+
+		h.mu.Lock()
+		events := h.events
+		h.events = nil
+		h.mu.Unlock()
+
+		for _, event := range events {
+			if ctx.Err() != nil {
+				return
+			}
+
+			event(h.handler)
+		}
+	}
+}
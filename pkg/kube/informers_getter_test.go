@@ -0,0 +1,145 @@
+package kube_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+type testConfigGetter struct {
+	cfg *rest.Config
+}
+
+func (g testConfigGetter) Config(ctx context.Context) *rest.Config {
+	return g.cfg
+}
+
+var _ = Describe("DefaultInformersGetter", func() {
+	var getter kube.InformersGetter
+
+	appInformer := func(informers cache.Informers) cache.Informer {
+		informer, err := informers.GetInformer(context.Background(), &wego.Application{})
+		Expect(err).NotTo(HaveOccurred())
+
+		return informer
+	}
+
+	BeforeEach(func() {
+		getter = kube.NewDefaultInformersGetter(testConfigGetter{cfg: k8sTestEnv.Rest})
+	})
+
+	It("shares the informers of a namespace between callers", func() {
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		defer cancel1()
+
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+
+		informers1, err := getter.Informers(ctx1, "wego-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		informers2, err := getter.Informers(ctx2, "wego-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		other, err := getter.Informers(ctx2, "default")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(appInformer(informers2)).To(BeIdenticalTo(appInformer(informers1)))
+		Expect(appInformer(other)).NotTo(BeIdenticalTo(appInformer(informers1)))
+	})
+
+	It("releases the informers once all callers are done", func() {
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		ctx2, cancel2 := context.WithCancel(context.Background())
+
+		informers1, err := getter.Informers(ctx1, "wego-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = getter.Informers(ctx2, "wego-system")
+		Expect(err).NotTo(HaveOccurred())
+
+		informer := appInformer(informers1)
+
+		cancel1()
+
+		Consistently(func() cache.Informer {
+			informers, err := getter.Informers(ctx2, "wego-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			return appInformer(informers)
+		}, "200ms").Should(BeIdenticalTo(informer))
+
+		cancel2()
+
+		Eventually(func() cache.Informer {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			informers, err := getter.Informers(ctx, "wego-system")
+			Expect(err).NotTo(HaveOccurred())
+
+			return appInformer(informers)
+		}).ShouldNot(BeIdenticalTo(informer))
+	})
+
+	It("stops calling the event handlers of callers that are done", func() {
+		ctx1, cancel1 := context.WithCancel(context.Background())
+		defer cancel1()
+
+		ctx2, cancel2 := context.WithCancel(context.Background())
+		defer cancel2()
+
+		var added1, added2 int32
+
+		countAdded := func(ctx context.Context, counter *int32) {
+			informers, err := getter.Informers(ctx, "default")
+			Expect(err).NotTo(HaveOccurred())
+
+			informer, err := informers.GetInformer(ctx, &corev1.ConfigMap{})
+			Expect(err).NotTo(HaveOccurred())
+
+			informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+				AddFunc: func(interface{}) { atomic.AddInt32(counter, 1) },
+			})
+
+			go func() {
+				_ = informers.Start(ctx)
+			}()
+		}
+
+		countAdded(ctx1, &added1)
+		countAdded(ctx2, &added2)
+
+		createConfigMap := func() {
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("informers-%d", rand.Intn(100000)), Namespace: "default"},
+			}
+			Expect(k8sClient.Create(context.Background(), cm)).To(Succeed())
+		}
+
+		createConfigMap()
+		Eventually(func() int32 { return atomic.LoadInt32(&added1) }).Should(BeNumerically(">", 0))
+		Eventually(func() int32 { return atomic.LoadInt32(&added2) }).Should(BeNumerically(">", 0))
+
+		cancel1()
+
+		before := atomic.LoadInt32(&added2)
+		createConfigMap()
+		Eventually(func() int32 { return atomic.LoadInt32(&added2) }).Should(BeNumerically(">", before))
+
+		stopped := atomic.LoadInt32(&added1)
+		createConfigMap()
+		Consistently(func() int32 { return atomic.LoadInt32(&added1) }, "200ms").Should(Equal(stopped))
+	})
+})
@@ -0,0 +1,24 @@
+package kubefakes
+
+import (
+	"context"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+var _ kube.InformersGetter = &FakeInformersGetter{}
+
+type FakeInformersGetter struct {
+	informers cache.Informers
+}
+
+func NewFakeInformersGetter(informers cache.Informers) kube.InformersGetter {
+	return &FakeInformersGetter{
+		informers: informers,
+	}
+}
+
+func (g *FakeInformersGetter) Informers(ctx context.Context, namespace string) (cache.Informers, error) {
+	return g.informers, nil
+}
@@ -60,7 +60,10 @@ var (
 	GVRKustomization  schema.GroupVersionResource = kustomizev2.GroupVersion.WithResource("kustomizations")
 	GVRGitRepository  schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("gitrepositories")
 	GVRHelmRepository schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("helmrepositories")
-	GVRHelmRelease    schema.GroupVersionResource = helmv2.GroupVersion.WithResource("helmreleases")
+	GVRBucket         schema.GroupVersionResource = sourcev1.GroupVersion.WithResource("buckets")
+	// The OCIRepository API is newer than the source-controller API this module depends on
+	GVROCIRepository schema.GroupVersionResource = schema.GroupVersionResource{Group: sourcev1.GroupVersion.Group, Version: "v1beta2", Resource: "ocirepositories"}
+	GVRHelmRelease   schema.GroupVersionResource = helmv2.GroupVersion.WithResource("helmreleases")
 )
 
 const (
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"github.com/fluxcd/pkg/runtime/dependency"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 type AutomationType string
@@ -12,8 +14,10 @@ const (
 	AutomationTypeHelm      AutomationType = "helm"
 	AutomationTypeKustomize AutomationType = "kustomize"
 
-	SourceTypeGit  SourceType = "git"
-	SourceTypeHelm SourceType = "helm"
+	SourceTypeGit    SourceType = "git"
+	SourceTypeHelm   SourceType = "helm"
+	SourceTypeOCI    SourceType = "oci"
+	SourceTypeBucket SourceType = "bucket"
 )
 
 type Application struct {
@@ -27,6 +31,48 @@ type Application struct {
 	AutomationType      AutomationType
 	SourceType          SourceType
 	HelmTargetNamespace string
+	// ArtifactSourceURL is the URL of an OCI repository or bucket source
+	ArtifactSourceURL string
+	// SourceSecretRef is the name of the Secret with the credentials of an
+	// OCI repository or bucket source
+	SourceSecretRef string
+	// HelmValues and HelmValuesFrom are the values of the Helm release of the
+	// application. They are only used to generate the Helm release.
+	HelmValues     map[string]interface{}
+	HelmValuesFrom []HelmValuesReference
+	// DependsOn is the list of applications, in the same namespace, that
+	// must be ready before the application is deployed.
+	DependsOn []string
+}
+
+// IsArtifactSource returns true for applications whose manifests are fetched
+// from an OCI repository or a bucket rather than from a repository.
+func (a Application) IsArtifactSource() bool {
+	return a.SourceType == SourceTypeOCI || a.SourceType == SourceTypeBucket
+}
+
+// SourceURL returns the URL of the source of the application, whatever its type.
+func (a Application) SourceURL() string {
+	switch {
+	case a.SourceType == SourceTypeHelm:
+		return a.HelmSourceURL
+	case a.IsArtifactSource():
+		return a.ArtifactSourceURL
+	default:
+		return a.GitSourceURL.String()
+	}
+}
+
+// GetDependsOn returns the name of the application and the applications it
+// depends on, so that applications can be sorted with dependency.Sort.
+func (a Application) GetDependsOn() (types.NamespacedName, []dependency.CrossNamespaceDependencyReference) {
+	dependsOn := []dependency.CrossNamespaceDependencyReference{}
+
+	for _, name := range a.DependsOn {
+		dependsOn = append(dependsOn, dependency.CrossNamespaceDependencyReference{Name: name})
+	}
+
+	return types.NamespacedName{Name: a.Name, Namespace: a.Namespace}, dependsOn
 }
 
 func IsExternalConfigRepo(url string) bool {
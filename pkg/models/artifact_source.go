@@ -0,0 +1,70 @@
+package models
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+const (
+	ociScheme = "oci://"
+	// DefaultOCITag is the tag of an OCI artifact when its URL does not have one
+	DefaultOCITag = "latest"
+)
+
+// OCISource is an OCI artifact with the manifests of an application.
+type OCISource struct {
+	// Repository is the URL of the OCI repository, e.g. oci://ghcr.io/org/manifests
+	Repository string
+	Tag        string
+}
+
+// ParseOCIURL parses an oci://<registry>/<repository>[:<tag>] URL.
+func ParseOCIURL(ociURL string) (OCISource, error) {
+	if !strings.HasPrefix(ociURL, ociScheme) {
+		return OCISource{}, fmt.Errorf("OCI repository URL %q must start with %s", ociURL, ociScheme)
+	}
+
+	source := OCISource{Repository: ociURL, Tag: DefaultOCITag}
+
+	// A colon after the last slash separates the tag, others are the port of the registry
+	if i := strings.LastIndex(ociURL, ":"); i > strings.LastIndex(ociURL, "/") {
+		source.Repository, source.Tag = ociURL[:i], ociURL[i+1:]
+	}
+
+	if source.Tag == "" || !strings.Contains(strings.TrimPrefix(source.Repository, ociScheme), "/") {
+		return OCISource{}, fmt.Errorf("OCI repository URL %q must be in the form oci://<registry>/<repository>[:<tag>]", ociURL)
+	}
+
+	return source, nil
+}
+
+// Name returns the name of the repository, without the registry and the path.
+func (s OCISource) Name() string {
+	return path.Base(s.Repository)
+}
+
+// BucketSource is an S3 compatible bucket with the manifests of an application.
+type BucketSource struct {
+	Endpoint   string
+	BucketName string
+	Insecure   bool
+}
+
+// ParseBucketURL parses an http[s]://<endpoint>/<bucket> URL. Endpoints
+// served over http are insecure.
+func ParseBucketURL(bucketURL string) (BucketSource, error) {
+	u, err := url.Parse(bucketURL)
+	if err != nil {
+		return BucketSource{}, fmt.Errorf("invalid bucket URL %q: %w", bucketURL, err)
+	}
+
+	bucketName := strings.Trim(u.Path, "/")
+
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || bucketName == "" || strings.Contains(bucketName, "/") {
+		return BucketSource{}, fmt.Errorf("bucket URL %q must be in the form http[s]://<endpoint>/<bucket>", bucketURL)
+	}
+
+	return BucketSource{Endpoint: u.Host, BucketName: bucketName, Insecure: u.Scheme == "http"}, nil
+}
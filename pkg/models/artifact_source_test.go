@@ -0,0 +1,50 @@
+package models
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Artifact sources", func() {
+	DescribeTable("ParseOCIURL", func(url string, expected OCISource, valid bool) {
+		source, err := ParseOCIURL(url)
+		if !valid {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(Equal(expected))
+	},
+		Entry("with a tag", "oci://ghcr.io/org/podinfo:v6.0.0", OCISource{Repository: "oci://ghcr.io/org/podinfo", Tag: "v6.0.0"}, true),
+		Entry("without a tag", "oci://ghcr.io/org/podinfo", OCISource{Repository: "oci://ghcr.io/org/podinfo", Tag: DefaultOCITag}, true),
+		Entry("with a registry port", "oci://localhost:5000/podinfo", OCISource{Repository: "oci://localhost:5000/podinfo", Tag: DefaultOCITag}, true),
+		Entry("without the oci scheme", "https://ghcr.io/org/podinfo", OCISource{}, false),
+		Entry("without a repository", "oci://ghcr.io", OCISource{}, false),
+		Entry("with an empty tag", "oci://ghcr.io/org/podinfo:", OCISource{}, false),
+	)
+
+	It("names OCI sources after their repository", func() {
+		source, err := ParseOCIURL("oci://ghcr.io/org/manifests/podinfo:v6.0.0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source.Name()).To(Equal("podinfo"))
+	})
+
+	DescribeTable("ParseBucketURL", func(url string, expected BucketSource, valid bool) {
+		source, err := ParseBucketURL(url)
+		if !valid {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(Equal(expected))
+	},
+		Entry("over https", "https://s3.amazonaws.com/podinfo", BucketSource{Endpoint: "s3.amazonaws.com", BucketName: "podinfo"}, true),
+		Entry("over http", "http://minio:9000/podinfo/", BucketSource{Endpoint: "minio:9000", BucketName: "podinfo", Insecure: true}, true),
+		Entry("without a bucket", "https://s3.amazonaws.com", BucketSource{}, false),
+		Entry("with a path in the bucket", "https://s3.amazonaws.com/podinfo/deploy", BucketSource{}, false),
+		Entry("with another scheme", "s3://podinfo", BucketSource{}, false),
+	)
+})
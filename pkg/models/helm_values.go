@@ -0,0 +1,29 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HelmValuesReference is a ConfigMap or a Secret holding values of a Helm release.
+type HelmValuesReference struct {
+	Kind string
+	Name string
+}
+
+// ParseHelmValuesReference parses a configmap/<name> or secret/<name> reference.
+func ParseHelmValuesReference(ref string) (HelmValuesReference, error) {
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return HelmValuesReference{}, fmt.Errorf("invalid values reference %q: must be in the form configmap/<name> or secret/<name>", ref)
+	}
+
+	switch strings.ToLower(parts[0]) {
+	case "configmap":
+		return HelmValuesReference{Kind: "ConfigMap", Name: parts[1]}, nil
+	case "secret":
+		return HelmValuesReference{Kind: "Secret", Name: parts[1]}, nil
+	default:
+		return HelmValuesReference{}, fmt.Errorf("invalid values reference %q: kind must be configmap or secret", ref)
+	}
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("ParseHelmValuesReference", func(ref string, expected HelmValuesReference, valid bool) {
+	valuesRef, err := ParseHelmValuesReference(ref)
+	if !valid {
+		Expect(err).To(HaveOccurred())
+		return
+	}
+
+	Expect(err).NotTo(HaveOccurred())
+	Expect(valuesRef).To(Equal(expected))
+},
+	Entry("a configmap", "configmap/loki-values", HelmValuesReference{Kind: "ConfigMap", Name: "loki-values"}, true),
+	Entry("a secret", "Secret/loki-values", HelmValuesReference{Kind: "Secret", Name: "loki-values"}, true),
+	Entry("another kind", "deployment/loki", HelmValuesReference{}, false),
+	Entry("without a name", "configmap/", HelmValuesReference{}, false),
+	Entry("without a kind", "loki-values", HelmValuesReference{}, false),
+)
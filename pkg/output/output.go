@@ -0,0 +1,148 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+// Format is the format the get commands print resources in.
+type Format string
+
+const (
+	// FormatTable prints a table with the most important fields. This is the default.
+	FormatTable Format = ""
+	// FormatWide prints a table with additional columns.
+	FormatWide Format = "wide"
+	// FormatJSON prints the resources as JSON.
+	FormatJSON Format = "json"
+	// FormatYAML prints the resources as YAML.
+	FormatYAML Format = "yaml"
+	// FormatGoTemplate executes a Go template against the JSON representation of the resources.
+	FormatGoTemplate Format = "go-template"
+	// FormatJSONPath evaluates a JSONPath expression against the JSON representation of the resources.
+	FormatJSONPath Format = "jsonpath"
+)
+
+// FlagUsage is the usage of the --output flag.
+const FlagUsage = "Output format. One of: json|yaml|wide|go-template=...|jsonpath=..."
+
+// Options holds the parsed value of the --output flag.
+type Options struct {
+	Format Format
+	// Template is the Go template or JSONPath expression of the go-template and jsonpath formats
+	Template string
+}
+
+// NewOptions parses the value of the --output flag, e.g. "json" or "jsonpath={.name}".
+func NewOptions(value string) (Options, error) {
+	format := value
+	tmpl := ""
+
+	if i := strings.Index(value, "="); i >= 0 {
+		format, tmpl = value[:i], value[i+1:]
+	}
+
+	switch Format(format) {
+	case FormatTable, FormatWide, FormatJSON, FormatYAML:
+		if tmpl != "" {
+			return Options{}, fmt.Errorf("output format %q does not take a template", format)
+		}
+	case FormatGoTemplate, FormatJSONPath:
+		if tmpl == "" {
+			return Options{}, fmt.Errorf("output format %q requires a template, e.g. %s=<template>", format, format)
+		}
+	default:
+		return Options{}, fmt.Errorf("unsupported output format %q. %s", format, FlagUsage)
+	}
+
+	return Options{Format: Format(format), Template: tmpl}, nil
+}
+
+// IsTable returns true when the resources should be printed in a table.
+func (o Options) IsTable() bool {
+	return o.Format == FormatTable || o.Format == FormatWide
+}
+
+// IsWide returns true when tables should include additional columns.
+func (o Options) IsWide() bool {
+	return o.Format == FormatWide
+}
+
+// Print writes v in one of the structured formats. Go templates and JSONPath expressions
+// are evaluated against the JSON representation of v, so they use the JSON field names.
+func (o Options) Print(w io.Writer, v interface{}) error {
+	switch o.Format {
+	case FormatJSON:
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		_, err = fmt.Fprintln(w, string(out))
+
+		return err
+	case FormatYAML:
+		out, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+
+		_, err = w.Write(out)
+
+		return err
+	case FormatGoTemplate:
+		data, err := toJSONData(v)
+		if err != nil {
+			return err
+		}
+
+		t, err := template.New("output").Parse(o.Template)
+		if err != nil {
+			return fmt.Errorf("failed to parse template: %w", err)
+		}
+
+		if err := t.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to execute template: %w", err)
+		}
+
+		return nil
+	case FormatJSONPath:
+		data, err := toJSONData(v)
+		if err != nil {
+			return err
+		}
+
+		p := jsonpath.New("output")
+		if err := p.Parse(o.Template); err != nil {
+			return fmt.Errorf("failed to parse jsonpath expression: %w", err)
+		}
+
+		if err := p.Execute(w, data); err != nil {
+			return fmt.Errorf("failed to execute jsonpath expression: %w", err)
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("output format %q is not a structured format", o.Format)
+	}
+}
+
+func toJSONData(v interface{}) (interface{}, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal output: %w", err)
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(out, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal output: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,88 @@
+package output_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaveworks/weave-gitops/pkg/output"
+)
+
+type item struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+func TestNewOptions(t *testing.T) {
+	tests := []struct {
+		value            string
+		expected         output.Options
+		expectedErrorStr string
+	}{
+		{value: "", expected: output.Options{Format: output.FormatTable}},
+		{value: "wide", expected: output.Options{Format: output.FormatWide}},
+		{value: "json", expected: output.Options{Format: output.FormatJSON}},
+		{value: "jsonpath={.name}", expected: output.Options{Format: output.FormatJSONPath, Template: "{.name}"}},
+		{value: "go-template={{.name}}={{.version}}", expected: output.Options{Format: output.FormatGoTemplate, Template: "{{.name}}={{.version}}"}},
+		{value: "jsonpath", expectedErrorStr: "output format \"jsonpath\" requires a template, e.g. jsonpath=<template>"},
+		{value: "yaml={.name}", expectedErrorStr: "output format \"yaml\" does not take a template"},
+		{value: "xml", expectedErrorStr: "unsupported output format \"xml\". " + output.FlagUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			opts, err := output.NewOptions(tt.value)
+			if tt.expectedErrorStr != "" {
+				assert.EqualError(t, err, tt.expectedErrorStr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, opts)
+		})
+	}
+}
+
+func TestPrint(t *testing.T) {
+	items := []item{{Name: "podinfo", Version: "6.0.0"}, {Name: "nginx", Version: "1.0.0"}}
+
+	tests := []struct {
+		name     string
+		opts     output.Options
+		expected string
+	}{
+		{
+			name:     "json",
+			opts:     output.Options{Format: output.FormatJSON},
+			expected: "[\n  {\n    \"name\": \"podinfo\",\n    \"version\": \"6.0.0\"\n  },\n  {\n    \"name\": \"nginx\",\n    \"version\": \"1.0.0\"\n  }\n]\n",
+		},
+		{
+			name:     "yaml",
+			opts:     output.Options{Format: output.FormatYAML},
+			expected: "- name: podinfo\n  version: 6.0.0\n- name: nginx\n  version: 1.0.0\n",
+		},
+		{
+			name:     "go-template",
+			opts:     output.Options{Format: output.FormatGoTemplate, Template: "{{range .}}{{.name}}:{{.version}} {{end}}"},
+			expected: "podinfo:6.0.0 nginx:1.0.0 ",
+		},
+		{
+			name:     "jsonpath",
+			opts:     output.Options{Format: output.FormatJSONPath, Template: "{[*].name}"},
+			expected: "podinfo nginx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := new(bytes.Buffer)
+			assert.NoError(t, tt.opts.Print(w, items))
+			assert.Equal(t, tt.expected, w.String())
+		})
+	}
+
+	t.Run("table", func(t *testing.T) {
+		err := output.Options{}.Print(new(bytes.Buffer), items)
+		assert.EqualError(t, err, "output format \"\" is not a structured format")
+	})
+}
@@ -18,6 +18,9 @@ const (
 	// RefreshTokenCookieName is the name of the cookie that holds the refresh
 	// token.
 	RefreshTokenCookieName = "refresh_token"
+	// StaticUserCookieName is the name of the cookie that holds the token of
+	// the static user once they have signed in.
+	StaticUserCookieName = "static_user_token"
 	// ScopeProfile is the "profile" scope
 	scopeProfile = "profile"
 	// ScopeEmail is the "email" scope
@@ -28,9 +31,15 @@ const (
 
 // RegisterAuthServer registers the /callback route under a specified prefix.
 // This route is called by the OIDC Provider in order to pass back state after
-// the authentication flow completes.
+// the authentication flow completes. When the static user is enabled, the
+// /sign_in and /sign_out routes are registered as well.
 func RegisterAuthServer(mux *http.ServeMux, prefix string, srv *AuthServer) {
 	mux.Handle(prefix+"/callback", srv)
+
+	if srv.config.StaticUser.Enabled {
+		mux.Handle(prefix+"/sign_in", http.HandlerFunc(srv.SignIn))
+		mux.Handle(prefix+"/sign_out", http.HandlerFunc(srv.SignOut))
+	}
 }
 
 type principalCtxKey struct{}
@@ -60,10 +69,7 @@ func WithPrincipal(ctx context.Context, p *UserPrincipal) context.Context {
 //
 // Unauthorized requests will be denied with a 401 status code.
 func WithAPIAuth(next http.Handler, srv *AuthServer) http.Handler {
-	cookieAuth := NewJWTCookiePrincipalGetter(srv.logger,
-		srv.verifier(), IDTokenCookieName)
-	headerAuth := NewJWTAuthorizationHeaderPrincipalGetter(srv.logger, srv.verifier())
-	multi := MultiAuthPrincipal{cookieAuth, headerAuth}
+	multi := srv.principalGetter()
 
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		principal, err := multi.Principal(r)
@@ -84,12 +90,15 @@ func WithAPIAuth(next http.Handler, srv *AuthServer) http.Handler {
 //
 // Unauthorized requests will be redirected to the OIDC Provider.
 // It is meant to be used with routes that serve HTML content,
-// not API routes.
+// not API routes. Without an OIDC Provider there is nowhere to
+// redirect to, so the content is served and the UI signs in the
+// static user through the API.
 func WithWebAuth(next http.Handler, srv *AuthServer) http.Handler {
-	cookieAuth := NewJWTCookiePrincipalGetter(srv.logger,
-		srv.verifier(), IDTokenCookieName)
-	headerAuth := NewJWTAuthorizationHeaderPrincipalGetter(srv.logger, srv.verifier())
-	multi := MultiAuthPrincipal{cookieAuth, headerAuth}
+	if !srv.oidcEnabled() {
+		return next
+	}
+
+	multi := srv.principalGetter()
 
 	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
 		principal, err := multi.Principal(r)
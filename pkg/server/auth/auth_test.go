@@ -33,13 +33,13 @@ func TestWithAPIAuthReturns401ForUnauthenticatedRequests(t *testing.T) {
 
 	srv, err := auth.NewAuthServer(ctx, logr.Discard(), http.DefaultClient,
 		auth.AuthConfig{
-			auth.OIDCConfig{
+			OIDCConfig: auth.OIDCConfig{
 				IssuerURL:    fake.Issuer,
 				ClientID:     fake.ClientID,
 				ClientSecret: fake.ClientSecret,
 				RedirectURL:  "",
 			},
-			auth.CookieConfig{
+			CookieConfig: auth.CookieConfig{
 				CookieDuration:     20 * time.Minute,
 				IssueSecureCookies: false,
 			},
@@ -82,13 +82,13 @@ func TestWithWebAuthRedirectsToOIDCIssuerForUnauthenticatedRequests(t *testing.T
 
 	srv, err := auth.NewAuthServer(ctx, logr.Discard(), http.DefaultClient,
 		auth.AuthConfig{
-			auth.OIDCConfig{
+			OIDCConfig: auth.OIDCConfig{
 				IssuerURL:    fake.Issuer,
 				ClientID:     fake.ClientID,
 				ClientSecret: fake.ClientSecret,
 				RedirectURL:  "",
 			},
-			auth.CookieConfig{
+			CookieConfig: auth.CookieConfig{
 				CookieDuration:     20 * time.Minute,
 				IssueSecureCookies: false,
 			},
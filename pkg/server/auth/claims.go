@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultUsernameClaim is the ID token claim used as the username when none is configured.
+	DefaultUsernameClaim = "email"
+	// DefaultGroupsClaim is the ID token claim used as the groups when none is configured.
+	DefaultGroupsClaim = "groups"
+)
+
+// ClaimsConfig is used to configure how the claims of an ID token map to the
+// principal that is impersonated on requests to Kubernetes. It works like the
+// --oidc-username-claim, --oidc-groups-claim, --oidc-username-prefix and
+// --oidc-groups-prefix flags of the Kubernetes API server, so that RBAC
+// bindings written for users of the API server also apply to the principals.
+type ClaimsConfig struct {
+	UsernameClaim  string
+	GroupsClaim    string
+	UsernamePrefix string
+	GroupsPrefix   string
+}
+
+func (c ClaimsConfig) principal(claims map[string]interface{}) (*UserPrincipal, error) {
+	usernameClaim := c.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = DefaultUsernameClaim
+	}
+
+	groupsClaim := c.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = DefaultGroupsClaim
+	}
+
+	username, ok := claims[usernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("the JWT token does not have a %q claim", usernameClaim)
+	}
+
+	groups, err := groupsFromClaim(claims[groupsClaim])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the %q claim of the JWT token: %w", groupsClaim, err)
+	}
+
+	for i := range groups {
+		groups[i] = c.GroupsPrefix + groups[i]
+	}
+
+	return &UserPrincipal{ID: c.UsernamePrefix + username, Groups: groups}, nil
+}
+
+// groupsFromClaim accepts a list of groups or a single group, like the Kubernetes API server.
+func groupsFromClaim(claim interface{}) ([]string, error) {
+	switch v := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{v}, nil
+	case []interface{}:
+		groups := []string{}
+
+		for _, g := range v {
+			group, ok := g.(string)
+			if !ok {
+				return nil, fmt.Errorf("group %v is not a string", g)
+			}
+
+			groups = append(groups, group)
+		}
+
+		return groups, nil
+	default:
+		return nil, fmt.Errorf("expected a string or a list of strings, got %T", claim)
+	}
+}
@@ -23,13 +23,15 @@ type PrincipalGetter interface {
 type JWTCookiePrincipalGetter struct {
 	log        logr.Logger
 	verifier   *oidc.IDTokenVerifier
+	claims     ClaimsConfig
 	cookieName string
 }
 
-func NewJWTCookiePrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier, cookieName string) PrincipalGetter {
+func NewJWTCookiePrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier, claims ClaimsConfig, cookieName string) PrincipalGetter {
 	return &JWTCookiePrincipalGetter{
 		log:        log,
 		verifier:   verifier,
+		claims:     claims,
 		cookieName: cookieName,
 	}
 }
@@ -42,7 +44,7 @@ func (pg *JWTCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal,
 		return nil, nil
 	}
 
-	return parseJWTToken(r.Context(), pg.verifier, cookie.Value)
+	return parseJWTToken(r.Context(), pg.verifier, pg.claims, cookie.Value)
 }
 
 // JWTAuthorizationHeaderPrincipalGetter inspects the Authorization
@@ -51,12 +53,14 @@ func (pg *JWTCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal,
 type JWTAuthorizationHeaderPrincipalGetter struct {
 	log      logr.Logger
 	verifier *oidc.IDTokenVerifier
+	claims   ClaimsConfig
 }
 
-func NewJWTAuthorizationHeaderPrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier) PrincipalGetter {
+func NewJWTAuthorizationHeaderPrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier, claims ClaimsConfig) PrincipalGetter {
 	return &JWTAuthorizationHeaderPrincipalGetter{
 		log:      log,
 		verifier: verifier,
+		claims:   claims,
 	}
 }
 
@@ -68,7 +72,7 @@ func (pg *JWTAuthorizationHeaderPrincipalGetter) Principal(r *http.Request) (*Us
 		return nil, nil
 	}
 
-	return parseJWTToken(r.Context(), pg.verifier, extractToken(header))
+	return parseJWTToken(r.Context(), pg.verifier, pg.claims, extractToken(header))
 }
 
 func extractToken(s string) string {
@@ -84,22 +88,19 @@ func extractToken(s string) string {
 	return strings.TrimSpace(parts[1])
 }
 
-func parseJWTToken(ctx context.Context, verifier *oidc.IDTokenVerifier, rawIDToken string) (*UserPrincipal, error) {
+func parseJWTToken(ctx context.Context, verifier *oidc.IDTokenVerifier, claimsConfig ClaimsConfig, rawIDToken string) (*UserPrincipal, error) {
 	token, err := verifier.Verify(ctx, rawIDToken)
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify JWT token: %w", err)
 	}
 
-	var claims struct {
-		Email  string   `json:"email"`
-		Groups []string `json:"groups"`
-	}
+	claims := map[string]interface{}{}
 
 	if err := token.Claims(&claims); err != nil {
 		return nil, fmt.Errorf("failed to parse claims from the JWT token: %w", err)
 	}
 
-	return &UserPrincipal{ID: claims.Email, Groups: claims.Groups}, nil
+	return claimsConfig.principal(claims)
 }
 
 // MultiAuthPrincipal looks for a principal in an array of principal getters and
@@ -33,7 +33,7 @@ func TestJWTCookiePrincipalGetter(t *testing.T) {
 
 	for _, tt := range authTests {
 		t.Run(tt.name, func(t *testing.T) {
-			principal, err := auth.NewJWTCookiePrincipalGetter(logr.Discard(), verifier, cookieName).Principal(makeCookieRequest(cookieName, tt.cookie))
+			principal, err := auth.NewJWTCookiePrincipalGetter(logr.Discard(), verifier, auth.ClaimsConfig{}, cookieName).Principal(makeCookieRequest(cookieName, tt.cookie))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -49,10 +49,23 @@ func TestJWTAuthorizationHeaderPrincipalGetter(t *testing.T) {
 	authTests := []struct {
 		name          string
 		authorization string
+		claims        auth.ClaimsConfig
 		want          *auth.UserPrincipal
 	}{
-		{"JWT ID Token", "Bearer " + testutils.MakeJWToken(t, privKey, "example@example.com"), &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"testing"}}},
-		{"no auth header value", "", nil},
+		{"JWT ID Token", "Bearer " + testutils.MakeJWToken(t, privKey, "example@example.com"), auth.ClaimsConfig{}, &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"testing"}}},
+		{"no auth header value", "", auth.ClaimsConfig{}, nil},
+		{
+			"username claim and prefixes",
+			"Bearer " + testutils.MakeJWToken(t, privKey, "example@example.com"),
+			auth.ClaimsConfig{UsernameClaim: "preferred_username", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"},
+			&auth.UserPrincipal{ID: "oidc:example", Groups: []string{"oidc:testing"}},
+		},
+		{
+			"groups claim",
+			"Bearer " + testutils.MakeJWToken(t, privKey, "example@example.com"),
+			auth.ClaimsConfig{GroupsClaim: "sub"},
+			&auth.UserPrincipal{ID: "example@example.com", Groups: []string{"testing"}},
+		},
 	}
 
 	srv := testutils.MakeKeysetServer(t, privKey)
@@ -61,7 +74,7 @@ func TestJWTAuthorizationHeaderPrincipalGetter(t *testing.T) {
 
 	for _, tt := range authTests {
 		t.Run(tt.name, func(t *testing.T) {
-			principal, err := auth.NewJWTAuthorizationHeaderPrincipalGetter(logr.Discard(), verifier).Principal(makeAuthenticatedRequest(tt.authorization))
+			principal, err := auth.NewJWTAuthorizationHeaderPrincipalGetter(logr.Discard(), verifier, tt.claims).Principal(makeAuthenticatedRequest(tt.authorization))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -72,6 +85,21 @@ func TestJWTAuthorizationHeaderPrincipalGetter(t *testing.T) {
 	}
 }
 
+func TestJWTAuthorizationHeaderPrincipalGetterMissingUsernameClaim(t *testing.T) {
+	privKey := testutils.MakeRSAPrivateKey(t)
+
+	srv := testutils.MakeKeysetServer(t, privKey)
+	keySet := oidc.NewRemoteKeySet(oidc.ClientContext(context.TODO(), srv.Client()), srv.URL)
+	verifier := oidc.NewVerifier("http://127.0.0.1:5556/dex", keySet, &oidc.Config{ClientID: "test-service"})
+
+	getter := auth.NewJWTAuthorizationHeaderPrincipalGetter(logr.Discard(), verifier, auth.ClaimsConfig{UsernameClaim: "name"})
+
+	_, err := getter.Principal(makeAuthenticatedRequest("Bearer " + testutils.MakeJWToken(t, privKey, "example@example.com")))
+	if err == nil || err.Error() != `the JWT token does not have a "name" claim` {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func makeCookieRequest(cookieName, token string) *http.Request {
 	req := httptest.NewRequest("GET", "http://example.com/", nil)
 	if token != "" {
@@ -11,6 +11,7 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-logr/logr"
 	"golang.org/x/oauth2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // OIDCConfig is used to configure an AuthServer to interact with
@@ -20,6 +21,8 @@ type OIDCConfig struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURL  string
+	// Claims configures the principal an ID token maps to
+	Claims ClaimsConfig
 }
 
 // CookieConfig is used to configure the cookies that get issued
@@ -29,33 +32,106 @@ type CookieConfig struct {
 	IssueSecureCookies bool
 }
 
-// AuthConfig is used to configure an AuthServer.
+// TokenReviewConfig is used to configure an AuthServer to accept
+// Kubernetes ServiceAccount tokens as bearer tokens. Tokens must be
+// issued for one of the Audiences, which default to the ones of the
+// API server, to a member of one of the Groups, which default to all
+// ServiceAccounts.
+type TokenReviewConfig struct {
+	Enabled   bool
+	Audiences []string
+	Groups    []string
+}
+
+// AuthConfig is used to configure an AuthServer. The OIDC issuer is
+// optional when the static user or the ServiceAccount token login is
+// enabled, e.g. in air-gapped clusters without an identity provider.
 type AuthConfig struct {
 	OIDCConfig
 	CookieConfig
+	StaticUser  StaticUserConfig
+	TokenReview TokenReviewConfig
+	// KubernetesClient reads the Secret of the static user and creates
+	// the TokenReviews of ServiceAccount tokens.
+	KubernetesClient client.Client
 }
 
-// AuthServer interacts with an OIDC issuer to handle the OAuth2 process flow.
+// AuthServer interacts with an OIDC issuer to handle the OAuth2 process flow,
+// and logs in the static user.
 type AuthServer struct {
-	logger   logr.Logger
-	client   *http.Client
-	provider *oidc.Provider
-	config   AuthConfig
+	logger      logr.Logger
+	client      *http.Client
+	provider    *oidc.Provider
+	tokenSigner *TokenSigner
+	config      AuthConfig
 }
 
 // NewAuthServer creates a new AuthServer object.
 func NewAuthServer(ctx context.Context, logger logr.Logger, client *http.Client, config AuthConfig) (*AuthServer, error) {
-	provider, err := oidc.NewProvider(ctx, config.IssuerURL)
-	if err != nil {
-		return nil, fmt.Errorf("could not create provider: %w", err)
+	if config.IssuerURL == "" && !config.StaticUser.Enabled && !config.TokenReview.Enabled {
+		return nil, fmt.Errorf("no authentication method is configured")
+	}
+
+	if (config.StaticUser.Enabled || config.TokenReview.Enabled) && config.KubernetesClient == nil {
+		return nil, fmt.Errorf("a Kubernetes client is required for the static user and ServiceAccount token login")
+	}
+
+	srv := &AuthServer{
+		logger: logger,
+		client: client,
+		config: config,
+	}
+
+	if config.IssuerURL != "" {
+		provider, err := oidc.NewProvider(ctx, config.IssuerURL)
+		if err != nil {
+			return nil, fmt.Errorf("could not create provider: %w", err)
+		}
+
+		srv.provider = provider
+	}
+
+	if config.StaticUser.Enabled {
+		signer, err := NewTokenSigner(config.CookieDuration)
+		if err != nil {
+			return nil, fmt.Errorf("could not create token signer: %w", err)
+		}
+
+		srv.tokenSigner = signer
 	}
 
-	return &AuthServer{
-		logger:   logger,
-		client:   client,
-		provider: provider,
-		config:   config,
-	}, nil
+	return srv, nil
+}
+
+// oidcEnabled returns true if an OIDC issuer is configured.
+func (c *AuthServer) oidcEnabled() bool {
+	return c.provider != nil
+}
+
+// principalGetter returns the chain of PrincipalGetters of the enabled
+// authentication methods. The ServiceAccount tokens are reviewed before
+// the OIDC bearer tokens are verified, as a token that fails the OIDC
+// verification stops the chain.
+func (c *AuthServer) principalGetter() PrincipalGetter {
+	multi := MultiAuthPrincipal{}
+
+	if c.oidcEnabled() {
+		multi = append(multi, NewJWTCookiePrincipalGetter(c.logger, c.verifier(), c.config.Claims, IDTokenCookieName))
+	}
+
+	if c.config.StaticUser.Enabled {
+		multi = append(multi, NewStaticUserCookiePrincipalGetter(c.logger, c.tokenSigner, StaticUserCookieName))
+	}
+
+	if c.config.TokenReview.Enabled {
+		multi = append(multi, NewTokenReviewPrincipalGetter(c.logger, c.config.KubernetesClient, c.config.TokenReview.Audiences, c.config.TokenReview.Groups))
+	}
+
+	if c.oidcEnabled() {
+		multi = append(multi, NewJWTAuthorizationHeaderPrincipalGetter(c.logger, c.verifier(), c.config.Claims))
+	}
+
+	return multi
 }
 
 // SetRedirectURL is used to set the redirect URL. This is meant to be used
@@ -106,6 +182,11 @@ func (c *AuthServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 
 	ctx := oidc.ClientContext(r.Context(), c.client)
 
+	if !c.oidcEnabled() {
+		http.Error(rw, "OIDC is not configured", http.StatusNotFound)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodGet:
 		// Authorization redirect callback from OAuth2 auth flow.
@@ -0,0 +1,211 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultStaticUserSecretName is the name of the Secret that holds the
+	// username and password of the static user when none is configured.
+	DefaultStaticUserSecretName = "cluster-user-auth"
+	// StaticUserUsernameKey is the key of the username in the Secret.
+	StaticUserUsernameKey = "username"
+	// StaticUserPasswordKey is the key of the bcrypt hash of the password
+	// in the Secret.
+	StaticUserPasswordKey = "password"
+
+	tokenSigningKeyLength = 32
+)
+
+// StaticUserConfig is used to configure an AuthServer to sign in a single
+// user, e.g. an admin, whose username and bcrypt-hashed password are stored
+// in a Kubernetes Secret. The Secret is read on every sign in, so that
+// the password can be changed without restarting the server.
+type StaticUserConfig struct {
+	Enabled         bool
+	SecretName      string
+	SecretNamespace string
+}
+
+// SignInRequest is the body of a request to sign in the static user.
+type SignInRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// SignIn checks the credentials of the static user against the Secret and
+// issues a cookie with a signed token on success.
+func (c *AuthServer) SignIn(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(rw, fmt.Sprintf("method not implemented: %s", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req SignInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(rw, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+
+	ok, err := c.checkStaticUser(r.Context(), req.Username, req.Password)
+	if err != nil {
+		c.logger.Error(err, "failed to check the credentials of the static user")
+		http.Error(rw, "", http.StatusInternalServerError)
+
+		return
+	}
+
+	if !ok {
+		c.logger.Info("invalid credentials for the static user", "username", req.Username)
+		http.Error(rw, "Invalid username or password", http.StatusUnauthorized)
+
+		return
+	}
+
+	token, err := c.tokenSigner.Sign(req.Username)
+	if err != nil {
+		c.logger.Error(err, "failed to sign token")
+		http.Error(rw, "", http.StatusInternalServerError)
+
+		return
+	}
+
+	http.SetCookie(rw, c.createCookie(StaticUserCookieName, token))
+	rw.WriteHeader(http.StatusOK)
+}
+
+// SignOut clears the cookies of the static user and the OIDC tokens.
+func (c *AuthServer) SignOut(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(rw, fmt.Sprintf("method not implemented: %s", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	http.SetCookie(rw, c.clearCookie(StaticUserCookieName))
+	http.SetCookie(rw, c.clearCookie(IDTokenCookieName))
+	http.SetCookie(rw, c.clearCookie(RefreshTokenCookieName))
+	rw.WriteHeader(http.StatusOK)
+}
+
+func (c *AuthServer) checkStaticUser(ctx context.Context, username, password string) (bool, error) {
+	secretName := c.config.StaticUser.SecretName
+	if secretName == "" {
+		secretName = DefaultStaticUserSecretName
+	}
+
+	var secret corev1.Secret
+	if err := c.config.KubernetesClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: c.config.StaticUser.SecretNamespace}, &secret); err != nil {
+		return false, fmt.Errorf("failed to get the secret %s/%s: %w", c.config.StaticUser.SecretNamespace, secretName, err)
+	}
+
+	hash, ok := secret.Data[StaticUserPasswordKey]
+	if !ok {
+		return false, fmt.Errorf("the secret %s/%s does not have a %q key", c.config.StaticUser.SecretNamespace, secretName, StaticUserPasswordKey)
+	}
+
+	if subtle.ConstantTimeCompare(secret.Data[StaticUserUsernameKey], []byte(username)) != 1 {
+		return false, nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to compare the password hash: %w", err)
+	}
+
+	return true, nil
+}
+
+// TokenSigner signs and verifies the tokens of the static user with a key
+// generated at startup. Restarting the server signs out the user.
+type TokenSigner struct {
+	key      []byte
+	duration time.Duration
+}
+
+// NewTokenSigner creates a TokenSigner with a random key, whose tokens
+// expire after duration.
+func NewTokenSigner(duration time.Duration) (*TokenSigner, error) {
+	key := make([]byte, tokenSigningKeyLength)
+
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return &TokenSigner{key: key, duration: duration}, nil
+}
+
+// Sign creates a token with the username as subject.
+func (s *TokenSigner) Sign(username string) (string, error) {
+	claims := jwt.StandardClaims{
+		Subject:   username,
+		IssuedAt:  time.Now().Unix(),
+		ExpiresAt: time.Now().Add(s.duration).Unix(),
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.key)
+}
+
+// Verify checks the signature and expiry of a token and returns its claims.
+func (s *TokenSigner) Verify(token string) (*jwt.StandardClaims, error) {
+	claims := &jwt.StandardClaims{}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected token signing method %v", t.Header["alg"])
+		}
+
+		return s.key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// StaticUserCookiePrincipalGetter inspects a cookie for a token signed
+// for the static user and returns a principal object.
+type StaticUserCookiePrincipalGetter struct {
+	log        logr.Logger
+	signer     *TokenSigner
+	cookieName string
+}
+
+func NewStaticUserCookiePrincipalGetter(log logr.Logger, signer *TokenSigner, cookieName string) PrincipalGetter {
+	return &StaticUserCookiePrincipalGetter{
+		log:        log,
+		signer:     signer,
+		cookieName: cookieName,
+	}
+}
+
+func (pg *StaticUserCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
+	pg.log.Info("attempt to read static user token from cookie")
+
+	cookie, err := r.Cookie(pg.cookieName)
+	if err == http.ErrNoCookie {
+		return nil, nil
+	}
+
+	claims, err := pg.signer.Verify(cookie.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify static user token: %w", err)
+	}
+
+	return &UserPrincipal{ID: claims.Subject}, nil
+}
@@ -0,0 +1,146 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func makeStaticUserAuthServer(t *testing.T) *auth.AuthServer {
+	hash, err := bcrypt.GenerateFromPassword([]byte("my-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: auth.DefaultStaticUserSecretName, Namespace: "wego-system"},
+		Data: map[string][]byte{
+			auth.StaticUserUsernameKey: []byte("admin"),
+			auth.StaticUserPasswordKey: hash,
+		},
+	}
+
+	srv, err := auth.NewAuthServer(context.Background(), logr.Discard(), http.DefaultClient,
+		auth.AuthConfig{
+			CookieConfig:     auth.CookieConfig{CookieDuration: 20 * time.Minute},
+			StaticUser:       auth.StaticUserConfig{Enabled: true, SecretNamespace: "wego-system"},
+			KubernetesClient: fake.NewClientBuilder().WithObjects(secret).Build(),
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return srv
+}
+
+func TestSignInStaticUser(t *testing.T) {
+	srv := makeStaticUserAuthServer(t)
+	mux := http.NewServeMux()
+	auth.RegisterAuthServer(mux, "/oauth2", srv)
+
+	res := httptest.NewRecorder()
+	mux.ServeHTTP(res, httptest.NewRequest(http.MethodPost, "/oauth2/sign_in", strings.NewReader(`{"username":"admin","password":"my-password"}`)))
+
+	if res.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected status of %d but got %d", http.StatusOK, res.Result().StatusCode)
+	}
+
+	var cookie *http.Cookie
+
+	for _, c := range res.Result().Cookies() {
+		if c.Name == auth.StaticUserCookieName {
+			cookie = c
+		}
+	}
+
+	if cookie == nil {
+		t.Fatalf("expected a %q cookie", auth.StaticUserCookieName)
+	}
+
+	var principal *auth.UserPrincipal
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/applications", nil)
+	req.AddCookie(cookie)
+
+	res = httptest.NewRecorder()
+	auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		principal = auth.Principal(r.Context())
+	}), srv).ServeHTTP(res, req)
+
+	if res.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected status of %d but got %d", http.StatusOK, res.Result().StatusCode)
+	}
+
+	if diff := cmp.Diff(&auth.UserPrincipal{ID: "admin"}, principal); diff != "" {
+		t.Fatalf("failed to get principal:\n%s", diff)
+	}
+}
+
+func TestSignInStaticUserWithInvalidCredentials(t *testing.T) {
+	srv := makeStaticUserAuthServer(t)
+
+	signInTests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"wrong password", `{"username":"admin","password":"wrong"}`, http.StatusUnauthorized},
+		{"wrong username", `{"username":"root","password":"my-password"}`, http.StatusUnauthorized},
+		{"invalid body", `{`, http.StatusBadRequest},
+	}
+
+	for _, tt := range signInTests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := httptest.NewRecorder()
+			srv.SignIn(res, httptest.NewRequest(http.MethodPost, "/oauth2/sign_in", strings.NewReader(tt.body)))
+
+			if res.Result().StatusCode != tt.want {
+				t.Fatalf("expected status of %d but got %d", tt.want, res.Result().StatusCode)
+			}
+
+			if len(res.Result().Cookies()) != 0 {
+				t.Fatalf("expected no cookies, got %v", res.Result().Cookies())
+			}
+		})
+	}
+}
+
+func TestStaticUserCookiePrincipalGetterRejectsForeignTokens(t *testing.T) {
+	signer, err := auth.NewTokenSigner(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := auth.NewTokenSigner(time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := other.Sign("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = auth.NewStaticUserCookiePrincipalGetter(logr.Discard(), signer, auth.StaticUserCookieName).Principal(makeCookieRequest(auth.StaticUserCookieName, token))
+	if err == nil {
+		t.Fatal("expected an error for a token signed with another key")
+	}
+}
+
+func TestNewAuthServerRequiresAnAuthenticationMethod(t *testing.T) {
+	_, err := auth.NewAuthServer(context.Background(), logr.Discard(), http.DefaultClient, auth.AuthConfig{})
+	if err == nil || err.Error() != "no authentication method is configured" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
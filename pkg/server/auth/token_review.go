@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServiceAccountsGroup is the group Kubernetes puts every ServiceAccount in.
+	ServiceAccountsGroup = "system:serviceaccounts"
+
+	// TokenReviewCacheTTL is how long the result of a TokenReview is reused for the same token.
+	TokenReviewCacheTTL = 10 * time.Second
+)
+
+// TokenReviewPrincipalGetter inspects the Authorization header (bearer
+// token) for a Kubernetes ServiceAccount token, validates it through a
+// TokenReview and returns a principal object. Only tokens issued for
+// one of the configured audiences, to a user in one of the configured
+// groups, are accepted. Reviews are cached for TokenReviewCacheTTL by
+// the hash of the token.
+type TokenReviewPrincipalGetter struct {
+	log       logr.Logger
+	client    client.Client
+	audiences []string
+	groups    []string
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu      sync.Mutex
+	reviews map[string]tokenReview
+}
+
+// tokenReview is a cached review, principal is nil when the token was rejected.
+type tokenReview struct {
+	principal *UserPrincipal
+	expires   time.Time
+}
+
+// NewTokenReviewPrincipalGetter creates a TokenReviewPrincipalGetter. The
+// audiences default to the ones of the API server and the groups to
+// ServiceAccountsGroup.
+func NewTokenReviewPrincipalGetter(log logr.Logger, client client.Client, audiences, groups []string) PrincipalGetter {
+	if len(groups) == 0 {
+		groups = []string{ServiceAccountsGroup}
+	}
+
+	return &TokenReviewPrincipalGetter{
+		log:       log,
+		client:    client,
+		audiences: audiences,
+		groups:    groups,
+		ttl:       TokenReviewCacheTTL,
+		now:       time.Now,
+		reviews:   map[string]tokenReview{},
+	}
+}
+
+// Principal returns no principal when the token is not authenticated by
+// Kubernetes, so that the next PrincipalGetter can try it, e.g. as an
+// OIDC ID token.
+func (pg *TokenReviewPrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
+	pg.log.Info("attempt to review token from auth header")
+
+	token := extractToken(r.Header.Get("Authorization"))
+	if token == "" {
+		return nil, nil
+	}
+
+	sum := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(sum[:])
+
+	pg.mu.Lock()
+	cached, ok := pg.reviews[key]
+	pg.mu.Unlock()
+
+	if ok && pg.now().Before(cached.expires) {
+		return cached.principal, nil
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token, Audiences: pg.audiences},
+	}
+
+	if err := pg.client.Create(r.Context(), review); err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+
+	var principal *UserPrincipal
+
+	if review.Status.Authenticated && pg.allowed(review.Status) {
+		principal = &UserPrincipal{ID: review.Status.User.Username, Groups: review.Status.User.Groups}
+	}
+
+	pg.store(key, principal)
+
+	return principal, nil
+}
+
+// allowed reports whether the reviewed token was issued for one of the audiences
+// and to a member of one of the groups.
+func (pg *TokenReviewPrincipalGetter) allowed(status authenticationv1.TokenReviewStatus) bool {
+	if len(pg.audiences) > 0 && !containsAny(status.Audiences, pg.audiences) {
+		return false
+	}
+
+	return containsAny(status.User.Groups, pg.groups)
+}
+
+func (pg *TokenReviewPrincipalGetter) store(key string, principal *UserPrincipal) {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	now := pg.now()
+
+	for k, review := range pg.reviews {
+		if !now.Before(review.expires) {
+			delete(pg.reviews, k)
+		}
+	}
+
+	pg.reviews[key] = tokenReview{principal: principal, expires: now.Add(pg.ttl)}
+}
+
+func containsAny(values, wanted []string) bool {
+	for _, v := range values {
+		for _, w := range wanted {
+			if v == w {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+package auth_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTokenReviewPrincipalGetter(t *testing.T) {
+	reviewTests := []struct {
+		name   string
+		header string
+		want   *auth.UserPrincipal
+	}{
+		{"service account token", "Bearer sa-token", &auth.UserPrincipal{ID: "system:serviceaccount:ci:deployer", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:ci"}}},
+		{"user token", "Bearer user-token", nil},
+		{"unauthenticated token", "Bearer other-token", nil},
+		{"no header", "", nil},
+	}
+
+	cl := newTokenReviewClient(t)
+
+	for _, tt := range reviewTests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := auth.NewTokenReviewPrincipalGetter(logr.Discard(), cl, nil, nil).Principal(makeAuthenticatedRequest(tt.header))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, principal); diff != "" {
+				t.Fatalf("failed to get principal:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTokenReviewPrincipalGetterChecksAudiencesAndGroups(t *testing.T) {
+	cl := newTokenReviewClient(t)
+	req := makeAuthenticatedRequest("Bearer sa-token")
+
+	principal, err := auth.NewTokenReviewPrincipalGetter(logr.Discard(), cl, []string{"weave-gitops"}, nil).Principal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if principal != nil {
+		t.Fatalf("accepted a token issued for another audience: %v", principal)
+	}
+
+	principal, err = auth.NewTokenReviewPrincipalGetter(logr.Discard(), cl, nil, []string{"system:serviceaccounts:prod"}).Principal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if principal != nil {
+		t.Fatalf("accepted a token of a ServiceAccount outside of the groups: %v", principal)
+	}
+}
+
+func TestTokenReviewPrincipalGetterCachesReviews(t *testing.T) {
+	cl := newTokenReviewClient(t)
+	getter := auth.NewTokenReviewPrincipalGetter(logr.Discard(), cl, nil, nil)
+
+	for _, header := range []string{"Bearer sa-token", "Bearer sa-token", "Bearer other-token", "Bearer other-token"} {
+		if _, err := getter.Principal(makeAuthenticatedRequest(header)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if reviews := atomic.LoadInt32(cl.reviews); reviews != 2 {
+		t.Fatalf("got %d TokenReviews, want one per token", reviews)
+	}
+}
+
+func newTokenReviewClient(t *testing.T) tokenReviewClient {
+	return tokenReviewClient{
+		Client: fake.NewClientBuilder().WithScheme(makeTokenReviewScheme(t)).Build(),
+		users: map[string]authenticationv1.UserInfo{
+			"sa-token":   {Username: "system:serviceaccount:ci:deployer", Groups: []string{"system:serviceaccounts", "system:serviceaccounts:ci"}},
+			"user-token": {Username: "jane", Groups: []string{"system:authenticated"}},
+		},
+		audiences: []string{"https://kubernetes.default.svc"},
+		reviews:   new(int32),
+	}
+}
+
+func makeTokenReviewScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := authenticationv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return scheme
+}
+
+// tokenReviewClient authenticates the tokens it knows, like the
+// Kubernetes API server does when a TokenReview is created. Tokens are
+// issued for its audiences only.
+type tokenReviewClient struct {
+	client.Client
+	users     map[string]authenticationv1.UserInfo
+	audiences []string
+	reviews   *int32
+}
+
+func (c tokenReviewClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if review, ok := obj.(*authenticationv1.TokenReview); ok {
+		atomic.AddInt32(c.reviews, 1)
+
+		if len(review.Spec.Audiences) > 0 && review.Spec.Audiences[0] != c.audiences[0] {
+			review.Status = authenticationv1.TokenReviewStatus{Error: "token audiences are invalid"}
+
+			return nil
+		}
+
+		user, ok := c.users[review.Spec.Token]
+		review.Status = authenticationv1.TokenReviewStatus{Authenticated: ok, User: user, Audiences: c.audiences}
+
+		return nil
+	}
+
+	return c.Client.Create(ctx, obj, opts...)
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+)
+
+const (
+	// ClusterNameLabel is the label Cluster API puts on the Secrets of a cluster.
+	ClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	// KubeconfigSecretKey is the key of the kubeconfig in a Cluster API kubeconfig Secret.
+	KubeconfigSecretKey = "value"
+
+	kubeconfigSecretSuffix = "-kubeconfig"
+
+	// ClustersCacheTTL is how long the clusters read from Secrets are reused before the Secrets are listed again.
+	ClustersCacheTTL = 30 * time.Second
+)
+
+// serviceAccountNamespaceFile holds the namespace of the pod the server runs in.
+var serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// ValidateClusterSecretsNamespace returns an error when the server runs in a pod and namespace,
+// the namespace of the kubeconfig Secrets of other clusters, is not the namespace of the pod.
+// The server is only granted access to the Secrets of the namespace it is installed in.
+func ValidateClusterSecretsNamespace(namespace string) error {
+	data, err := ioutil.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("could not read the namespace of the server: %w", err)
+	}
+
+	if serverNamespace := strings.TrimSpace(string(data)); serverNamespace != namespace {
+		return fmt.Errorf("the kubeconfig Secrets of other clusters must be in the namespace of the server %q, not %q", serverNamespace, namespace)
+	}
+
+	return nil
+}
+
+// Cluster holds what the server needs to talk to a cluster other than the
+// one it runs in.
+type Cluster struct {
+	Name            string
+	ClientGetter    kube.ClientGetter
+	KubeGetter      kube.KubeGetter
+	ClientsetGetter kube.ClientsetGetter
+	// Err is set when the cluster is known but its clients cannot be created,
+	// e.g. because its kubeconfig is invalid.
+	Err error
+}
+
+// ClustersGetter implementations return the clusters, other than the one
+// the server runs in, that applications can be read from.
+type ClustersGetter interface {
+	Clusters(ctx context.Context) ([]Cluster, error)
+}
+
+var _ ClustersGetter = &SecretsClustersGetter{}
+
+// SecretsClustersGetter implements the ClustersGetter interface by reading
+// kubeconfigs from Secrets, like the ones Cluster API creates for the
+// clusters it provisions. Requests to those clusters impersonate the
+// principal found in the context, in the same way as requests to the
+// cluster the server runs in. The clusters are cached for ClustersCacheTTL,
+// so changes to the Secrets are picked up after at most that long.
+type SecretsClustersGetter struct {
+	client    client.Client
+	namespace string
+	selector  labels.Selector
+	ttl       time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	clusters []Cluster
+	expires  time.Time
+}
+
+// NewSecretsClustersGetter creates a SecretsClustersGetter that reads the
+// Secrets in namespace matching selector with client.
+func NewSecretsClustersGetter(client client.Client, namespace string, selector labels.Selector) ClustersGetter {
+	return &SecretsClustersGetter{
+		client:    client,
+		namespace: namespace,
+		selector:  selector,
+		ttl:       ClustersCacheTTL,
+		now:       time.Now,
+	}
+}
+
+// Clusters returns a cluster for every Secret with a kubeconfig under the
+// "value" key, sorted by name. Other Secrets, like the certificate Secrets
+// Cluster API also labels with the cluster name, are ignored. The cluster is
+// named after the cluster name label or, when it is missing, after the Secret
+// without its "-kubeconfig" suffix.
+func (g *SecretsClustersGetter) Clusters(ctx context.Context) ([]Cluster, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.clusters == nil || !g.now().Before(g.expires) {
+		clusters, err := g.readClusters(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		g.clusters = clusters
+		g.expires = g.now().Add(g.ttl)
+	}
+
+	return append([]Cluster{}, g.clusters...), nil
+}
+
+func (g *SecretsClustersGetter) readClusters(ctx context.Context) ([]Cluster, error) {
+	secrets := &corev1.SecretList{}
+
+	if err := g.client.List(ctx, secrets, client.InNamespace(g.namespace), client.MatchingLabelsSelector{Selector: g.selector}); err != nil {
+		return nil, fmt.Errorf("could not list cluster secrets: %w", err)
+	}
+
+	clusters := []Cluster{}
+
+	for _, secret := range secrets.Items {
+		data, ok := secret.Data[KubeconfigSecretKey]
+		if !ok {
+			continue
+		}
+
+		name := secret.Labels[ClusterNameLabel]
+		if name == "" {
+			name = strings.TrimSuffix(secret.Name, kubeconfigSecretSuffix)
+		}
+
+		clusters = append(clusters, newKubeconfigCluster(name, data))
+	}
+
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Name < clusters[j].Name })
+
+	return clusters, nil
+}
+
+func newKubeconfigCluster(name string, kubeconfig []byte) Cluster {
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return Cluster{Name: name, Err: fmt.Errorf("invalid kubeconfig: %w", err)}
+	}
+
+	configGetter := NewImpersonatingConfigGetter(cfg, false)
+
+	return Cluster{
+		Name:            name,
+		ClientGetter:    kube.NewDefaultClientGetter(configGetter, name),
+		KubeGetter:      kube.NewDefaultKubeGetter(configGetter, name),
+		ClientsetGetter: kube.NewDefaultClientsetGetter(configGetter),
+	}
+}
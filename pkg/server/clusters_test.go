@@ -0,0 +1,321 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type fakeClustersGetter struct {
+	clusters []Cluster
+	err      error
+}
+
+func (g *fakeClustersGetter) Clusters(ctx context.Context) ([]Cluster, error) {
+	return g.clusters, g.err
+}
+
+// listFailingClient fails to list objects, e.g. when the caller may only read some of them.
+type listFailingClient struct {
+	client.Client
+}
+
+func (c listFailingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return errors.New("could not list objects")
+}
+
+var _ = Describe("Clusters", func() {
+	var (
+		ctx            context.Context
+		mgmtClient     client.Client
+		leafClient     client.Client
+		clustersGetter *fakeClustersGetter
+		appsSrv        pb.ApplicationsServer
+	)
+
+	newApp := func(cl client.Client, name string) {
+		app := &wego.Application{}
+		app.Name = name
+		app.Namespace = "wego-system"
+
+		Expect(cl.Create(ctx, app)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mgmtClient = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).Build()
+		leafClient = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).Build()
+
+		newApp(mgmtClient, "mgmt-app")
+		newApp(leafClient, "leaf-app")
+
+		clustersGetter = &fakeClustersGetter{clusters: []Cluster{
+			{
+				Name:         "leaf",
+				ClientGetter: kubefakes.NewFakeClientGetter(leafClient),
+				KubeGetter:   kubefakes.NewFakeKubeGetter(&kube.KubeHTTP{Client: leafClient, ClusterName: "leaf"}),
+			},
+			{Name: "broken", Err: errors.New("invalid kubeconfig")},
+		}}
+
+		cfg := ApplicationsConfig{
+			Logger:         testutils.MakeFakeLogr(),
+			FetcherFactory: NewDefaultFetcherFactory(),
+			ClusterConfig:  kube.ClusterConfig{ClusterName: "mgmt"},
+		}
+
+		appsSrv = NewApplicationsServer(&cfg,
+			WithClientGetter(kubefakes.NewFakeClientGetter(mgmtClient)),
+			WithClustersGetter(clustersGetter))
+	})
+
+	Describe("ListApplications", func() {
+		It("lists the applications of the cluster the server runs in by default", func() {
+			res, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(1))
+			Expect(res.Applications[0].Name).To(Equal("mgmt-app"))
+			Expect(res.Applications[0].ClusterName).To(Equal("mgmt"))
+		})
+
+		It("lists the applications of a cluster by name", func() {
+			res, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{ClusterName: "leaf"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(1))
+			Expect(res.Applications[0].Name).To(Equal("leaf-app"))
+			Expect(res.Applications[0].ClusterName).To(Equal("leaf"))
+		})
+
+		It("returns an error for an unknown cluster", func() {
+			_, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{ClusterName: "unknown"})
+			Expect(err).To(MatchGRPCError(codes.NotFound, errors.New("cluster \"unknown\" not found")))
+		})
+
+		It("returns an error for a cluster that cannot be reached", func() {
+			_, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{ClusterName: "broken"})
+			Expect(err).To(MatchGRPCError(codes.FailedPrecondition, errors.New("cluster \"broken\": invalid kubeconfig")))
+		})
+
+		It("lists the applications of all clusters and reports the clusters that failed", func() {
+			res, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{AllClusters: true})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Applications).To(HaveLen(2))
+			Expect(res.Applications[0].ClusterName).To(Equal("mgmt"))
+			Expect(res.Applications[1].ClusterName).To(Equal("leaf"))
+
+			Expect(res.Errors).To(HaveLen(1))
+			Expect(res.Errors[0].ClusterName).To(Equal("broken"))
+			Expect(res.Errors[0].Message).To(Equal("invalid kubeconfig"))
+		})
+
+		It("returns an error when the clusters cannot be listed", func() {
+			clustersGetter.err = errors.New("forbidden")
+
+			_, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{AllClusters: true})
+			Expect(err).To(MatchError("could not get clusters: forbidden"))
+		})
+	})
+
+	Describe("GetApplication", func() {
+		It("reports an unknown health when the reconciled objects cannot be listed", func() {
+			clustersGetter.clusters[0].ClientGetter = kubefakes.NewFakeClientGetter(listFailingClient{leafClient})
+
+			source := &sourcev1.GitRepository{}
+			source.Name = "leaf-app"
+			source.Namespace = "wego-system"
+			Expect(leafClient.Create(ctx, source)).To(Succeed())
+
+			kustomization := &kustomizev2.Kustomization{}
+			kustomization.Name = "leaf-app"
+			kustomization.Namespace = "wego-system"
+			Expect(leafClient.Create(ctx, kustomization)).To(Succeed())
+
+			kustomization.Status.Inventory = &kustomizev2.ResourceInventory{Entries: []kustomizev2.ResourceRef{
+				{ID: "default_podinfo_example.com_Widget", Version: "v1"},
+			}}
+			Expect(leafClient.Status().Update(ctx, kustomization)).To(Succeed())
+
+			res, err := appsSrv.GetApplication(ctx, &pb.GetApplicationRequest{
+				Name:        "leaf-app",
+				Namespace:   "wego-system",
+				ClusterName: "leaf",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(res.Application.Name).To(Equal("leaf-app"))
+			Expect(res.Health.Status).To(Equal("Unknown"))
+		})
+	})
+
+	Describe("GetChildObjects", func() {
+		It("gets the objects from the cluster by name", func() {
+			parent := &corev1.ConfigMap{}
+			parent.Name = "parent"
+			parent.Namespace = "wego-system"
+			parent.UID = "some-uid"
+			Expect(leafClient.Create(ctx, parent)).To(Succeed())
+
+			res, err := appsSrv.GetChildObjects(ctx, &pb.GetChildObjectsReq{
+				ClusterName:      "leaf",
+				GroupVersionKind: &pb.GroupVersionKind{Version: "v1", Kind: "ConfigMap"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Objects).To(HaveLen(1))
+			Expect(res.Objects[0].Name).To(Equal("parent"))
+		})
+	})
+})
+
+var _ = Describe("SecretsClustersGetter", func() {
+	var (
+		ctx context.Context
+		cl  client.Client
+	)
+
+	newSecret := func(name string, labels map[string]string, data map[string][]byte) {
+		secret := &corev1.Secret{}
+		secret.Name = name
+		secret.Namespace = "default"
+		secret.Labels = labels
+		secret.Data = data
+
+		Expect(cl.Create(ctx, secret)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cl = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).Build()
+	})
+
+	It("returns a cluster for each kubeconfig secret", func() {
+		kubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: leaf
+  cluster:
+    server: https://leaf.example.com:6443
+contexts:
+- name: leaf
+  context:
+    cluster: leaf
+    user: admin
+current-context: leaf
+users:
+- name: admin
+  user:
+    token: abc
+`)
+
+		newSecret("leaf-b-kubeconfig", map[string]string{ClusterNameLabel: "leaf-b"}, map[string][]byte{KubeconfigSecretKey: kubeconfig})
+		newSecret("leaf-a-kubeconfig", map[string]string{ClusterNameLabel: "leaf-a"}, map[string][]byte{KubeconfigSecretKey: []byte("not a kubeconfig")})
+		newSecret("leaf-b-ca", map[string]string{ClusterNameLabel: "leaf-b"}, map[string][]byte{"tls.crt": []byte("cert")})
+		newSecret("other", nil, map[string][]byte{KubeconfigSecretKey: kubeconfig})
+
+		selector, err := labels.Parse(ClusterNameLabel)
+		Expect(err).NotTo(HaveOccurred())
+
+		clusters, err := NewSecretsClustersGetter(cl, "default", selector).Clusters(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(2))
+
+		Expect(clusters[0].Name).To(Equal("leaf-a"))
+		Expect(clusters[0].Err).To(HaveOccurred())
+
+		Expect(clusters[1].Name).To(Equal("leaf-b"))
+		Expect(clusters[1].Err).NotTo(HaveOccurred())
+		Expect(clusters[1].ClientGetter).NotTo(BeNil())
+		Expect(clusters[1].KubeGetter).NotTo(BeNil())
+	})
+
+	It("names clusters after the secret when the label is missing", func() {
+		newSecret("leaf-kubeconfig", map[string]string{"team": "a"}, map[string][]byte{KubeconfigSecretKey: []byte("")})
+
+		clusters, err := NewSecretsClustersGetter(cl, "default", labels.Everything()).Clusters(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(1))
+		Expect(clusters[0].Name).To(Equal("leaf"))
+	})
+
+	It("reads the secrets again once the cached clusters expire", func() {
+		newSecret("leaf-kubeconfig", nil, map[string][]byte{KubeconfigSecretKey: []byte("")})
+
+		now := time.Now()
+		getter := NewSecretsClustersGetter(cl, "default", labels.Everything()).(*SecretsClustersGetter)
+		getter.now = func() time.Time { return now }
+
+		clusters, err := getter.Clusters(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(1))
+
+		newSecret("other-kubeconfig", nil, map[string][]byte{KubeconfigSecretKey: []byte("")})
+
+		clusters, err = getter.Clusters(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(1))
+
+		now = now.Add(ClustersCacheTTL)
+
+		clusters, err = getter.Clusters(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("ValidateClusterSecretsNamespace", func() {
+	var (
+		original string
+		dir      string
+	)
+
+	BeforeEach(func() {
+		original = serviceAccountNamespaceFile
+
+		var err error
+		dir, err = ioutil.TempDir("", "serviceaccount-")
+		Expect(err).NotTo(HaveOccurred())
+
+		serviceAccountNamespaceFile = filepath.Join(dir, "namespace")
+	})
+
+	AfterEach(func() {
+		serviceAccountNamespaceFile = original
+		os.RemoveAll(dir)
+	})
+
+	It("accepts any namespace outside of a cluster", func() {
+		Expect(ValidateClusterSecretsNamespace("capi-clusters")).To(Succeed())
+	})
+
+	It("accepts the namespace of the server", func() {
+		Expect(ioutil.WriteFile(serviceAccountNamespaceFile, []byte("wego-system"), 0600)).To(Succeed())
+
+		Expect(ValidateClusterSecretsNamespace("wego-system")).To(Succeed())
+	})
+
+	It("rejects other namespaces in a cluster", func() {
+		Expect(ioutil.WriteFile(serviceAccountNamespaceFile, []byte("wego-system"), 0600)).To(Succeed())
+
+		Expect(ValidateClusterSecretsNamespace("capi-clusters")).To(MatchError(ContainSubstring(`namespace of the server "wego-system"`)))
+	})
+})
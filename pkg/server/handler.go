@@ -7,6 +7,8 @@ import (
 	"os"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	pbapp "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	pbprofiles "github.com/weaveworks/weave-gitops/pkg/api/profiles"
@@ -22,6 +24,21 @@ func AuthEnabled() bool {
 	return os.Getenv(AuthEnabledFeatureFlag) == "true"
 }
 
+// NewMetricsHandler serves the Prometheus metrics of the API requests, the git
+// provider calls and the Kubernetes client.
+func NewMetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+}
+
+// ListenAndServeMetrics serves the metrics of NewMetricsHandler on /metrics at addr,
+// apart from the API so that they are not exposed to its users.
+func ListenAndServeMetrics(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", NewMetricsHandler())
+
+	return http.ListenAndServe(addr, mux)
+}
+
 type Config struct {
 	AppConfig      *ApplicationsConfig
 	AppOptions     []ApplicationsOption
@@ -30,8 +47,9 @@ type Config struct {
 }
 
 func NewHandlers(ctx context.Context, cfg *Config) (http.Handler, error) {
-	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(cfg.AppConfig.Logger))
+	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(cfg.AppConfig.Logger), middleware.WithRPCMethodMetrics())
 	httpHandler := middleware.WithLogging(cfg.AppConfig.Logger, mux)
+	httpHandler = middleware.WithMetrics(httpHandler)
 	httpHandler = middleware.WithProviderToken(cfg.AppConfig.JwtClient, httpHandler, cfg.AppConfig.Logger)
 
 	if AuthEnabled() {
@@ -43,6 +61,10 @@ func NewHandlers(ctx context.Context, cfg *Config) (http.Handler, error) {
 		return nil, fmt.Errorf("could not register application: %w", err)
 	}
 
+	if err := registerWatchHandler(mux, appsSrv); err != nil {
+		return nil, fmt.Errorf("could not register application watch: %w", err)
+	}
+
 	profilesSrv := NewProfilesServer(cfg.ProfilesConfig)
 
 	if err := pbprofiles.RegisterProfilesHandlerServer(ctx, mux, profilesSrv); err != nil {
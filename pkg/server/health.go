@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// healthSeverity orders the kstatus of objects from the healthiest to the least healthy.
+var healthSeverity = map[status.Status]int{
+	status.CurrentStatus:     0,
+	status.UnknownStatus:     1,
+	status.InProgressStatus:  2,
+	status.TerminatingStatus: 3,
+	status.FailedStatus:      4,
+}
+
+// mapUnstructuredObject computes the kstatus of an object and maps it to the API response.
+func mapUnstructuredObject(obj *unstructured.Unstructured) (*pb.UnstructuredObject, error) {
+	res, err := status.Compute(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not get status for %s: %w", obj.GetName(), err)
+	}
+
+	return &pb.UnstructuredObject{
+		GroupVersionKind: &pb.GroupVersionKind{
+			Group:   obj.GroupVersionKind().Group,
+			Version: obj.GroupVersionKind().Version,
+			Kind:    obj.GetKind(),
+		},
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Uid:       string(obj.GetUID()),
+		Status:    res.Status.String(),
+		Message:   res.Message,
+	}, nil
+}
+
+// toUnstructured converts a typed flux object to an unstructured object with its GroupVersionKind set.
+func toUnstructured(obj client.Object, scheme *runtime.Scheme) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u, nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %s to unstructured: %w", obj.GetName(), err)
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+
+	return u, nil
+}
+
+// listReconciledObjects lists the objects of the given kinds applied by a Kustomization or a HelmRelease.
+func listReconciledObjects(ctx context.Context, cl client.Client, kind pb.AutomationKind, name, namespace string, kinds []*pb.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	var opts client.MatchingLabels
+
+	switch kind {
+	case pb.AutomationKind_Kustomize:
+		opts = client.MatchingLabels{
+			KustomizeNameKey:      name,
+			KustomizeNamespaceKey: namespace,
+		}
+	case pb.AutomationKind_Helm:
+		opts = client.MatchingLabels{
+			HelmNameKey:      name,
+			HelmNamespaceKey: namespace,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported application kind: %s", kind.String())
+	}
+
+	result := []unstructured.Unstructured{}
+
+	for _, gvk := range kinds {
+		list := unstructured.UnstructuredList{}
+
+		list.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   gvk.Group,
+			Kind:    gvk.Kind,
+			Version: gvk.Version,
+		})
+
+		if err := cl.List(ctx, &list, opts); err != nil {
+			return nil, fmt.Errorf("could not get unstructured list: %w", err)
+		}
+
+		result = append(result, list.Items...)
+	}
+
+	return result, nil
+}
+
+// unknownHealth is the health of an application whose objects cannot be listed.
+func unknownHealth() *pb.ApplicationHealth {
+	return &pb.ApplicationHealth{
+		Status:  status.UnknownStatus.String(),
+		Message: "the health of the application could not be assessed",
+	}
+}
+
+// applicationHealth aggregates the kstatus of the objects of an application. The application is as healthy
+// as its least healthy object, whose status message explains the health of the application.
+func applicationHealth(objects []*unstructured.Unstructured) (*pb.ApplicationHealth, error) {
+	health := &pb.ApplicationHealth{
+		Status:  status.CurrentStatus.String(),
+		Message: fmt.Sprintf("%d objects are current", len(objects)),
+	}
+	severity := healthSeverity[status.CurrentStatus]
+
+	for _, obj := range objects {
+		res, err := status.Compute(obj)
+		if err != nil {
+			return nil, fmt.Errorf("could not get status for %s: %w", obj.GetName(), err)
+		}
+
+		if healthSeverity[res.Status] > severity {
+			severity = healthSeverity[res.Status]
+			health = &pb.ApplicationHealth{
+				Status:  res.Status.String(),
+				Message: fmt.Sprintf("%s %s/%s: %s", obj.GetKind(), obj.GetNamespace(), obj.GetName(), res.Message),
+			}
+		}
+	}
+
+	return health, nil
+}
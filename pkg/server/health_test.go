@@ -0,0 +1,103 @@
+package server
+
+import (
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func healthTestDeployment(name string, replicas, available int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":       name,
+			"namespace":  "default",
+			"generation": int64(1),
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(1),
+			"replicas":           replicas,
+			"updatedReplicas":    replicas,
+			"readyReplicas":      available,
+			"availableReplicas":  available,
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "True"},
+				map[string]interface{}{"type": "Progressing", "status": "True", "reason": "NewReplicaSetAvailable"},
+			},
+		},
+	}}
+}
+
+var _ = Describe("mapUnstructuredObject", func() {
+	It("sets the kstatus and the message of the object", func() {
+		obj, err := mapUnstructuredObject(healthTestDeployment("podinfo", 2, 1))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(obj.GroupVersionKind).To(Equal(&pb.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}))
+		Expect(obj.Status).To(Equal("InProgress"))
+		Expect(obj.Message).To(Equal("Available: 1/2"))
+	})
+})
+
+var _ = Describe("applicationHealth", func() {
+	It("is current when all the objects are current", func() {
+		health, err := applicationHealth([]*unstructured.Unstructured{
+			healthTestDeployment("podinfo", 2, 2),
+			healthTestDeployment("redis", 1, 1),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(health.Status).To(Equal("Current"))
+		Expect(health.Message).To(Equal("2 objects are current"))
+	})
+
+	It("takes the status of the least healthy object", func() {
+		kustomization := &kustomizev2.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "wego-system", Generation: 1},
+			Status: kustomizev2.KustomizationStatus{
+				ObservedGeneration: 1,
+				Conditions: []metav1.Condition{{
+					Type:    meta.ReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  "ReconciliationFailed",
+					Message: "kustomize build failed",
+				}},
+			},
+		}
+
+		u, err := toUnstructured(kustomization, kube.CreateScheme())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(u.GetKind()).To(Equal("Kustomization"))
+
+		health, err := applicationHealth([]*unstructured.Unstructured{
+			healthTestDeployment("podinfo", 2, 2),
+			u,
+			healthTestDeployment("redis", 2, 1),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(health.Status).To(Equal("InProgress"))
+		Expect(health.Message).To(Equal("Kustomization wego-system/podinfo: kustomize build failed"))
+	})
+
+	It("reports terminating objects", func() {
+		deleted := healthTestDeployment("podinfo", 1, 1)
+		now := metav1.Now()
+		deleted.SetDeletionTimestamp(&now)
+
+		health, err := applicationHealth([]*unstructured.Unstructured{healthTestDeployment("redis", 1, 1), deleted})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(health.Status).To(Equal("Terminating"))
+		Expect(health.Message).To(HavePrefix("Deployment default/podinfo: "))
+	})
+})
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Impersonation", func() {
+	var (
+		ctx       context.Context
+		adminCl   client.Client
+		allowedNs string
+		deniedNs  string
+		appsSrv   pb.ApplicationsServer
+		appName   = "my-app"
+		userName  = "oidc:alice@example.com"
+		groupName = "oidc:team-a"
+		otherUser = "oidc:mallory@example.com"
+	)
+
+	createNamespaceWithApp := func() string {
+		ns := &corev1.Namespace{}
+		ns.Name = "kube-test-" + rand.String(5)
+		Expect(adminCl.Create(ctx, ns)).To(Succeed())
+
+		app := &wego.Application{ObjectMeta: metav1.ObjectMeta{Name: appName, Namespace: ns.Name}}
+		Expect(adminCl.Create(ctx, app)).To(Succeed())
+
+		return ns.Name
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme := kube.CreateScheme()
+		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+
+		var err error
+		adminCl, err = client.New(env.Rest, client.Options{Scheme: scheme})
+		Expect(err).NotTo(HaveOccurred())
+
+		allowedNs = createNamespaceWithApp()
+		deniedNs = createNamespaceWithApp()
+
+		role := &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{Name: "apps-reader", Namespace: allowedNs},
+			Rules: []rbacv1.PolicyRule{{
+				APIGroups: []string{wego.GroupVersion.Group},
+				Resources: []string{"apps"},
+				Verbs:     []string{"get", "list"},
+			}},
+		}
+		Expect(adminCl.Create(ctx, role)).To(Succeed())
+
+		binding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "apps-reader", Namespace: allowedNs},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: role.Name},
+			Subjects: []rbacv1.Subject{
+				{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: userName},
+				{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: groupName},
+			},
+		}
+		Expect(adminCl.Create(ctx, binding)).To(Succeed())
+
+		cfg := ApplicationsConfig{
+			Logger:         testutils.MakeFakeLogr(),
+			FetcherFactory: NewDefaultFetcherFactory(),
+			ClusterConfig:  kube.ClusterConfig{DefaultConfig: env.Rest, ClusterName: testClustername},
+		}
+		appsSrv = NewApplicationsServer(&cfg)
+	})
+
+	It("lists the applications the user is allowed to see", func() {
+		userCtx := auth.WithPrincipal(ctx, &auth.UserPrincipal{ID: userName})
+
+		res, err := appsSrv.ListApplications(userCtx, &pb.ListApplicationsRequest{Namespace: allowedNs})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Applications).To(HaveLen(1))
+
+		_, err = appsSrv.ListApplications(userCtx, &pb.ListApplicationsRequest{Namespace: deniedNs})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("impersonates the groups of the user", func() {
+		userCtx := auth.WithPrincipal(ctx, &auth.UserPrincipal{ID: otherUser, Groups: []string{groupName}})
+
+		res, err := appsSrv.ListApplications(userCtx, &pb.ListApplicationsRequest{Namespace: allowedNs})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Applications).To(HaveLen(1))
+	})
+
+	It("denies users without a binding", func() {
+		userCtx := auth.WithPrincipal(ctx, &auth.UserPrincipal{ID: otherUser})
+
+		_, err := appsSrv.GetApplication(userCtx, &pb.GetApplicationRequest{Name: appName, Namespace: allowedNs})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("denies listing the objects of an application the user cannot read", func() {
+		userCtx := auth.WithPrincipal(ctx, &auth.UserPrincipal{ID: userName})
+
+		_, err := appsSrv.GetReconciledObjects(userCtx, &pb.GetReconciledObjectsReq{
+			AutomationName:      appName,
+			AutomationNamespace: allowedNs,
+			AutomationKind:      pb.AutomationKind_Kustomize,
+			Kinds:               []*pb.GroupVersionKind{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+		})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+		_, err = appsSrv.GetChildObjects(userCtx, &pb.GetChildObjectsReq{
+			GroupVersionKind: &pb.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+			ParentUid:        "some-uid",
+		})
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	})
+
+	It("uses the credentials of the server without a principal", func() {
+		res, err := appsSrv.ListApplications(ctx, &pb.ListApplicationsRequest{Namespace: deniedNs})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Applications).To(HaveLen(1))
+	})
+})
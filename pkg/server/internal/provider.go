@@ -16,7 +16,8 @@ func NewGitProviderClient(token string) gitproviders.Client {
 	}
 }
 
-// GetProvider returns a GitProvider passing the auth token into the implementation
+// GetProvider returns a GitProvider passing the auth token into the implementation.
+// Its API calls are counted in the metrics of the server.
 func (c *gitProviderClient) GetProvider(repoUrl gitproviders.RepoURL, getAccountType gitproviders.AccountTypeGetter) (gitproviders.GitProvider, error) {
 	provider, err := gitproviders.New(gitproviders.Config{
 		Provider: repoUrl.Provider(),
@@ -27,5 +28,10 @@ func (c *gitProviderClient) GetProvider(repoUrl gitproviders.RepoURL, getAccount
 		return nil, fmt.Errorf("error creating git provider client: %w", err)
 	}
 
-	return provider, nil
+	return gitproviders.NewMetricsProvider(provider), nil
+}
+
+// GetToken returns the auth token of the client.
+func (c *gitProviderClient) GetToken(repoUrl gitproviders.RepoURL) (string, error) {
+	return c.token, nil
 }
@@ -39,6 +39,6 @@ var _ = Describe("Get git provider", func() {
 
 		Expect(err).To(BeNil())
 		expectedProvider, _ := gitproviders.New(gitproviders.Config{Provider: repoUrl.Provider(), Token: fakeToken}, repoUrl.Owner(), fakeAccountGetterSuccess)
-		Expect(provider).To(Equal(expectedProvider))
+		Expect(provider).To(Equal(gitproviders.NewMetricsProvider(expectedProvider)))
 	})
 })
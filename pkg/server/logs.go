@@ -0,0 +1,64 @@
+package server
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+)
+
+// StreamApplicationLogs streams the logs of the pods of the workloads an application reconciled, until all the
+// logs are sent or, when following them, until the stream ends. The pods are resolved and their logs are read
+// with the credentials of the caller.
+func (s *applicationServer) StreamApplicationLogs(msg *pb.StreamApplicationLogsRequest, stream pb.Applications_StreamApplicationLogsServer) error {
+	ctx := stream.Context()
+
+	if _, err := labels.Parse(msg.LabelSelector); err != nil {
+		return grpcStatus.Errorf(codes.InvalidArgument, "invalid label selector %q: %s", msg.LabelSelector, err)
+	}
+
+	cluster, err := s.cluster(ctx, msg.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := cluster.KubeGetter.Kube(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		return mapKubeError(fmt.Errorf("could not get application %q: %w", msg.Name, err))
+	}
+
+	pods, err := applicationv2.ApplicationPods(ctx, kubeClient.Raw(), app, msg.LabelSelector)
+	if err != nil {
+		return fmt.Errorf("could not find the pods of application %q: %w", app.Name, err)
+	}
+
+	clientset, err := cluster.ClientsetGetter.Clientset(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := applicationv2.LogsOptions{
+		Container:    msg.Container,
+		SinceSeconds: msg.SinceSeconds,
+		Follow:       msg.Follow,
+	}
+
+	return applicationv2.StreamLogs(ctx, clientset, pods, opts, func(line applicationv2.LogLine) error {
+		return stream.Send(&pb.StreamApplicationLogsResponse{
+			Namespace: line.Namespace,
+			Pod:       line.Pod,
+			Container: line.Container,
+			Line:      line.Line,
+		})
+	})
+}
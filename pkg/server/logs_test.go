@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+)
+
+type fakeLogsStream struct {
+	grpc.ServerStream
+	ctx   context.Context
+	lines []*pb.StreamApplicationLogsResponse
+}
+
+func (s *fakeLogsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeLogsStream) Send(line *pb.StreamApplicationLogsResponse) error {
+	s.lines = append(s.lines, line)
+	return nil
+}
+
+var _ = Describe("StreamApplicationLogs", func() {
+	var (
+		logsSrv pb.ApplicationsServer
+		stream  *fakeLogsStream
+	)
+
+	BeforeEach(func() {
+		app := &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+
+		k8s := fakeclient.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			app,
+			&kustomizev2.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+				Status: kustomizev2.KustomizationStatus{
+					Inventory: &kustomizev2.ResourceInventory{
+						Entries: []kustomizev2.ResourceRef{{ID: "default_podinfo__Pod", Version: "v1"}},
+					},
+				},
+			},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "default"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "podinfo"}}},
+			},
+		).Build()
+
+		kubeClient := &kubefakes.FakeKube{}
+		kubeClient.GetApplicationReturns(app, nil)
+		kubeClient.RawReturns(k8s)
+
+		logsSrv = NewApplicationsServer(&ApplicationsConfig{},
+			WithKubeGetter(kubefakes.NewFakeKubeGetter(kubeClient)),
+			WithClientsetGetter(kubefakes.NewFakeClientsetGetter(fake.NewSimpleClientset())),
+		)
+
+		stream = &fakeLogsStream{ctx: context.Background()}
+	})
+
+	It("streams the logs of the application pods", func() {
+		err := logsSrv.StreamApplicationLogs(&pb.StreamApplicationLogsRequest{Name: "podinfo", Namespace: wego.DefaultNamespace}, stream)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(stream.lines).To(HaveLen(1))
+		Expect(stream.lines[0].Pod).To(Equal("podinfo"))
+		Expect(stream.lines[0].Container).To(Equal("podinfo"))
+		Expect(stream.lines[0].Line).To(Equal("fake logs"))
+	})
+
+	It("rejects invalid label selectors", func() {
+		err := logsSrv.StreamApplicationLogs(&pb.StreamApplicationLogsRequest{Name: "podinfo", LabelSelector: "app in podinfo"}, stream)
+		Expect(err).To(MatchGRPCError(codes.InvalidArgument, errors.New(`invalid label selector "app in podinfo": unable to parse requirement: found 'podinfo' expected: '('`)))
+	})
+})
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	clientmetrics "k8s.io/client-go/tools/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// UnknownRPCMethod is the method label of requests that are not routed to an RPC,
+// e.g. the ones that fail routing or are served by a custom handler.
+const UnknownRPCMethod = "unknown"
+
+var (
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gitops",
+		Subsystem: "server",
+		Name:      "requests_total",
+		Help:      "Number of API requests by RPC method and gRPC status code.",
+	}, []string{"method", "code"})
+
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gitops",
+		Subsystem: "server",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of API requests by RPC method and gRPC status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	kubeRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gitops",
+		Subsystem: "kube_client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of Kubernetes API requests by verb and host.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verb", "host"})
+)
+
+func init() {
+	// All the metrics of the server are served from the controller-runtime registry,
+	// next to the Kubernetes client request counts that controller-runtime registers.
+	metrics.Registry.MustRegister(requestsTotal, requestDuration)
+}
+
+var registerKubeClientMetrics sync.Once
+
+// RegisterKubeClientMetrics records the latency of the requests of every Kubernetes
+// client of the process. It is meant to be called once by the server on startup.
+func RegisterKubeClientMetrics() {
+	registerKubeClientMetrics.Do(func() {
+		metrics.Registry.MustRegister(kubeRequestDuration)
+
+		// client-go only accepts the first call to metrics.Register, which controller-runtime
+		// makes without a latency metric, so the latency adapter is set directly.
+		clientmetrics.RequestLatency = kubeLatencyAdapter{metric: kubeRequestDuration}
+	})
+}
+
+// kubeLatencyAdapter records the latency of Kubernetes client requests. Paths are left
+// out of the labels as they include the names of objects.
+type kubeLatencyAdapter struct {
+	metric *prometheus.HistogramVec
+}
+
+func (a kubeLatencyAdapter) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
+	a.metric.WithLabelValues(verb, u.Host).Observe(latency.Seconds())
+}
+
+type requestMetrics struct {
+	method string
+	code   codes.Code
+}
+
+type metricsKey struct{}
+
+// WithMetrics records the count and latency of API requests. The RPC method is
+// recorded by the WithRPCMethodMetrics option of the ServeMux and the status
+// code by its error handler, see WithGrpcErrorLogging.
+func WithMetrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		m := &requestMetrics{method: UnknownRPCMethod, code: codes.OK}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), metricsKey{}, m)))
+
+		requestsTotal.WithLabelValues(m.method, m.code.String()).Inc()
+		requestDuration.WithLabelValues(m.method, m.code.String()).Observe(time.Since(start).Seconds())
+	})
+}
+
+// WithRPCMethodMetrics records the RPC method a request is routed to for WithMetrics.
+// The method is only known once the grpc-gateway has annotated the context of the
+// request, which is when the metadata annotators are called.
+func WithRPCMethodMetrics() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		if m, ok := ctx.Value(metricsKey{}).(*requestMetrics); ok {
+			if method, ok := runtime.RPCMethod(ctx); ok {
+				m.method = method
+			}
+		}
+
+		return nil
+	})
+}
+
+func recordErrorCode(ctx context.Context, err error) {
+	if m, ok := ctx.Value(metricsKey{}).(*requestMetrics); ok {
+		m.code = status.Code(err)
+	}
+}
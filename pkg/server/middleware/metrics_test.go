@@ -0,0 +1,98 @@
+package middleware_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// scrapeMetrics returns the metrics of the server in the text exposition format.
+func scrapeMetrics() string {
+	res := httptest.NewRecorder()
+	promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}).ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, err := ioutil.ReadAll(res.Body)
+	Expect(err).NotTo(HaveOccurred())
+
+	return string(body)
+}
+
+var _ = Describe("WithMetrics", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(testutils.MakeFakeLogr()), middleware.WithRPCMethodMetrics())
+
+		Expect(mux.HandlePath(http.MethodGet, "/v1/things/{name}", func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+			ctx, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, "/metrics_test.v1.Things/GetThing")
+			Expect(err).NotTo(HaveOccurred())
+
+			if params["name"] != "found" {
+				_, marshaler := runtime.MarshalerForRequest(mux, r)
+				runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.NotFound, "not found"))
+
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		})).To(Succeed())
+
+		handler = middleware.WithMetrics(mux)
+	})
+
+	It("records the RPC method and status code of requests", func() {
+		for _, path := range []string{"/v1/things/found", "/v1/things/found", "/v1/things/missing"} {
+			req := httptest.NewRequest(http.MethodGet, path, nil).WithContext(context.Background())
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		metricsText := scrapeMetrics()
+		Expect(metricsText).To(ContainSubstring(`gitops_server_requests_total{code="OK",method="/metrics_test.v1.Things/GetThing"} 2`))
+		Expect(metricsText).To(ContainSubstring(`gitops_server_requests_total{code="NotFound",method="/metrics_test.v1.Things/GetThing"} 1`))
+		Expect(metricsText).To(ContainSubstring(`gitops_server_request_duration_seconds_count{code="OK",method="/metrics_test.v1.Things/GetThing"} 2`))
+	})
+
+	It("records requests that are not routed to an RPC as unknown", func() {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v1/other", nil))
+
+		Expect(scrapeMetrics()).To(ContainSubstring(`gitops_server_requests_total{code="NotFound",method="unknown"}`))
+	})
+})
+
+var _ = Describe("Kubernetes client metrics", func() {
+	It("records the latency of Kubernetes API requests", func() {
+		middleware.RegisterKubeClientMetrics()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		clientset, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = clientset.CoreV1().Namespaces().Get(context.Background(), "default", metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+
+		u, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scrapeMetrics()).To(ContainSubstring(fmt.Sprintf(`gitops_kube_client_request_duration_seconds_count{host=%q,verb="GET"} 1`, u.Host)))
+	})
+})
@@ -25,6 +25,13 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush lets streaming responses, such as watches, reach the client through the recorder.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 var RequestOkText = "request success"
 var RequestErrorText = "request error"
 var ServerErrorText = "server error"
@@ -37,6 +44,7 @@ var ServerErrorText = "server error"
 func WithGrpcErrorLogging(log logr.Logger) runtime.ServeMuxOption {
 	return runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 		log.Error(err, ServerErrorText)
+		recordErrorCode(r.Context(), err)
 		// We don't want to change the behavior of error handling, just intercept for logging.
 		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
 	})
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/manifests"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+type staticTokenSource struct {
+	token     string
+	expiresAt time.Time
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	return s.token, s.expiresAt, nil
+}
+
+var _ = Describe("Service account RBAC", func() {
+	var (
+		ctx       context.Context
+		adminCl   client.Client
+		saCl      client.Client
+		namespace string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		scheme := kube.CreateScheme()
+		Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+
+		var err error
+		adminCl, err = client.New(env.Rest, client.Options{Scheme: scheme})
+		Expect(err).NotTo(HaveOccurred())
+
+		ns := &corev1.Namespace{}
+		ns.Name = "kube-test-" + rand.String(5)
+		Expect(adminCl.Create(ctx, ns)).To(Succeed())
+		namespace = ns.Name
+
+		// Apply the RBAC of the wego-app manifests. The cluster wide objects are named after the
+		// namespace, so that the ones of the other tests do not grant anything
+		wegoManifests, err := manifests.GenerateWegoAppManifests(manifests.Params{Namespace: namespace})
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, m := range wegoManifests {
+			meta := &metav1.TypeMeta{}
+			Expect(yaml.Unmarshal(m, meta)).To(Succeed())
+
+			var obj client.Object
+
+			switch meta.Kind {
+			case "Role":
+				obj = &rbacv1.Role{}
+			case "RoleBinding":
+				obj = &rbacv1.RoleBinding{}
+			case "ClusterRole":
+				obj = &rbacv1.ClusterRole{}
+			case "ClusterRoleBinding":
+				obj = &rbacv1.ClusterRoleBinding{}
+			default:
+				continue
+			}
+
+			Expect(yaml.Unmarshal(m, obj)).To(Succeed())
+
+			switch o := obj.(type) {
+			case *rbacv1.ClusterRole:
+				o.Name = o.Name + "-" + namespace
+			case *rbacv1.ClusterRoleBinding:
+				o.Name = o.Name + "-" + namespace
+				o.RoleRef.Name = o.RoleRef.Name + "-" + namespace
+			}
+
+			Expect(adminCl.Create(ctx, obj)).To(Succeed())
+		}
+
+		cfg := rest.CopyConfig(env.Rest)
+		cfg.Impersonate = rest.ImpersonationConfig{
+			UserName: "system:serviceaccount:" + namespace + ":wego-app-service-account",
+			Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace},
+		}
+
+		saCl, err = client.New(cfg, client.Options{Scheme: scheme})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("allows refreshing the GitHub App tokens of all namespaces", func() {
+		other := &corev1.Namespace{}
+		other.Name = "kube-test-" + rand.String(5)
+		Expect(adminCl.Create(ctx, other)).To(Succeed())
+
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "wego-github-my-repo",
+				Namespace:   other.Name,
+				Labels:      map[string]string{auth.GitAuthLabel: string(gitproviders.GitAuthGitHubApp)},
+				Annotations: map[string]string{auth.TokenExpiresAtAnnotation: time.Now().Format(time.RFC3339)},
+			},
+			Data: map[string][]byte{"username": []byte("x-access-token"), "password": []byte("old")},
+		}
+		Expect(adminCl.Create(ctx, secret)).To(Succeed())
+
+		expiresAt := time.Now().Add(time.Hour)
+		sources := func(host string) (auth.GitTokenSource, error) {
+			return staticTokenSource{token: "new", expiresAt: expiresAt}, nil
+		}
+
+		Expect(auth.RefreshGitCredentials(ctx, saCl, metav1.NamespaceAll, sources)).To(Succeed())
+
+		Expect(adminCl.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		Expect(string(secret.Data["password"])).To(Equal("new"))
+	})
+})
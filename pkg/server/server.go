@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/benbjohnson/clock"
@@ -21,18 +22,20 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
-	"sigs.k8s.io/cli-utils/pkg/kstatus/status"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/osys"
@@ -64,14 +67,18 @@ var (
 type applicationServer struct {
 	pb.UnimplementedApplicationsServer
 
-	factory        services.Factory
-	jwtClient      auth.JWTClient
-	log            logr.Logger
-	ghAuthClient   auth.GithubAuthClient
-	fetcherFactory applicationv2.FetcherFactory
-	glAuthClient   auth.GitlabAuthClient
-	clientGetter   kube.ClientGetter
-	kubeGetter     kube.KubeGetter
+	factory         services.Factory
+	jwtClient       auth.JWTClient
+	log             logr.Logger
+	ghAuthClient    auth.GithubAuthClient
+	fetcherFactory  applicationv2.FetcherFactory
+	glAuthClient    auth.GitlabAuthClient
+	clientGetter    kube.ClientGetter
+	kubeGetter      kube.KubeGetter
+	informersGetter kube.InformersGetter
+	clientsetGetter kube.ClientsetGetter
+	clustersGetter  ClustersGetter
+	clusterName     string
 }
 
 // An ApplicationsConfig allows for the customization of an ApplicationsServer.
@@ -108,25 +115,38 @@ func NewApplicationsServer(cfg *ApplicationsConfig, setters ...ApplicationsOptio
 	configGetter := NewImpersonatingConfigGetter(cfg.ClusterConfig.DefaultConfig, false)
 	clientGetter := kube.NewDefaultClientGetter(configGetter, cfg.ClusterConfig.ClusterName)
 	kubeGetter := kube.NewDefaultKubeGetter(configGetter, cfg.ClusterConfig.ClusterName)
+	informersGetter := kube.NewDefaultInformersGetter(configGetter)
+	clientsetGetter := kube.NewDefaultClientsetGetter(configGetter)
 
 	args := &ApplicationsOptions{
-		ClientGetter: clientGetter,
-		KubeGetter:   kubeGetter,
+		ClientGetter:    clientGetter,
+		KubeGetter:      kubeGetter,
+		InformersGetter: informersGetter,
+		ClientsetGetter: clientsetGetter,
 	}
 
 	for _, setter := range setters {
 		setter(args)
 	}
 
+	log := cfg.Logger
+	if log == nil {
+		log = logr.Discard()
+	}
+
 	return &applicationServer{
-		jwtClient:      cfg.JwtClient,
-		log:            cfg.Logger,
-		factory:        cfg.Factory,
-		ghAuthClient:   cfg.GithubAuthClient,
-		fetcherFactory: cfg.FetcherFactory,
-		glAuthClient:   cfg.GitlabAuthClient,
-		clientGetter:   args.ClientGetter,
-		kubeGetter:     args.KubeGetter,
+		jwtClient:       cfg.JwtClient,
+		log:             log,
+		factory:         cfg.Factory,
+		ghAuthClient:    cfg.GithubAuthClient,
+		fetcherFactory:  cfg.FetcherFactory,
+		glAuthClient:    cfg.GitlabAuthClient,
+		clientGetter:    args.ClientGetter,
+		kubeGetter:      args.KubeGetter,
+		informersGetter: args.InformersGetter,
+		clientsetGetter: args.ClientsetGetter,
+		clustersGetter:  args.ClustersGetter,
+		clusterName:     cfg.ClusterConfig.ClusterName,
 	}
 }
 
@@ -154,7 +174,7 @@ func DefaultApplicationsConfig() (*ApplicationsConfig, error) {
 		return nil, fmt.Errorf("could not create client config: %w", err)
 	}
 
-	fluxClient := flux.New(osys.New(), &runner.CLIRunner{})
+	fluxClient := flux.NewClient(osys.New(), &runner.CLIRunner{})
 
 	return &ApplicationsConfig{
 		Logger:           logr,
@@ -171,52 +191,150 @@ func DefaultApplicationsConfig() (*ApplicationsConfig, error) {
 }
 
 func (s *applicationServer) ListApplications(ctx context.Context, msg *pb.ListApplicationsRequest) (*pb.ListApplicationsResponse, error) {
-	rawClient, err := s.clientGetter.Client(ctx)
+	if !msg.AllClusters {
+		cluster, err := s.cluster(ctx, msg.ClusterName)
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := s.listClusterApplications(ctx, cluster, msg.Namespace)
+		if err != nil {
+			return nil, mapKubeError(err)
+		}
+
+		return &pb.ListApplicationsResponse{
+			Applications: list,
+		}, nil
+	}
+
+	clusters := []Cluster{s.defaultCluster()}
+
+	if s.clustersGetter != nil {
+		others, err := s.clustersGetter.Clusters(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get clusters: %w", err)
+		}
+
+		clusters = append(clusters, others...)
+	}
+
+	// Query the clusters concurrently, so that a slow or unreachable cluster
+	// does not hold up or fail the others.
+	lists := make([][]*pb.Application, len(clusters))
+	errs := make([]error, len(clusters))
+
+	var wg sync.WaitGroup
+
+	for i := range clusters {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			lists[i], errs[i] = s.listClusterApplications(ctx, clusters[i], msg.Namespace)
+		}(i)
+	}
+
+	wg.Wait()
+
+	res := &pb.ListApplicationsResponse{
+		Applications: []*pb.Application{},
+		Errors:       []*pb.ClusterError{},
+	}
+
+	for i, cluster := range clusters {
+		if errs[i] != nil {
+			res.Errors = append(res.Errors, &pb.ClusterError{ClusterName: cluster.Name, Message: errs[i].Error()})
+			continue
+		}
+
+		res.Applications = append(res.Applications, lists[i]...)
+	}
+
+	return res, nil
+}
+
+func (s *applicationServer) listClusterApplications(ctx context.Context, cluster Cluster, namespace string) ([]*pb.Application, error) {
+	if cluster.Err != nil {
+		return nil, cluster.Err
+	}
+
+	rawClient, err := cluster.ClientGetter.Client(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	fetcher := s.fetcherFactory.Create(rawClient)
 
-	apps, err := fetcher.List(ctx, msg.Namespace)
+	apps, err := fetcher.List(ctx, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	if apps == nil {
-		return &pb.ListApplicationsResponse{
-			Applications: []*pb.Application{},
-		}, nil
-	}
-
 	list := []*pb.Application{}
 	for _, a := range apps {
-		list = append(list, &pb.Application{Name: a.Name})
+		list = append(list, &pb.Application{Name: a.Name, ClusterName: cluster.Name})
 	}
 
-	return &pb.ListApplicationsResponse{
-		Applications: list,
-	}, nil
+	return list, nil
+}
+
+// defaultCluster returns the cluster the server runs in.
+func (s *applicationServer) defaultCluster() Cluster {
+	return Cluster{
+		Name:            s.clusterName,
+		ClientGetter:    s.clientGetter,
+		KubeGetter:      s.kubeGetter,
+		ClientsetGetter: s.clientsetGetter,
+	}
+}
+
+// cluster returns the cluster called name, or the cluster the server runs in
+// when name is empty.
+func (s *applicationServer) cluster(ctx context.Context, name string) (Cluster, error) {
+	if name == "" || name == s.clusterName {
+		return s.defaultCluster(), nil
+	}
+
+	if s.clustersGetter != nil {
+		clusters, err := s.clustersGetter.Clusters(ctx)
+		if err != nil {
+			return Cluster{}, fmt.Errorf("could not get clusters: %w", err)
+		}
+
+		for _, c := range clusters {
+			if c.Name == name {
+				if c.Err != nil {
+					return Cluster{}, grpcStatus.Errorf(codes.FailedPrecondition, "cluster %q: %s", name, c.Err.Error())
+				}
+
+				return c, nil
+			}
+		}
+	}
+
+	return Cluster{}, grpcStatus.Errorf(codes.NotFound, "cluster %q not found", name)
 }
 
 func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetApplicationRequest) (*pb.GetApplicationResponse, error) {
-	kubeClient, err := s.kubeGetter.Kube(ctx)
+	cluster, err := s.cluster(ctx, msg.ClusterName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create kube service: %w", err)
+		return nil, err
 	}
 
-	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	kubeClient, err := cluster.KubeGetter.Kube(ctx)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil, grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
-		}
+		return nil, mapKubeError(fmt.Errorf("failed to create kube service: %w", err))
+	}
 
-		return nil, fmt.Errorf("could not get application %q: %w", msg.Name, err)
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		return nil, mapKubeError(fmt.Errorf("could not get application %q: %w", msg.Name, err))
 	}
 
-	src, deployment, err := findFluxObjects(app)
+	src, deployment, err := applicationv2.FluxObjects(app)
 	if err != nil {
-		return nil, fmt.Errorf("could not get flux objects for application %q: %w", app.Name, err)
+		return nil, mapKubeError(fmt.Errorf("could not get flux objects for application %q: %w", app.Name, err))
 	}
 
 	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
@@ -226,7 +344,7 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 			return nil, nil
 		}
 
-		return nil, fmt.Errorf("could not get source for app %s: %w", app.Name, err)
+		return nil, mapKubeError(fmt.Errorf("could not get source for app %s: %w", app.Name, err))
 	}
 
 	if err := kubeClient.GetResource(ctx, name, deployment); err != nil {
@@ -234,7 +352,7 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 			return nil, nil
 		}
 
-		return nil, fmt.Errorf("could not get deployment for app %s: %w", app.Name, err)
+		return nil, mapKubeError(fmt.Errorf("could not get deployment for app %s: %w", app.Name, err))
 	}
 
 	var (
@@ -253,20 +371,27 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 			reconciledKinds, err = getKustomizeInventory(at)
 
 			if err != nil {
-				return nil, err
+				return nil, mapKubeError(fmt.Errorf("could not get reconciled objects of app %s: %w", app.Name, err))
 			}
 		case *helmv2.HelmRelease:
 			helmRelease = at
 			deploymentType = pb.AutomationKind_Helm
-			reconciledKinds, err = getHelmInventory(at, kubeClient)
+			reconciledKinds, err = getHelmInventory(ctx, at, kubeClient)
 
 			if err != nil {
-				return nil, err
+				return nil, mapKubeError(fmt.Errorf("could not get reconciled objects of app %s: %w", app.Name, err))
 			}
 		}
 	}
 
-	return &pb.GetApplicationResponse{Application: &pb.Application{
+	health, err := s.applicationHealth(ctx, cluster, kubeClient, src, deployment, deploymentType, reconciledKinds)
+	if err != nil {
+		s.log.Error(err, "could not assess health of application", "name", app.Name, "namespace", app.Namespace)
+
+		health = unknownHealth()
+	}
+
+	return &pb.GetApplicationResponse{Health: health, Application: &pb.Application{
 		Name:                  app.Name,
 		Namespace:             app.Namespace,
 		Url:                   app.Spec.URL,
@@ -276,9 +401,40 @@ func (s *applicationServer) GetApplication(ctx context.Context, msg *pb.GetAppli
 		HelmRelease:           mapHelmReleaseSpecToResponse(helmRelease),
 		Source:                mapSourceSpecToReponse(src),
 		ReconciledObjectKinds: reconciledKinds,
+		ClusterName:           cluster.Name,
 	}}, nil
 }
 
+// applicationHealth aggregates the kstatus of the source and deployment of an application and of the objects they reconciled.
+func (s *applicationServer) applicationHealth(ctx context.Context, cluster Cluster, kubeClient kube.Kube, src, deployment client.Object, deploymentType pb.AutomationKind, reconciledKinds []*pb.GroupVersionKind) (*pb.ApplicationHealth, error) {
+	objects := []*unstructured.Unstructured{}
+
+	for _, obj := range []client.Object{src, deployment} {
+		u, err := toUnstructured(obj, kubeClient.Raw().Scheme())
+		if err != nil {
+			return nil, err
+		}
+
+		objects = append(objects, u)
+	}
+
+	cl, err := cluster.ClientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciled, err := listReconciledObjects(ctx, cl, deploymentType, deployment.GetName(), deployment.GetNamespace(), reconciledKinds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range reconciled {
+		objects = append(objects, &reconciled[i])
+	}
+
+	return applicationHealth(objects)
+}
+
 func (s *applicationServer) AddApplication(ctx context.Context, msg *pb.AddApplicationRequest) (*pb.AddApplicationResponse, error) {
 	token, err := middleware.ExtractProviderToken(ctx)
 	if err != nil {
@@ -358,7 +514,7 @@ func (s *applicationServer) RemoveApplication(ctx context.Context, msg *pb.Remov
 
 	application, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
 	if err != nil {
-		return nil, fmt.Errorf("could not get application %q: %w", msg.Name, err)
+		return nil, mapKubeError(fmt.Errorf("could not get application %q: %w", msg.Name, err))
 	}
 
 	appSrv, err := s.factory.GetAppService(ctx, kubeClient)
@@ -407,7 +563,7 @@ func (s *applicationServer) SyncApplication(ctx context.Context, msg *pb.SyncApp
 	if err := appSrv.Sync(app.SyncParams{Name: msg.Name, Namespace: msg.Namespace}); err != nil {
 		return &pb.SyncApplicationResponse{
 			Success: false,
-		}, fmt.Errorf("error syncing app: %w", err)
+		}, mapKubeError(fmt.Errorf("error syncing app: %w", err))
 	}
 
 	return &pb.SyncApplicationResponse{
@@ -415,6 +571,154 @@ func (s *applicationServer) SyncApplication(ctx context.Context, msg *pb.SyncApp
 	}, nil
 }
 
+func (s *applicationServer) SuspendApplication(ctx context.Context, msg *pb.SuspendApplicationRequest) (*pb.SuspendApplicationResponse, error) {
+	changed, err := s.setSuspended(ctx, msg.ClusterName, msg.Name, msg.Namespace, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SuspendApplicationResponse{Success: true, Suspended: changed}, nil
+}
+
+func (s *applicationServer) ResumeApplication(ctx context.Context, msg *pb.ResumeApplicationRequest) (*pb.ResumeApplicationResponse, error) {
+	changed, err := s.setSuspended(ctx, msg.ClusterName, msg.Name, msg.Namespace, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResumeApplicationResponse{Success: true, Resumed: changed}, nil
+}
+
+// setSuspended suspends or resumes an application, and reports whether its state changed.
+func (s *applicationServer) setSuspended(ctx context.Context, clusterName, name, namespace string, suspend bool) (bool, error) {
+	cluster, err := s.cluster(ctx, clusterName)
+	if err != nil {
+		return false, err
+	}
+
+	kubeClient, err := cluster.KubeGetter.Kube(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: name, Namespace: namespace})
+	if err != nil {
+		return false, mapKubeError(fmt.Errorf("could not get application %q: %w", name, err))
+	}
+
+	changed, err := applicationv2.SetSuspended(ctx, kubeClient.Raw(), app, suspend)
+	if err != nil {
+		return false, mapKubeError(err)
+	}
+
+	return changed, nil
+}
+
+func (s *applicationServer) RollbackApplication(ctx context.Context, msg *pb.RollbackApplicationRequest) (*pb.RollbackApplicationResponse, error) {
+	if msg.Revision == "" {
+		return nil, grpcStatus.Error(codes.InvalidArgument, "revision is required")
+	}
+
+	token, err := middleware.ExtractProviderToken(ctx)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "token error: %s", err.Error())
+	}
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	application, err := s.getApplicationForUpdate(ctx, kubeClient, msg.Name, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	appSrv, gitClient, gitProvider, err := s.getAppServices(ctx, kubeClient, token.AccessToken, application)
+	if err != nil {
+		return nil, err
+	}
+
+	params := app.RollbackParams{
+		Name:             msg.Name,
+		Namespace:        msg.Namespace,
+		Revision:         msg.Revision,
+		GitProviderToken: token.AccessToken,
+		AutoMerge:        msg.AutoMerge,
+	}
+
+	if err := appSrv.Rollback(gitClient, gitProvider, params); err != nil {
+		return nil, fmt.Errorf("error rolling back app: %w", err)
+	}
+
+	return &pb.RollbackApplicationResponse{Success: true, RepoUrl: application.Spec.ConfigRepo}, nil
+}
+
+func (s *applicationServer) UnpinApplication(ctx context.Context, msg *pb.UnpinApplicationRequest) (*pb.UnpinApplicationResponse, error) {
+	token, err := middleware.ExtractProviderToken(ctx)
+	if err != nil {
+		return nil, grpcStatus.Errorf(codes.Unauthenticated, "token error: %s", err.Error())
+	}
+
+	kubeClient, err := s.kubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	application, err := s.getApplicationForUpdate(ctx, kubeClient, msg.Name, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	appSrv, gitClient, gitProvider, err := s.getAppServices(ctx, kubeClient, token.AccessToken, application)
+	if err != nil {
+		return nil, err
+	}
+
+	params := app.UnpinParams{
+		Name:             msg.Name,
+		Namespace:        msg.Namespace,
+		GitProviderToken: token.AccessToken,
+		AutoMerge:        msg.AutoMerge,
+	}
+
+	if err := appSrv.Unpin(gitClient, gitProvider, params); err != nil {
+		return nil, fmt.Errorf("error unpinning app: %w", err)
+	}
+
+	return &pb.UnpinApplicationResponse{Success: true, RepoUrl: application.Spec.ConfigRepo}, nil
+}
+
+func (s *applicationServer) getApplicationForUpdate(ctx context.Context, kubeClient kube.Kube, name, namespace string) (*wego.Application, error) {
+	application, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: name, Namespace: namespace})
+	if err != nil {
+		return nil, mapKubeError(fmt.Errorf("could not get application %q: %w", name, err))
+	}
+
+	return application, nil
+}
+
+// getAppServices returns the app service and the git clients of the config repository of an application.
+func (s *applicationServer) getAppServices(ctx context.Context, kubeClient kube.Kube, token string, application *wego.Application) (app.AppService, git.Git, gitproviders.GitProvider, error) {
+	appSrv, err := s.factory.GetAppService(ctx, kubeClient)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("could not create app service: %w", err)
+	}
+
+	client := internal.NewGitProviderClient(token)
+
+	gitClient, gitProvider, err := s.factory.GetGitClients(ctx, kubeClient, client, services.GitConfigParams{
+		URL:        application.Spec.URL,
+		ConfigRepo: application.Spec.ConfigRepo,
+		Namespace:  application.Namespace,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get git clients: %w", err)
+	}
+
+	return appSrv, gitClient, gitProvider, nil
+}
+
 //Until the middleware is done this function will not be able to get the token and will fail
 func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommitsRequest) (*pb.ListCommitsResponse, error) {
 	providerToken, err := middleware.ExtractProviderToken(ctx)
@@ -471,17 +775,26 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 		return nil, err
 	}
 
+	// Commits are still listed when the trusted keys cannot be loaded, they are just not verified.
+	verifier, err := auth.CommitVerifier(ctx, cl, msg.Namespace)
+	if err != nil {
+		s.log.Error(err, "failed to load the trusted commit signing keys", "namespace", msg.Namespace)
+	}
+
 	list := []*pb.Commit{}
 
 	for _, commit := range commits {
 		c := commit.Get()
+		payload, signature := gitproviders.CommitSignature(commit)
 
 		list = append(list, &pb.Commit{
-			Author:  c.Author,
-			Message: utils.CleanCommitMessage(c.Message),
-			Hash:    utils.ConvertCommitHashToShort(c.Sha),
-			Date:    utils.CleanCommitCreatedAt(c.CreatedAt),
-			Url:     utils.ConvertCommitURLToShort(c.URL),
+			Author:   c.Author,
+			Message:  utils.CleanCommitMessage(c.Message),
+			Hash:     utils.ConvertCommitHashToShort(c.Sha),
+			Date:     utils.CleanCommitCreatedAt(c.CreatedAt),
+			Url:      utils.ConvertCommitURLToShort(c.URL),
+			Signed:   signature != "",
+			Verified: verifier != nil && verifier.Verify(payload, signature) == nil,
 		})
 	}
 
@@ -494,73 +807,42 @@ func (s *applicationServer) ListCommits(ctx context.Context, msg *pb.ListCommits
 }
 
 func (s *applicationServer) GetReconciledObjects(ctx context.Context, msg *pb.GetReconciledObjectsReq) (*pb.GetReconciledObjectsRes, error) {
-	cl, err := s.clientGetter.Client(ctx)
+	cluster, err := s.cluster(ctx, msg.ClusterName)
 	if err != nil {
 		return nil, err
 	}
 
-	var opts client.MatchingLabels
-
-	switch msg.AutomationKind {
-	case pb.AutomationKind_Kustomize:
-		opts = client.MatchingLabels{
-			KustomizeNameKey:      msg.AutomationName,
-			KustomizeNamespaceKey: msg.AutomationNamespace,
-		}
-	case pb.AutomationKind_Helm:
-		opts = client.MatchingLabels{
-			HelmNameKey:      msg.AutomationName,
-			HelmNamespaceKey: msg.AutomationNamespace,
-		}
-	default:
-		return nil, fmt.Errorf("unsupported application kind: %s", msg.AutomationKind.String())
+	cl, err := cluster.ClientGetter.Client(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	result := []unstructured.Unstructured{}
-
-	for _, gvk := range msg.Kinds {
-		list := unstructured.UnstructuredList{}
-
-		list.SetGroupVersionKind(schema.GroupVersionKind{
-			Group:   gvk.Group,
-			Kind:    gvk.Kind,
-			Version: gvk.Version,
-		})
-
-		if err := cl.List(ctx, &list, opts); err != nil {
-			return nil, fmt.Errorf("could not get unstructured list: %s", err)
-		}
-
-		result = append(result, list.Items...)
+	result, err := listReconciledObjects(ctx, cl, msg.AutomationKind, msg.AutomationName, msg.AutomationNamespace, msg.Kinds)
+	if err != nil {
+		return nil, mapKubeError(err)
 	}
 
 	objects := []*pb.UnstructuredObject{}
 
-	for _, obj := range result {
-		res, err := status.Compute(&obj)
-
+	for i := range result {
+		obj, err := mapUnstructuredObject(&result[i])
 		if err != nil {
-			return nil, fmt.Errorf("could not get status for %s: %w", obj.GetName(), err)
+			return nil, err
 		}
 
-		objects = append(objects, &pb.UnstructuredObject{
-			GroupVersionKind: &pb.GroupVersionKind{
-				Group:   obj.GetObjectKind().GroupVersionKind().Group,
-				Version: obj.GetObjectKind().GroupVersionKind().GroupVersion().Version,
-				Kind:    obj.GetKind(),
-			},
-			Name:      obj.GetName(),
-			Namespace: obj.GetNamespace(),
-			Status:    res.Status.String(),
-			Uid:       string(obj.GetUID()),
-		})
+		objects = append(objects, obj)
 	}
 
 	return &pb.GetReconciledObjectsRes{Objects: objects}, nil
 }
 
 func (s *applicationServer) GetChildObjects(ctx context.Context, msg *pb.GetChildObjectsReq) (*pb.GetChildObjectsRes, error) {
-	cl, err := s.clientGetter.Client(ctx)
+	cluster, err := s.cluster(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	cl, err := cluster.ClientGetter.Client(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -574,7 +856,7 @@ func (s *applicationServer) GetChildObjects(ctx context.Context, msg *pb.GetChil
 	})
 
 	if err := cl.List(ctx, &list); err != nil {
-		return nil, fmt.Errorf("could not get unstructured object: %s", err)
+		return nil, mapKubeError(fmt.Errorf("could not get unstructured object: %w", err))
 	}
 
 	objects := []*pb.UnstructuredObject{}
@@ -593,26 +875,70 @@ Items:
 			}
 		}
 
-		statusResult, err := status.Compute(&obj)
+		child, err := mapUnstructuredObject(&obj)
 		if err != nil {
-			return nil, fmt.Errorf("could not get status for %s: %w", obj.GetName(), err)
+			return nil, err
 		}
-		objects = append(objects, &pb.UnstructuredObject{
-			GroupVersionKind: &pb.GroupVersionKind{
-				Group:   obj.GetObjectKind().GroupVersionKind().Group,
-				Version: obj.GetObjectKind().GroupVersionKind().GroupVersion().Version,
-				Kind:    obj.GetKind(),
-			},
-			Name:      obj.GetName(),
-			Namespace: obj.GetNamespace(),
-			Status:    statusResult.Status.String(),
-			Uid:       string(obj.GetUID()),
-		})
+
+		objects = append(objects, child)
 	}
 
 	return &pb.GetChildObjectsRes{Objects: objects}, nil
 }
 
+func (s *applicationServer) ListApplicationEvents(ctx context.Context, msg *pb.ListApplicationEventsRequest) (*pb.ListApplicationEventsResponse, error) {
+	if msg.Type != "" && msg.Type != corev1.EventTypeNormal && msg.Type != corev1.EventTypeWarning {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "invalid event type %q", msg.Type)
+	}
+
+	cluster, err := s.cluster(ctx, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := cluster.KubeGetter.Kube(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kube service: %w", err)
+	}
+
+	app, err := kubeClient.GetApplication(ctx, types.NamespacedName{Name: msg.Name, Namespace: msg.Namespace})
+	if err != nil {
+		return nil, mapKubeError(fmt.Errorf("could not get application %q: %w", msg.Name, err))
+	}
+
+	events, err := applicationv2.ListEvents(ctx, kubeClient.Raw(), app, msg.Type)
+	if err != nil {
+		return nil, mapKubeError(fmt.Errorf("could not list events of application %q: %w", app.Name, err))
+	}
+
+	res := &pb.ListApplicationEventsResponse{Events: []*pb.Event{}}
+
+	for _, event := range events {
+		res.Events = append(res.Events, &pb.Event{
+			Type:           event.Type,
+			Reason:         event.Reason,
+			Message:        event.Message,
+			Kind:           event.InvolvedObject.Kind,
+			Name:           event.InvolvedObject.Name,
+			Namespace:      event.InvolvedObject.Namespace,
+			Count:          event.Count,
+			FirstTimestamp: formatEventTime(event.FirstTimestamp.Time),
+			LastTimestamp:  formatEventTime(applicationv2.EventTime(event)),
+			Source:         event.Source.Component,
+		})
+	}
+
+	return res, nil
+}
+
+func formatEventTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
 func (s *applicationServer) GetGithubDeviceCode(ctx context.Context, msg *pb.GetGithubDeviceCodeRequest) (*pb.GetGithubDeviceCodeResponse, error) {
 	res, err := s.ghAuthClient.GetDeviceCode()
 	if err != nil {
@@ -646,8 +972,10 @@ func (s *applicationServer) GetGithubAuthStatus(ctx context.Context, msg *pb.Get
 // Authenticate generates and returns a jwt token using git provider name and git provider token
 func (s *applicationServer) Authenticate(_ context.Context, msg *pb.AuthenticateRequest) (*pb.AuthenticateResponse, error) {
 	if !strings.HasPrefix(github.DefaultDomain, msg.ProviderName) &&
-		!strings.HasPrefix(gitlab.DefaultDomain, msg.ProviderName) {
-		return nil, grpcStatus.Errorf(codes.InvalidArgument, "%s expected github or gitlab, got %s", ErrBadProvider, msg.ProviderName)
+		!strings.HasPrefix(gitlab.DefaultDomain, msg.ProviderName) &&
+		msg.ProviderName != string(gitproviders.GitProviderBitbucketServer) &&
+		msg.ProviderName != string(gitproviders.GitProviderGitea) {
+		return nil, grpcStatus.Errorf(codes.InvalidArgument, "%s expected github, gitlab, bitbucket-server or gitea, got %s", ErrBadProvider, msg.ProviderName)
 	}
 
 	if msg.AccessToken == "" {
@@ -718,6 +1046,19 @@ func (s *applicationServer) ValidateProviderToken(ctx context.Context, msg *pb.V
 	}, nil
 }
 
+// mapKubeError reports missing objects and requests the caller is not allowed to make
+// with the matching gRPC codes. Other errors are returned unchanged.
+func mapKubeError(err error) error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return grpcStatus.Errorf(codes.NotFound, "not found: %s", err.Error())
+	case apierrors.IsForbidden(err):
+		return grpcStatus.Errorf(codes.PermissionDenied, "forbidden: %s", err.Error())
+	}
+
+	return err
+}
+
 func mapHelmReleaseSpecToResponse(helm *helmv2.HelmRelease) *pb.HelmRelease {
 	if helm == nil {
 		return nil
@@ -733,9 +1074,40 @@ func mapHelmReleaseSpecToResponse(helm *helmv2.HelmRelease) *pb.HelmRelease {
 			Version:     helm.Spec.Chart.Spec.Version,
 			ValuesFiles: helm.Spec.Chart.Spec.ValuesFiles,
 		},
+		Values:     helmValuesToYaml(helm),
+		ValuesFrom: mapHelmValuesFrom(helm.Spec.ValuesFrom),
 	}
 }
 
+func helmValuesToYaml(helm *helmv2.HelmRelease) string {
+	if helm.Spec.Values == nil {
+		return ""
+	}
+
+	values, err := yaml.JSONToYAML(helm.Spec.Values.Raw)
+	if err != nil {
+		return ""
+	}
+
+	return string(values)
+}
+
+func mapHelmValuesFrom(refs []helmv2.ValuesReference) []*pb.HelmValuesReference {
+	out := []*pb.HelmValuesReference{}
+
+	for _, ref := range refs {
+		out = append(out, &pb.HelmValuesReference{
+			Kind:       ref.Kind,
+			Name:       ref.Name,
+			ValuesKey:  ref.ValuesKey,
+			TargetPath: ref.TargetPath,
+			Optional:   ref.Optional,
+		})
+	}
+
+	return out
+}
+
 func mapKustomizationSpecToResponse(kust *kustomizev2.Kustomization) *pb.Kustomization {
 	if kust == nil {
 		return nil
@@ -792,54 +1164,44 @@ func mapSourceSpecToReponse(src client.Object) *pb.Source {
 		}
 
 		source.Conditions = mapConditions(st.Status.Conditions)
-	}
-
-	return source
-}
-
-// Returns k8s objects that can be used to find the cluster objects.
-// The first return argument is the source, the second is the deployment
-func findFluxObjects(app *wego.Application) (client.Object, client.Object, error) {
-	st := app.Spec.SourceType
-	if st == "" {
-		// Apps that were created before the SourceType field exists will not have a SourceType defined.
-		// Assume git, since thats what the CLI defaults to.
-		st = wego.SourceTypeGit
-	}
-
-	var src client.Object
+	case *sourcev1.Bucket:
+		source.Name = st.Name
+		source.Namespace = st.Namespace
+		source.Url = bucketURL(st)
+		source.Type = pb.Source_Bucket
+		source.Interval = st.Spec.Interval.Duration.String()
+		source.Suspend = st.Spec.Suspend
 
-	switch st {
-	case wego.SourceTypeGit:
-		src = &sourcev1.GitRepository{}
-	case wego.SourceTypeHelm:
-		src = &sourcev1.HelmRepository{}
-	}
+		if st.Spec.Timeout != nil {
+			source.Timeout = st.Spec.Timeout.Duration.String()
+		}
 
-	if src == nil {
-		return nil, nil, fmt.Errorf("invalid source type %q", st)
-	}
+		source.Conditions = mapConditions(st.Status.Conditions)
+	case *unstructured.Unstructured:
+		// OCIRepositories are the only sources read as unstructured objects
+		source.Name = st.GetName()
+		source.Namespace = st.GetNamespace()
+		source.Type = pb.Source_OCI
+		source.Url, _, _ = unstructured.NestedString(st.Object, "spec", "url")
+		source.Reference, _, _ = unstructured.NestedString(st.Object, "spec", "ref", "tag")
+		source.Interval, _, _ = unstructured.NestedString(st.Object, "spec", "interval")
+		source.Timeout, _, _ = unstructured.NestedString(st.Object, "spec", "timeout")
+		source.Suspend, _, _ = unstructured.NestedBool(st.Object, "spec", "suspend")
 
-	at := app.Spec.DeploymentType
-	if at == "" {
-		// Same as above, default to kustomize to match CLI default.
-		at = wego.DeploymentTypeKustomize
+		conditions, _ := applicationv2.UnstructuredConditions(st)
+		source.Conditions = mapConditions(conditions)
 	}
 
-	var deployment client.Object
-
-	switch at {
-	case wego.DeploymentTypeHelm:
-		deployment = &helmv2.HelmRelease{}
-	case wego.DeploymentTypeKustomize:
-		deployment = &kustomizev2.Kustomization{}
-	}
+	return source
+}
 
-	if deployment == nil {
-		return nil, nil, fmt.Errorf("invalid deployment type %q", at)
+func bucketURL(bucket *sourcev1.Bucket) string {
+	scheme := "https"
+	if bucket.Spec.Insecure {
+		scheme = "http"
 	}
 
-	return src, deployment, nil
+	return fmt.Sprintf("%s://%s/%s", scheme, bucket.Spec.Endpoint, bucket.Spec.BucketName)
 }
 
 // Convert k8s conditions to protobuf conditions
@@ -865,6 +1227,10 @@ func toProtoProvider(p gitproviders.GitProviderName) pb.GitProvider {
 		return pb.GitProvider_GitHub
 	case gitproviders.GitProviderGitLab:
 		return pb.GitProvider_GitLab
+	case gitproviders.GitProviderBitbucketServer:
+		return pb.GitProvider_BitbucketServer
+	case gitproviders.GitProviderGitea:
+		return pb.GitProvider_Gitea
 	}
 
 	return pb.GitProvider_Unknown
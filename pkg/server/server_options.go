@@ -5,8 +5,11 @@ import "github.com/weaveworks/weave-gitops/pkg/kube"
 // ApplicationsOptions includes all the options that can be set for an
 // ApplicationsServer.
 type ApplicationsOptions struct {
-	ClientGetter kube.ClientGetter
-	KubeGetter   kube.KubeGetter
+	ClientGetter    kube.ClientGetter
+	KubeGetter      kube.KubeGetter
+	InformersGetter kube.InformersGetter
+	ClientsetGetter kube.ClientsetGetter
+	ClustersGetter  ClustersGetter
 }
 
 // ApplicationsOption defines the signature of a function that can be used
@@ -26,3 +29,25 @@ func WithKubeGetter(kubeGetter kube.KubeGetter) ApplicationsOption {
 		args.KubeGetter = kubeGetter
 	}
 }
+
+// WithInformersGetter allows for setting an InformersGetter.
+func WithInformersGetter(informersGetter kube.InformersGetter) ApplicationsOption {
+	return func(args *ApplicationsOptions) {
+		args.InformersGetter = informersGetter
+	}
+}
+
+// WithClientsetGetter allows for setting a ClientsetGetter.
+func WithClientsetGetter(clientsetGetter kube.ClientsetGetter) ApplicationsOption {
+	return func(args *ApplicationsOptions) {
+		args.ClientsetGetter = clientsetGetter
+	}
+}
+
+// WithClustersGetter allows for setting a ClustersGetter, which makes the
+// applications of other clusters available.
+func WithClustersGetter(clustersGetter ClustersGetter) ApplicationsOption {
+	return func(args *ApplicationsOptions) {
+		args.ClustersGetter = clustersGetter
+	}
+}
@@ -325,6 +325,16 @@ var _ = Describe("ApplicationsServer", func() {
 
 		Expect(err).Should(MatchGRPCError(codes.InvalidArgument, ErrEmptyAccessToken))
 	})
+	Describe("RollbackApplication", func() {
+		It("requires a revision", func() {
+			_, err := appsClient.RollbackApplication(contextWithAuth(context.Background()), &pb.RollbackApplicationRequest{
+				Name:      "my-app",
+				Namespace: namespace.Name,
+			})
+
+			Expect(err).Should(MatchGRPCError(codes.InvalidArgument, errors.New("revision is required")))
+		})
+	})
 	Describe("GetReconciledObjects", func() {
 		It("gets object with a kustomization + git repo configuration", func() {
 			ctx := context.Background()
@@ -775,6 +785,43 @@ ConfigRepo: %s`, namespace.Name, namespace.Name, "ssh://git@github.com/some-org/
 			Expect(res.Commits[0].Url).To(Equal(desired.URL))
 			Expect(res.Commits[0].Message).To(Equal(desired.Message))
 			Expect(res.Commits[0].Hash).To(Equal(desired.Sha))
+			Expect(res.Commits[0].Signed).To(BeFalse())
+			Expect(res.Commits[0].Verified).To(BeFalse())
+		})
+
+		It("lists the commits without verifying them when the trusted keys are invalid", func() {
+			testApp := &wego.Application{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testapp-invalid-key",
+					Namespace: namespace.Name,
+				},
+				Spec: wego.ApplicationSpec{
+					Branch: "main",
+					Path:   "./k8s",
+					URL:    "https://github.com/owner/repo1",
+				},
+			}
+			Expect(k8sClient.Create(context.Background(), testApp)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: auth.TrustedKeysConfigMapName, Namespace: namespace.Name},
+				Data:       map[string]string{auth.TrustedKeysConfigMapKey: "not a key"},
+			}
+			Expect(k8sClient.Create(context.Background(), configMap)).To(Succeed())
+
+			gitProvider.GetCommitsReturns([]gitprovider.Commit{newTestcommit(gitprovider.CommitInfo{
+				URL:     "http://github.com/testrepo/commit/2349898",
+				Message: "my message",
+				Sha:     "2349898",
+			})}, nil)
+
+			res, err := appsClient.ListCommits(contextWithAuth(context.Background()), &pb.ListCommitsRequest{
+				Name:      testApp.Name,
+				Namespace: testApp.Namespace,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Commits).To(HaveLen(1))
+			Expect(res.Commits[0].Verified).To(BeFalse())
 		})
 	})
 
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+)
+
+var _ = Describe("SuspendApplication and ResumeApplication", func() {
+	var (
+		ctx        context.Context
+		suspendSrv pb.ApplicationsServer
+		k8s        client.Client
+		name       types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		name = types.NamespacedName{Name: "podinfo", Namespace: wego.DefaultNamespace}
+
+		app := &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+
+		k8s = fakeclient.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			app,
+			&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+			&sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+		).Build()
+
+		kubeClient := &kubefakes.FakeKube{}
+		kubeClient.GetApplicationReturns(app, nil)
+		kubeClient.RawReturns(k8s)
+
+		suspendSrv = NewApplicationsServer(&ApplicationsConfig{},
+			WithKubeGetter(kubefakes.NewFakeKubeGetter(kubeClient)),
+		)
+	})
+
+	It("suspends and resumes an application", func() {
+		res, err := suspendSrv.SuspendApplication(ctx, &pb.SuspendApplicationRequest{Name: name.Name, Namespace: name.Namespace})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Success).To(BeTrue())
+		Expect(res.Suspended).To(BeTrue())
+
+		kustomization := &kustomizev2.Kustomization{}
+		Expect(k8s.Get(ctx, name, kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Suspend).To(BeTrue())
+
+		res, err = suspendSrv.SuspendApplication(ctx, &pb.SuspendApplicationRequest{Name: name.Name, Namespace: name.Namespace})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Suspended).To(BeFalse())
+
+		resumed, err := suspendSrv.ResumeApplication(ctx, &pb.ResumeApplicationRequest{Name: name.Name, Namespace: name.Namespace})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resumed.Success).To(BeTrue())
+		Expect(resumed.Resumed).To(BeTrue())
+
+		Expect(k8s.Get(ctx, name, kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Suspend).To(BeFalse())
+	})
+
+	It("returns not found when the automation does not exist", func() {
+		Expect(k8s.Delete(ctx, &kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}})).To(Succeed())
+
+		_, err := suspendSrv.SuspendApplication(ctx, &pb.SuspendApplicationRequest{Name: name.Name, Namespace: name.Namespace})
+		Expect(grpcStatus.Code(err)).To(Equal(codes.NotFound))
+	})
+
+	It("returns not found for an unknown cluster", func() {
+		_, err := suspendSrv.ResumeApplication(ctx, &pb.ResumeApplicationRequest{Name: name.Name, Namespace: name.Namespace, ClusterName: "unknown"})
+		Expect(grpcStatus.Code(err)).To(Equal(codes.NotFound))
+	})
+})
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+)
+
+// Watch event types, matching the ones used by the Kubernetes watch API.
+const (
+	WatchEventAdded    = "ADDED"
+	WatchEventModified = "MODIFIED"
+	WatchEventDeleted  = "DELETED"
+)
+
+// watchEventBuffer is the number of events queued for a client before the informers are blocked.
+const watchEventBuffer = 100
+
+// WatchApplications streams changes to Applications and to the Flux sources, Kustomizations and
+// HelmReleases generated for them. Every object that exists when the watch starts is sent as ADDED.
+// The informers run with the credentials of the caller and are shared with the other streams of
+// the same caller, they stop once the last of those streams ends.
+func (s *applicationServer) WatchApplications(msg *pb.WatchApplicationsRequest, stream pb.Applications_WatchApplicationsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	informers, err := s.informersGetter.Informers(ctx, msg.Namespace)
+	if err != nil {
+		return grpcStatus.Errorf(codes.Internal, "could not create informers: %s", err)
+	}
+
+	events := make(chan *pb.WatchApplicationsResponse, watchEventBuffer)
+
+	for _, obj := range []client.Object{
+		&wego.Application{},
+		&sourcev1.GitRepository{},
+		&sourcev1.HelmRepository{},
+		&kustomizev2.Kustomization{},
+		&helmv2.HelmRelease{},
+	} {
+		informer, err := informers.GetInformer(ctx, obj)
+		if err != nil {
+			return grpcStatus.Errorf(codes.Internal, "could not watch %T: %s", obj, err)
+		}
+
+		informer.AddEventHandler(watchEventHandler(ctx, events))
+	}
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- informers.Start(ctx)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if err != nil {
+				return grpcStatus.Errorf(codes.Internal, "watching applications failed: %s", err)
+			}
+
+			// Some implementations return as soon as the informers are started.
+			errs = nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func watchEventHandler(ctx context.Context, events chan<- *pb.WatchApplicationsResponse) toolscache.ResourceEventHandler {
+	send := func(eventType string, obj interface{}) {
+		if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+
+		o, ok := obj.(client.Object)
+		if !ok {
+			return
+		}
+
+		select {
+		case events <- toWatchEvent(eventType, o):
+		case <-ctx.Done():
+		}
+	}
+
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			send(WatchEventAdded, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			// Periodic resyncs deliver updates for objects that did not change
+			oldMeta, oldOk := oldObj.(metav1.Object)
+			newMeta, newOk := newObj.(metav1.Object)
+
+			if oldOk && newOk && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+
+			send(WatchEventModified, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			send(WatchEventDeleted, obj)
+		},
+	}
+}
+
+// toWatchEvent converts an object into a watch event. Objects from informers do not carry their
+// TypeMeta, so the kind is derived from the Go type. Flux objects share the name of their application.
+func toWatchEvent(eventType string, obj client.Object) *pb.WatchApplicationsResponse {
+	event := &pb.WatchApplicationsResponse{
+		Type:        eventType,
+		Name:        obj.GetName(),
+		Namespace:   obj.GetNamespace(),
+		Application: obj.GetName(),
+		Conditions:  []*pb.Condition{},
+	}
+
+	switch o := obj.(type) {
+	case *wego.Application:
+		event.Kind = wego.ApplicationKind
+		event.Conditions = mapConditions(o.Status.Conditions)
+		event.LastAppliedRevision = o.Status.LastAppliedRevision
+		event.Suspended = o.Status.Suspended
+	case *sourcev1.GitRepository:
+		event.Kind = sourcev1.GitRepositoryKind
+		event.Conditions = mapConditions(o.Status.Conditions)
+		event.Suspended = o.Spec.Suspend
+	case *sourcev1.HelmRepository:
+		event.Kind = sourcev1.HelmRepositoryKind
+		event.Conditions = mapConditions(o.Status.Conditions)
+		event.Suspended = o.Spec.Suspend
+	case *kustomizev2.Kustomization:
+		event.Kind = kustomizev2.KustomizationKind
+		event.Conditions = mapConditions(o.Status.Conditions)
+		event.LastAppliedRevision = o.Status.LastAppliedRevision
+		event.Suspended = o.Spec.Suspend
+	case *helmv2.HelmRelease:
+		event.Kind = helmv2.HelmReleaseKind
+		event.Conditions = mapConditions(o.Status.Conditions)
+		event.LastAppliedRevision = o.Status.LastAppliedRevision
+		event.Suspended = o.Spec.Suspend
+	}
+
+	return event
+}
+
+// registerWatchHandler serves WatchApplications over HTTP. The in-process transport of the gateway does not
+// support streaming calls, so this replaces the generated handler. Events are written in the grpc-gateway
+// streaming format, one JSON object per line, or as server-sent events when the client accepts text/event-stream.
+func registerWatchHandler(mux *runtime.ServeMux, srv pb.ApplicationsServer) error {
+	return mux.HandlePath(http.MethodGet, "/v1/applications/watch", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, marshaler := runtime.MarshalerForRequest(mux, r)
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, grpcStatus.Error(codes.Unimplemented, "streaming is not supported by this connection"))
+			return
+		}
+
+		stream := &httpWatchStream{
+			ctx:       r.Context(),
+			w:         w,
+			flusher:   flusher,
+			marshaler: marshaler,
+			sse:       strings.Contains(r.Header.Get("Accept"), "text/event-stream"),
+		}
+
+		err := srv.WatchApplications(&pb.WatchApplicationsRequest{Namespace: r.URL.Query().Get("namespace")}, stream)
+		if err == nil {
+			return
+		}
+
+		if !stream.started {
+			runtime.HTTPError(r.Context(), mux, marshaler, w, r, err)
+			return
+		}
+
+		// Headers have been sent already, report the error in the stream itself
+		_ = stream.write("error", map[string]proto.Message{"error": grpcStatus.Convert(err).Proto()})
+	})
+}
+
+// httpWatchStream implements pb.Applications_WatchApplicationsServer on top of an HTTP response.
+type httpWatchStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	w         http.ResponseWriter
+	flusher   http.Flusher
+	marshaler runtime.Marshaler
+	sse       bool
+	started   bool
+}
+
+func (s *httpWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpWatchStream) Send(event *pb.WatchApplicationsResponse) error {
+	if s.sse {
+		return s.write("", event)
+	}
+
+	return s.write("", map[string]proto.Message{"result": event})
+}
+
+func (s *httpWatchStream) write(sseEvent string, v interface{}) error {
+	if !s.started {
+		contentType := s.marshaler.ContentType(v)
+		if s.sse {
+			contentType = "text/event-stream"
+		}
+
+		s.w.Header().Set("Content-Type", contentType)
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	data, err := s.marshaler.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("could not marshal watch event: %w", err)
+	}
+
+	if s.sse {
+		if sseEvent != "" {
+			if _, err := fmt.Fprintf(s.w, "event: %s\n", sseEvent); err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprintf(s.w, "data: %s\n\n", data)
+	} else {
+		_, err = fmt.Fprintf(s.w, "%s\n", data)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	s.flusher.Flush()
+
+	return nil
+}
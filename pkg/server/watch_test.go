@@ -0,0 +1,176 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/applications"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/kube/kubefakes"
+)
+
+// startedInformers signals when the watch has registered its handlers and started the informers.
+type startedInformers struct {
+	*informertest.FakeInformers
+	started chan struct{}
+}
+
+func (i startedInformers) Start(ctx context.Context) error {
+	close(i.started)
+	return nil
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.WatchApplicationsResponse
+}
+
+func (s fakeWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s fakeWatchStream) Send(event *pb.WatchApplicationsResponse) error {
+	s.events <- event
+	return nil
+}
+
+var _ = Describe("WatchApplications", func() {
+	var (
+		informers startedInformers
+		watchSrv  pb.ApplicationsServer
+		app       *wego.Application
+	)
+
+	BeforeEach(func() {
+		informers = startedInformers{
+			FakeInformers: &informertest.FakeInformers{Scheme: kube.CreateScheme()},
+			started:       make(chan struct{}),
+		}
+		watchSrv = NewApplicationsServer(&ApplicationsConfig{}, WithInformersGetter(kubefakes.NewFakeInformersGetter(informers)))
+
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system", ResourceVersion: "1"},
+			Status: wego.ApplicationStatus{
+				LastAppliedRevision: "main/abc123",
+				Conditions: []metav1.Condition{
+					{Type: wego.ReadyCondition, Status: metav1.ConditionTrue, Reason: "ReconciliationSucceeded"},
+				},
+			},
+		}
+	})
+
+	It("streams changes to applications and flux objects", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream := fakeWatchStream{ctx: ctx, events: make(chan *pb.WatchApplicationsResponse, 10)}
+		done := make(chan error)
+
+		go func() {
+			done <- watchSrv.WatchApplications(&pb.WatchApplicationsRequest{Namespace: "wego-system"}, stream)
+		}()
+
+		Eventually(informers.started).Should(BeClosed())
+
+		appInformer, err := informers.FakeInformerFor(&wego.Application{})
+		Expect(err).NotTo(HaveOccurred())
+		kustInformer, err := informers.FakeInformerFor(&kustomizev2.Kustomization{})
+		Expect(err).NotTo(HaveOccurred())
+
+		appInformer.Add(app)
+
+		var event *pb.WatchApplicationsResponse
+		Eventually(stream.events).Should(Receive(&event))
+		Expect(event.Type).To(Equal(WatchEventAdded))
+		Expect(event.Kind).To(Equal(wego.ApplicationKind))
+		Expect(event.Name).To(Equal("my-app"))
+		Expect(event.LastAppliedRevision).To(Equal("main/abc123"))
+		Expect(event.Conditions).To(HaveLen(1))
+
+		// Resyncs without changes are not sent
+		appInformer.Update(app, app)
+
+		kust := &kustomizev2.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "wego-system", ResourceVersion: "2"},
+			Spec:       kustomizev2.KustomizationSpec{Suspend: true},
+		}
+		oldKust := kust.DeepCopy()
+		oldKust.ResourceVersion = "1"
+		kustInformer.Update(oldKust, kust)
+
+		Eventually(stream.events).Should(Receive(&event))
+		Expect(event.Type).To(Equal(WatchEventModified))
+		Expect(event.Kind).To(Equal(kustomizev2.KustomizationKind))
+		Expect(event.Application).To(Equal("my-app"))
+		Expect(event.Suspended).To(BeTrue())
+
+		appInformer.Delete(app)
+
+		Eventually(stream.events).Should(Receive(&event))
+		Expect(event.Type).To(Equal(WatchEventDeleted))
+		Expect(event.Kind).To(Equal(wego.ApplicationKind))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("serves events over http as server-sent events", func() {
+		mux := runtime.NewServeMux()
+		Expect(registerWatchHandler(mux, watchSrv)).To(Succeed())
+
+		httpSrv := httptest.NewServer(mux)
+		defer httpSrv.Close()
+
+		req, err := http.NewRequest(http.MethodGet, httpSrv.URL+"/v1/applications/watch?namespace=wego-system", nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Accept", "text/event-stream")
+
+		// Headers are only written with the first event
+		responses := make(chan *http.Response)
+
+		go func() {
+			defer GinkgoRecover()
+
+			res, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			responses <- res
+		}()
+
+		Eventually(informers.started).Should(BeClosed())
+
+		appInformer, err := informers.FakeInformerFor(&wego.Application{})
+		Expect(err).NotTo(HaveOccurred())
+		appInformer.Add(app)
+
+		var res *http.Response
+		Eventually(responses).Should(Receive(&res))
+
+		defer res.Body.Close()
+
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		line, err := bufio.NewReader(res.Body).ReadString('\n')
+		Expect(err).NotTo(HaveOccurred())
+		Expect(line).To(HavePrefix("data: "))
+
+		event := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event)).To(Succeed())
+		Expect(event).To(HaveKeyWithValue("type", WatchEventAdded))
+		Expect(event).To(HaveKeyWithValue("name", "my-app"))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
@@ -14,6 +15,8 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"helm.sh/helm/v3/pkg/cli/values"
+	"helm.sh/helm/v3/pkg/getter"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 )
@@ -37,6 +40,14 @@ type AddParams struct {
 	AutoMerge                  bool
 	GitProviderToken           string
 	HelmReleaseTargetNamespace string
+	SourceSecretRef            string
+	HelmValuesFiles            []string
+	HelmSetValues              []string
+	HelmValuesFrom             []string
+	DependsOn                  []string
+	Wait                       bool
+	WaitTimeout                time.Duration
+	GitAuth                    gitproviders.GitAuth
 	MigrateToNewDirStructure   func(string) string
 }
 
@@ -50,6 +61,16 @@ func (a AddParams) IsHelmRepository() bool {
 	return a.Chart != ""
 }
 
+// HasHelmValues returns true when values are given for the Helm release of the application.
+func (a AddParams) HasHelmValues() bool {
+	return len(a.HelmValuesFiles) > 0 || len(a.HelmSetValues) > 0 || len(a.HelmValuesFrom) > 0
+}
+
+// IsArtifactSource returns true when the application is fetched from an OCI repository or a bucket.
+func (a AddParams) IsArtifactSource() bool {
+	return a.SourceType == wego.SourceTypeOCI || a.SourceType == wego.SourceTypeBucket
+}
+
 func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, params AddParams) error {
 	ctx := context.Background()
 
@@ -60,47 +81,93 @@ func (a *AppSvc) Add(configGit git.Git, gitProvider gitproviders.GitProvider, pa
 
 	a.printAddSummary(params)
 
-	if err := kube.IsClusterReady(a.Logger, a.Kube); err != nil {
+	app, clusterName, err := a.prepareApplication(ctx, params)
+	if err != nil {
 		return err
 	}
 
+	if params.DryRun {
+		return nil
+	}
+
+	if err := a.addApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge); err != nil {
+		return err
+	}
+
+	if params.Wait {
+		return a.waitForApplications(ctx, []models.Application{app}, params.WaitTimeout)
+	}
+
+	return nil
+}
+
+// DiffAdd returns the changes adding the application would make to the config repository.
+// Nothing is pushed to the repository or applied to the cluster.
+func (a *AppSvc) DiffAdd(configGit git.Git, gitProvider gitproviders.GitProvider, params AddParams) ([]gitopswriter.FileChange, error) {
+	ctx := context.Background()
+
+	params, err := a.updateParametersIfNecessary(ctx, gitProvider, params)
+	if err != nil {
+		return nil, fmt.Errorf("could not update parameters: %w", err)
+	}
+
+	app, clusterName, err := a.prepareApplication(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).DiffAddApplication(ctx, app, clusterName)
+}
+
+// prepareApplication checks that the cluster is ready and that the application does not exist yet.
+func (a *AppSvc) prepareApplication(ctx context.Context, params AddParams) (models.Application, string, error) {
+	if err := kube.IsClusterReady(a.Logger, a.Kube); err != nil {
+		return models.Application{}, "", err
+	}
+
 	clusterName, err := a.Kube.GetClusterName(ctx)
 	if err != nil {
-		return err
+		return models.Application{}, "", err
+	}
+
+	app, err := a.makeNewApplication(ctx, params)
+	if err != nil {
+		return models.Application{}, "", err
 	}
 
+	return app, clusterName, nil
+}
+
+// makeNewApplication makes the application and checks that it does not exist in the cluster yet.
+func (a *AppSvc) makeNewApplication(ctx context.Context, params AddParams) (models.Application, error) {
 	app, err := makeApplication(params)
 	if err != nil {
-		return err
+		return models.Application{}, err
 	}
 
 	if strings.HasPrefix(params.Name, "wego") {
-		return fmt.Errorf("the prefix 'wego' is used by weave gitops and is not allowed for an app name")
+		return models.Application{}, fmt.Errorf("the prefix 'wego' is used by weave gitops and is not allowed for an app name")
 	}
 
 	appHash := automation.GetAppHash(app)
 
 	wegoapps, err := a.Kube.GetApplications(ctx, params.Namespace)
 	if err != nil {
-		return err
+		return models.Application{}, err
 	}
 
 	for _, wegoapp := range wegoapps {
 		clusterApp, err := automation.WegoAppToApp(wegoapp)
 		if err != nil {
-			return err
+			return models.Application{}, err
 		}
 
 		if appHash == automation.GetAppHash(clusterApp) {
-			return fmt.Errorf("unable to create resource, resource already exists in cluster")
+			return models.Application{}, fmt.Errorf("unable to create resource, resource already exists in cluster")
 		}
 	}
 
-	if params.DryRun {
-		return nil
-	}
-
-	return a.addApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge)
+	return app, nil
 }
 
 func (a *AppSvc) printAddSummary(params AddParams) {
@@ -119,11 +186,38 @@ func (a *AppSvc) printAddSummary(params AddParams) {
 }
 
 func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gitproviders.GitProvider, params AddParams) (AddParams, error) {
-	params.SourceType = wego.SourceTypeGit
+	// The Flux version installed by gitops has no OCIRepository API, and its Kustomizations
+	// can only reference GitRepositories and Buckets.
+	if params.SourceType == wego.SourceTypeOCI || strings.HasPrefix(params.Url, "oci://") {
+		return params, errors.New("oci sources are not supported by the Flux version installed by gitops")
+	}
+
+	// Without --auto-merge the application is only deployed once its pull request is merged
+	if params.Wait && !params.AutoMerge {
+		return params, errors.New("--wait requires --auto-merge, the application is not deployed until its pull request is merged")
+	}
+
+	switch params.SourceType {
+	case "", wego.SourceTypeGit, wego.SourceTypeBucket:
+	case wego.SourceTypeHelm:
+		if params.Chart == "" {
+			return params, errors.New("--chart must be specified for helm sources")
+		}
+	default:
+		return params, fmt.Errorf("unknown source type %q", params.SourceType)
+	}
+
+	if !params.IsArtifactSource() {
+		params.SourceType = wego.SourceTypeGit
+	}
 
 	var appRepoUrl gitproviders.RepoURL
 
 	switch {
+	case params.IsArtifactSource():
+		if err := updateArtifactSourceParameters(&params); err != nil {
+			return params, err
+		}
 	case params.Chart != "":
 		params.SourceType = wego.SourceTypeHelm
 		params.DeploymentType = string(wego.DeploymentTypeHelm)
@@ -186,7 +280,7 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 		params.DeploymentType = DefaultDeploymentType
 	}
 
-	if params.Branch == "" {
+	if params.Branch == "" && !params.IsArtifactSource() {
 		params.Branch = DefaultBranch
 
 		if params.SourceType == wego.SourceTypeGit {
@@ -203,6 +297,20 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 		return params, err
 	}
 
+	for _, name := range params.DependsOn {
+		if err := models.ValidateApplicationName(name); err != nil {
+			return params, fmt.Errorf("invalid dependency: %w", err)
+		}
+
+		if name == params.Name {
+			return params, fmt.Errorf("application %q cannot depend on itself", params.Name)
+		}
+	}
+
+	if params.HasHelmValues() && params.DeploymentType != string(wego.DeploymentTypeHelm) {
+		return params, errors.New("--values, --set and --values-from can only be used with the helm deployment type")
+	}
+
 	// Validate namespace argument for helm
 	if params.HelmReleaseTargetNamespace != "" {
 		if ok, _ := a.Kube.NamespacePresent(context.Background(), params.HelmReleaseTargetNamespace); !ok {
@@ -217,28 +325,86 @@ func (a *AppSvc) updateParametersIfNecessary(ctx context.Context, gitProvider gi
 	return params, nil
 }
 
+// updateArtifactSourceParameters validates the parameters of an application
+// fetched from an OCI repository or a bucket and derives its name from the URL.
+func updateArtifactSourceParameters(params *AddParams) error {
+	if params.Chart != "" {
+		return fmt.Errorf("--chart cannot be used with %s sources", params.SourceType)
+	}
+
+	if params.DeploymentType != "" && params.DeploymentType != string(wego.DeploymentTypeKustomize) {
+		return fmt.Errorf("%s sources only support the kustomize deployment type", params.SourceType)
+	}
+
+	if params.ConfigRepo == "" {
+		return errors.New("--config-repo should be provided")
+	}
+
+	var name string
+
+	if params.SourceType == wego.SourceTypeOCI {
+		source, err := models.ParseOCIURL(params.Url)
+		if err != nil {
+			return err
+		}
+
+		name = source.Name()
+	} else {
+		source, err := models.ParseBucketURL(params.Url)
+		if err != nil {
+			return err
+		}
+
+		name = source.BucketName
+	}
+
+	if params.Name == "" {
+		if err := models.ValidateApplicationName(name); err != nil {
+			return fmt.Errorf("unable to use %q as the application name; please specify name with '--name' :%s", name, err)
+		}
+
+		params.Name = name
+	}
+
+	// resetting Dir param since Url has priority over it
+	params.Dir = ""
+
+	return nil
+}
+
 func (a *AppSvc) addApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterName string, autoMerge bool) error {
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).AddApplication(ctx, app, clusterName, autoMerge)
+}
+
+func (a *AppSvc) newGitOpsDirWriter(configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application) gitopswriter.GitOpsDirectoryWriter {
 	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProvider, configGit, a.Logger)
 	automationGen := automation.NewAutomationGenerator(gitProvider, a.Flux, a.Logger)
-	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger)
 
-	return gitOpsDirWriter.AddApplication(ctx, app, clusterName, autoMerge)
+	return gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger)
 }
 
 func makeApplication(params AddParams) (models.Application, error) {
 	var (
-		gitSourceURL  gitproviders.RepoURL
-		helmSourceURL string
-		err           error
+		gitSourceURL      gitproviders.RepoURL
+		helmSourceURL     string
+		artifactSourceURL string
+		err               error
 	)
 
-	if models.SourceType(params.SourceType) == models.SourceTypeHelm {
+	switch {
+	case models.SourceType(params.SourceType) == models.SourceTypeHelm:
 		helmSourceURL = params.Url
-	} else {
+	case params.IsArtifactSource():
+		artifactSourceURL = params.Url
+	default:
 		gitSourceURL, err = gitproviders.NewRepoURL(params.Url)
 		if err != nil {
 			return models.Application{}, err
 		}
+
+		if params.GitAuth != "" {
+			gitSourceURL = gitSourceURL.WithGitAuth(params.GitAuth)
+		}
 	}
 
 	configRepo := gitSourceURL
@@ -252,6 +418,11 @@ func makeApplication(params AddParams) (models.Application, error) {
 		configRepo = curl
 	}
 
+	helmValues, helmValuesFrom, err := makeHelmValues(params)
+	if err != nil {
+		return models.Application{}, err
+	}
+
 	app := models.Application{
 		Name:                params.Name,
 		Namespace:           params.Namespace,
@@ -263,7 +434,47 @@ func makeApplication(params AddParams) (models.Application, error) {
 		SourceType:          models.SourceType(params.SourceType),
 		AutomationType:      models.AutomationType(params.DeploymentType),
 		HelmTargetNamespace: params.HelmReleaseTargetNamespace,
+		ArtifactSourceURL:   artifactSourceURL,
+		SourceSecretRef:     params.SourceSecretRef,
+		HelmValues:          helmValues,
+		HelmValuesFrom:      helmValuesFrom,
+		DependsOn:           params.DependsOn,
 	}
 
 	return app, nil
 }
+
+// makeHelmValues merges the values files and --set values the same way helm does,
+// and parses the references to ConfigMaps and Secrets holding values.
+func makeHelmValues(params AddParams) (map[string]interface{}, []models.HelmValuesReference, error) {
+	var helmValues map[string]interface{}
+
+	if len(params.HelmValuesFiles) > 0 || len(params.HelmSetValues) > 0 {
+		opts := values.Options{
+			ValueFiles: params.HelmValuesFiles,
+			Values:     params.HelmSetValues,
+		}
+
+		merged, err := opts.MergeValues(getter.Providers{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read helm values: %w", err)
+		}
+
+		if len(merged) > 0 {
+			helmValues = merged
+		}
+	}
+
+	var helmValuesFrom []models.HelmValuesReference
+
+	for _, ref := range params.HelmValuesFrom {
+		valuesRef, err := models.ParseHelmValuesReference(ref)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		helmValuesFrom = append(helmValuesFrom, valuesRef)
+	}
+
+	return helmValues, helmValuesFrom, nil
+}
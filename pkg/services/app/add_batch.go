@@ -0,0 +1,194 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+)
+
+type AddBatchParams struct {
+	Apps        []AddParams
+	DryRun      bool
+	AutoMerge   bool
+	Wait        bool
+	WaitTimeout time.Duration
+}
+
+// AddResult is the outcome of adding one application of a batch. Err is nil when the
+// application is valid, and when it was added unless the batch is a dry run.
+type AddResult struct {
+	Name string
+	Err  error
+}
+
+// ErrInvalidBatch is returned when at least one application of a batch is invalid.
+// Nothing is added to the config repository in that case.
+var ErrInvalidBatch = errors.New("some applications are invalid; no application was added")
+
+// AddBatch validates several applications and, when they are all valid, adds them
+// to the config repository in a single commit or pull request.
+func (a *AppSvc) AddBatch(configGit git.Git, gitProvider gitproviders.GitProvider, params AddBatchParams) ([]AddResult, error) {
+	ctx := context.Background()
+
+	if len(params.Apps) == 0 {
+		return nil, errors.New("no applications to add")
+	}
+
+	if err := kube.IsClusterReady(a.Logger, a.Kube); err != nil {
+		return nil, err
+	}
+
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]AddResult, len(params.Apps))
+	apps := []models.Application{}
+	names := map[string]bool{}
+	valid := true
+
+	for i, appParams := range params.Apps {
+		app, err := a.validateBatchApplication(ctx, gitProvider, appParams, names)
+		results[i] = AddResult{Name: app.Name, Err: err}
+
+		if err != nil {
+			valid = false
+
+			if results[i].Name == "" {
+				results[i].Name = appParams.Url
+			}
+
+			continue
+		}
+
+		if len(apps) > 0 && app.ConfigRepo.String() != apps[0].ConfigRepo.String() {
+			results[i].Err = fmt.Errorf("config repository %s differs from %s; all applications must share a config repository", app.ConfigRepo, apps[0].ConfigRepo)
+			valid = false
+
+			continue
+		}
+
+		apps = append(apps, app)
+	}
+
+	if !valid {
+		return results, ErrInvalidBatch
+	}
+
+	if params.DryRun {
+		return results, nil
+	}
+
+	if err := a.newGitOpsDirWriter(configGit, gitProvider, apps[0]).AddApplications(ctx, apps, clusterName, params.AutoMerge); err != nil {
+		return results, err
+	}
+
+	if params.Wait {
+		return results, a.waitForApplications(ctx, apps, params.WaitTimeout)
+	}
+
+	return results, nil
+}
+
+func (a *AppSvc) validateBatchApplication(ctx context.Context, gitProvider gitproviders.GitProvider, params AddParams, names map[string]bool) (models.Application, error) {
+	params, err := a.updateParametersIfNecessary(ctx, gitProvider, params)
+	if err != nil {
+		return models.Application{Name: params.Name}, fmt.Errorf("could not update parameters: %w", err)
+	}
+
+	if names[params.Name] {
+		return models.Application{Name: params.Name}, fmt.Errorf("application %q is defined more than once", params.Name)
+	}
+
+	names[params.Name] = true
+
+	app, err := a.makeNewApplication(ctx, params)
+	if err != nil {
+		return models.Application{Name: params.Name}, err
+	}
+
+	if app.SourceType == models.SourceTypeGit {
+		exists, err := gitProvider.RepositoryExists(ctx, app.GitSourceURL)
+		if err != nil {
+			return app, fmt.Errorf("could not access repository %s: %w", app.GitSourceURL, err)
+		}
+
+		if !exists {
+			return app, fmt.Errorf("repository %s does not exist or cannot be accessed", app.GitSourceURL)
+		}
+	}
+
+	return app, nil
+}
+
+// ReadAddParams reads the parameters of applications from a stream of Application resources,
+// as YAML or JSON documents. Applications without a namespace are added to the given namespace.
+func ReadAddParams(r io.Reader, namespace string) ([]AddParams, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	params := []AddParams{}
+
+	for {
+		var app wego.Application
+		if err := decoder.Decode(&app); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("failed to decode application: %w", err)
+		}
+
+		if app.Kind == "" && app.Name == "" {
+			// skip empty documents
+			continue
+		}
+
+		if app.Kind != "Application" {
+			return nil, fmt.Errorf("unexpected kind %q, only Application resources can be added", app.Kind)
+		}
+
+		params = append(params, addParamsFromApplication(app, namespace))
+	}
+
+	return params, nil
+}
+
+func addParamsFromApplication(app wego.Application, namespace string) AddParams {
+	params := AddParams{
+		Name:                       app.Name,
+		Namespace:                  app.Namespace,
+		Url:                        app.Spec.URL,
+		Path:                       app.Spec.Path,
+		Branch:                     app.Spec.Branch,
+		ConfigRepo:                 app.Spec.ConfigRepo,
+		DeploymentType:             string(app.Spec.DeploymentType),
+		SourceType:                 app.Spec.SourceType,
+		HelmReleaseTargetNamespace: app.Spec.HelmTargetNamespace,
+		DependsOn:                  app.Spec.DependsOn,
+	}
+
+	if params.Namespace == "" {
+		params.Namespace = namespace
+	}
+
+	if params.Path == "" {
+		params.Path = DefaultPath
+	}
+
+	// The path of a helm source is the name of the chart
+	if app.Spec.SourceType == wego.SourceTypeHelm {
+		params.Chart = app.Spec.Path
+	}
+
+	return params
+}
@@ -0,0 +1,147 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	kustomizetypes "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("ReadAddParams", func() {
+	It("reads the parameters of every Application in the stream", func() {
+		params, err := ReadAddParams(strings.NewReader(`---
+apiVersion: wego.weave.works/v1alpha1
+kind: Application
+metadata:
+  name: podinfo
+spec:
+  url: https://github.com/foo/podinfo
+  branch: main
+  path: ./deploy
+  deployment_type: kustomize
+  source_type: git
+---
+apiVersion: wego.weave.works/v1alpha1
+kind: Application
+metadata:
+  name: loki
+  namespace: monitoring
+spec:
+  url: https://grafana.github.io/helm-charts
+  path: loki
+  deployment_type: helm
+  source_type: helm
+`), wego.DefaultNamespace)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(params).To(HaveLen(2))
+
+		Expect(params[0].Name).To(Equal("podinfo"))
+		Expect(params[0].Namespace).To(Equal(wego.DefaultNamespace))
+		Expect(params[0].Url).To(Equal("https://github.com/foo/podinfo"))
+		Expect(params[0].Path).To(Equal("./deploy"))
+		Expect(params[0].Chart).To(BeEmpty())
+
+		Expect(params[1].Namespace).To(Equal("monitoring"))
+		Expect(params[1].Chart).To(Equal("loki"))
+		Expect(params[1].IsHelmRepository()).To(BeTrue())
+	})
+
+	It("rejects other kinds of resources", func() {
+		_, err := ReadAddParams(strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n"), wego.DefaultNamespace)
+		Expect(err).To(MatchError(`unexpected kind "ConfigMap", only Application resources can be added`))
+	})
+})
+
+var _ = Describe("AddBatch", func() {
+	var batchParams AddBatchParams
+
+	BeforeEach(func() {
+		makeParams := func(name string) AddParams {
+			return AddParams{
+				Name:           name,
+				Url:            "https://github.com/foo/" + name,
+				Path:           "./deploy",
+				Branch:         "main",
+				DeploymentType: "kustomize",
+				Namespace:      wego.DefaultNamespace,
+				ConfigRepo:     "https://github.com/foo/config",
+			}
+		}
+
+		batchParams = AddBatchParams{
+			Apps:      []AddParams{makeParams("podinfo"), makeParams("nginx")},
+			AutoMerge: true,
+		}
+
+		manifestsByPath = map[string][]byte{}
+
+		gitProviders.RepositoryExistsReturns(true, nil)
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitClient.WriteStub = func(path string, manifest []byte) error {
+			manifestsByPath[path] = manifest
+			return nil
+		}
+
+		ctx = context.Background()
+	})
+
+	It("adds every application in a single commit", func() {
+		results, err := appSrv.AddBatch(gitClient, gitProviders, batchParams)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).To(Equal([]AddResult{{Name: "podinfo"}, {Name: "nginx"}}))
+
+		Expect(gitClient.CommitCallCount()).To(Equal(1))
+		Expect(fluxClient.CreateSourceGitCallCount()).To(Equal(2))
+
+		userKustomization := kustomizetypes.Kustomization{}
+		Expect(yaml.Unmarshal(manifestsByPath[filepath.Join(git.WegoRoot, git.WegoClusterDir, "test-cluster", "user", "kustomization.yaml")], &userKustomization)).To(Succeed())
+		Expect(userKustomization.Resources).To(Equal([]string{"../../../apps/podinfo", "../../../apps/nginx"}))
+	})
+
+	It("validates every application and adds none when one is invalid", func() {
+		batchParams.Apps[1].Name = "podinfo"
+		batchParams.Apps = append(batchParams.Apps, batchParams.Apps[0])
+		batchParams.Apps[2].Name = "invalid_name"
+
+		gitProviders.RepositoryExistsStub = func(_ context.Context, repoURL gitproviders.RepoURL) (bool, error) {
+			return !strings.Contains(repoURL.String(), "nginx"), nil
+		}
+
+		results, err := appSrv.AddBatch(gitClient, gitProviders, batchParams)
+		Expect(err).To(Equal(ErrInvalidBatch))
+		Expect(results).To(HaveLen(3))
+
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[1].Err).To(MatchError(`application "podinfo" is defined more than once`))
+		Expect(results[2].Err).To(HaveOccurred())
+
+		Expect(gitClient.CommitCallCount()).To(Equal(0))
+		Expect(gitClient.WriteCallCount()).To(Equal(0))
+	})
+
+	It("reports repositories that cannot be accessed", func() {
+		gitProviders.RepositoryExistsStub = func(_ context.Context, repoURL gitproviders.RepoURL) (bool, error) {
+			return !strings.Contains(repoURL.String(), "nginx"), nil
+		}
+
+		results, err := appSrv.AddBatch(gitClient, gitProviders, batchParams)
+		Expect(err).To(Equal(ErrInvalidBatch))
+		Expect(results[1].Err).To(MatchError(ContainSubstring("does not exist or cannot be accessed")))
+	})
+
+	It("only validates the applications in a dry run", func() {
+		batchParams.DryRun = true
+
+		results, err := appSrv.AddBatch(gitClient, gitProviders, batchParams)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(results).To(HaveLen(2))
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+	})
+})
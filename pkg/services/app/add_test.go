@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,6 +17,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
 	"sigs.k8s.io/yaml"
 )
@@ -227,6 +229,171 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Context("add app from an artifact source", func() {
+		BeforeEach(func() {
+			addParams.SourceType = wego.SourceTypeBucket
+			addParams.Url = "https://minio.example.com/podinfo"
+			addParams.Branch = ""
+			addParams.ConfigRepo = "ssh://git@github.com/owner/config-repo.git"
+		})
+
+		It("names the app after the bucket and keeps the source type", func() {
+			updated, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(updated.Name).To(Equal("podinfo"))
+			Expect(updated.SourceType).To(Equal(wego.SourceTypeBucket))
+			Expect(updated.Branch).To(BeEmpty())
+			Expect(gitProviders.GetDefaultBranchCallCount()).To(Equal(0))
+		})
+
+		It("rejects OCI sources", func() {
+			addParams.SourceType = wego.SourceTypeOCI
+			addParams.Url = "oci://ghcr.io/org/manifests/podinfo:v6.0.0"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("oci sources are not supported by the Flux version installed by gitops"))
+
+			addParams.SourceType = ""
+
+			_, err = appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("oci sources are not supported by the Flux version installed by gitops"))
+		})
+
+		It("rejects waiting for an application that is added with a pull request", func() {
+			addParams.Wait = true
+			addParams.AutoMerge = false
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--wait requires --auto-merge, the application is not deployed until its pull request is merged"))
+
+			addParams.AutoMerge = true
+
+			_, err = appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("requires a config repository", func() {
+			addParams.ConfigRepo = ""
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--config-repo should be provided"))
+		})
+
+		It("only supports the kustomize deployment type", func() {
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("bucket sources only support the kustomize deployment type"))
+		})
+
+		It("rejects invalid source urls", func() {
+			addParams.Url = "https://minio.example.com"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("rejects unknown source types", func() {
+			addParams.SourceType = "svn"
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError(`unknown source type "svn"`))
+		})
+	})
+
+	Context("add app with helm values", func() {
+		BeforeEach(func() {
+			addParams.Url = "https://charts.kube-ops.io"
+			addParams.Chart = "loki"
+			addParams.SourceType = wego.SourceTypeHelm
+			addParams.DeploymentType = string(wego.DeploymentTypeHelm)
+			addParams.ConfigRepo = "ssh://git@github.com/owner/config-repo.git"
+		})
+
+		It("merges values files and --set values and parses the values references", func() {
+			dir, err := ioutil.TempDir("", "values")
+			Expect(err).ShouldNot(HaveOccurred())
+			defer os.RemoveAll(dir)
+
+			first := filepath.Join(dir, "first.yaml")
+			Expect(ioutil.WriteFile(first, []byte("replicas: 1\npersistence:\n  enabled: false\n  size: 1Gi\n"), 0600)).To(Succeed())
+
+			second := filepath.Join(dir, "second.yaml")
+			Expect(ioutil.WriteFile(second, []byte("replicas: 2\n"), 0600)).To(Succeed())
+
+			addParams.HelmValuesFiles = []string{first, second}
+			addParams.HelmSetValues = []string{"persistence.enabled=true"}
+			addParams.HelmValuesFrom = []string{"secret/loki-credentials"}
+
+			app, err := makeApplication(addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(app.HelmValues).To(Equal(map[string]interface{}{
+				"replicas": float64(2),
+				"persistence": map[string]interface{}{
+					"enabled": true,
+					"size":    "1Gi",
+				},
+			}))
+			Expect(app.HelmValuesFrom).To(Equal([]models.HelmValuesReference{{Kind: "Secret", Name: "loki-credentials"}}))
+		})
+
+		It("fails for missing values files", func() {
+			addParams.HelmValuesFiles = []string{"/does/not/exist.yaml"}
+
+			_, err := makeApplication(addParams)
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("only allows values for the helm deployment type", func() {
+			addParams.Chart = ""
+			addParams.SourceType = wego.SourceTypeGit
+			addParams.DeploymentType = string(wego.DeploymentTypeKustomize)
+			addParams.Url = "https://github.com/foo/bar"
+			addParams.HelmSetValues = []string{"replicas=2"}
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError("--values, --set and --values-from can only be used with the helm deployment type"))
+		})
+	})
+
+	Context("add app with dependencies", func() {
+		var addParams AddParams
+
+		BeforeEach(func() {
+			addParams = AddParams{
+				Name:      "podinfo",
+				Url:       "https://github.com/foo/podinfo",
+				Namespace: wego.DefaultNamespace,
+				DependsOn: []string{"cert-manager"},
+			}
+		})
+
+		It("sets the dependencies of the application", func() {
+			updated, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			app, err := makeApplication(updated)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(app.DependsOn).To(Equal([]string{"cert-manager"}))
+		})
+
+		It("rejects invalid application names", func() {
+			addParams.DependsOn = []string{"Cert_Manager"}
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError(HavePrefix("invalid dependency: invalid application name")))
+		})
+
+		It("rejects a dependency on the application itself", func() {
+			addParams.DependsOn = []string{"podinfo"}
+
+			_, err := appSrv.(*AppSvc).updateParametersIfNecessary(ctx, gitProviders, addParams)
+			Expect(err).To(MatchError(`application "podinfo" cannot depend on itself`))
+		})
+	})
+
 	Context("check for default values on AddParameters", func() {
 		It("default values for path and deploymentType and branch should be correct", func() {
 			addParams := AddParams{}
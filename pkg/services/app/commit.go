@@ -22,6 +22,10 @@ func (a *AppSvc) GetCommits(gitProvider gitproviders.GitProvider, params CommitP
 		return nil, fmt.Errorf("unable to get commits for a helm chart")
 	}
 
+	if application.IsArtifactSource() {
+		return nil, fmt.Errorf("unable to get commits for a %s source", application.Spec.SourceType)
+	}
+
 	repoUrl, err := gitproviders.NewRepoURL(application.Spec.URL)
 	if err != nil {
 		return nil, fmt.Errorf("error creating normalized url: %w", err)
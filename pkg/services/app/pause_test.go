@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Pause and Unpause", func() {
+	var k8s client.Client
+
+	BeforeEach(func() {
+		app := &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-namespace"},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+
+		k8s = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-namespace"}},
+			&sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Namespace: "my-namespace"}},
+		).Build()
+
+		kubeClient.GetApplicationReturns(app, nil)
+		kubeClient.RawReturns(k8s)
+	})
+
+	It("suspends the automation of the app", func() {
+		Expect(appSrv.Pause(PauseParams{Name: "my-app", Namespace: "my-namespace"})).To(Succeed())
+
+		kustomization := &kustomizev2.Kustomization{}
+		Expect(k8s.Get(context.Background(), types.NamespacedName{Name: "my-app", Namespace: "my-namespace"}, kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Suspend).To(BeTrue())
+
+		msg, _ := log.PrintfArgsForCall(0)
+		Expect(msg).To(Equal("gitops automation paused for %s\n"))
+	})
+
+	It("does nothing when the app is already reconciling", func() {
+		Expect(appSrv.Unpause(UnpauseParams{Name: "my-app", Namespace: "my-namespace"})).To(Succeed())
+
+		msg, _ := log.PrintfArgsForCall(0)
+		Expect(msg).To(Equal("app %s is already reconciling\n"))
+	})
+})
@@ -8,7 +8,6 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/automation"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
-	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -28,29 +27,48 @@ func (a *AppSvc) Remove(configGit git.Git, gitProvider gitproviders.GitProvider,
 
 	ctx := context.Background()
 
-	clusterName, err := a.Kube.GetClusterName(ctx)
+	app, clusterName, err := a.getExistingApplication(ctx, params.Name, params.Namespace)
 	if err != nil {
 		return err
 	}
 
-	application, err := a.Kube.GetApplication(ctx, types.NamespacedName{Namespace: params.Namespace, Name: params.Name})
+	return a.removeApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge)
+}
+
+// DiffRemove returns the changes removing the application would make to the config repository.
+// Nothing is pushed to the repository or applied to the cluster.
+func (a *AppSvc) DiffRemove(configGit git.Git, gitProvider gitproviders.GitProvider, params RemoveParams) ([]gitopswriter.FileChange, error) {
+	ctx := context.Background()
+
+	app, clusterName, err := a.getExistingApplication(ctx, params.Name, params.Namespace)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).DiffRemoveApplication(ctx, app, clusterName)
+}
+
+// getExistingApplication returns an application on the cluster and the name of the cluster.
+func (a *AppSvc) getExistingApplication(ctx context.Context, name, namespace string) (models.Application, string, error) {
+	clusterName, err := a.Kube.GetClusterName(ctx)
+	if err != nil {
+		return models.Application{}, "", err
+	}
+
+	application, err := a.Kube.GetApplication(ctx, types.NamespacedName{Namespace: namespace, Name: name})
+	if err != nil {
+		return models.Application{}, "", err
 	}
 
 	// Find all resources created when adding this app
 	app, err := automation.WegoAppToApp(*application)
 	if err != nil {
-		return err
+		return models.Application{}, "", err
 	}
 
-	return a.removeApp(ctx, configGit, gitProvider, app, clusterName, params.AutoMerge)
+	return app, clusterName, nil
 }
 
 func (a *AppSvc) removeApp(ctx context.Context, configGit git.Git, gitProvider gitproviders.GitProvider, app models.Application, clusterName string, autoMerge bool) error {
-	repoWriter := gitrepo.NewRepoWriter(app.ConfigRepo, gitProvider, configGit, a.Logger)
-	automationGen := automation.NewAutomationGenerator(gitProvider, a.Flux, a.Logger)
-	gitOpsDirWriter := gitopswriter.NewGitOpsDirectoryWriter(automationGen, repoWriter, a.Osys, a.Logger)
-
-	return gitOpsDirWriter.RemoveApplication(ctx, app, clusterName, autoMerge)
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).RemoveApplication(ctx, app, clusterName, autoMerge)
 }
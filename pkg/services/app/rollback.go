@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+type RollbackParams struct {
+	Name             string
+	Namespace        string
+	Revision         string
+	AutoMerge        bool
+	GitProviderToken string
+}
+
+type UnpinParams struct {
+	Name             string
+	Namespace        string
+	AutoMerge        bool
+	GitProviderToken string
+}
+
+// Rollback pins the GitRepository of an application to a commit or tag in the config repository
+func (a *AppSvc) Rollback(configGit git.Git, gitProvider gitproviders.GitProvider, params RollbackParams) error {
+	ctx := context.Background()
+
+	app, _, err := a.getExistingApplication(ctx, params.Name, params.Namespace)
+	if err != nil {
+		return err
+	}
+
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).PinApplication(ctx, app, params.Revision, params.AutoMerge)
+}
+
+// Unpin makes the GitRepository of an application track the application branch again
+func (a *AppSvc) Unpin(configGit git.Git, gitProvider gitproviders.GitProvider, params UnpinParams) error {
+	ctx := context.Background()
+
+	app, _, err := a.getExistingApplication(ctx, params.Name, params.Namespace)
+	if err != nil {
+		return err
+	}
+
+	return a.newGitOpsDirWriter(configGit, gitProvider, app).UnpinApplication(ctx, app, params.AutoMerge)
+}
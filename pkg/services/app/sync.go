@@ -12,7 +12,9 @@ import (
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
 	"github.com/weaveworks/weave-gitops/pkg/utils"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -54,6 +56,10 @@ func (a *AppSvc) syncSource(ctx context.Context, app *wego.Application) error {
 		source = &sourcev1.GitRepository{}
 	case wego.SourceTypeHelm:
 		source = &sourcev1.HelmRepository{}
+	case wego.SourceTypeBucket:
+		source = &sourcev1.Bucket{}
+	case wego.SourceTypeOCI:
+		source = applicationv2.NewOCIRepository()
 	}
 
 	return a.syncResource(ctx, app, source)
@@ -141,11 +147,18 @@ func (a *AppSvc) checkResourceSync(ctx context.Context, name types.NamespacedNam
 }
 
 func initResourceType(resource kube.Resource) (kube.Resource, error) {
-	switch resource.(type) {
+	switch r := resource.(type) {
 	case *sourcev1.GitRepository:
 		return &sourcev1.GitRepository{}, nil
 	case *sourcev1.HelmRepository:
 		return &sourcev1.HelmRepository{}, nil
+	case *sourcev1.Bucket:
+		return &sourcev1.Bucket{}, nil
+	case *unstructured.Unstructured:
+		updated := &unstructured.Unstructured{}
+		updated.SetGroupVersionKind(r.GroupVersionKind())
+
+		return updated, nil
 	case *kustomizev1.Kustomization:
 		return &kustomizev1.Kustomization{}, nil
 	case *helmv2.HelmRelease:
@@ -161,6 +174,12 @@ func getLastHandledReconcileRequest(resource kube.Resource) (string, error) {
 		return r.Status.GetLastHandledReconcileRequest(), nil
 	case *sourcev1.HelmRepository:
 		return r.Status.GetLastHandledReconcileRequest(), nil
+	case *sourcev1.Bucket:
+		return r.Status.GetLastHandledReconcileRequest(), nil
+	case *unstructured.Unstructured:
+		lastHandled, _, err := unstructured.NestedString(r.Object, "status", "lastHandledReconcileAt")
+
+		return lastHandled, err
 	case *kustomizev1.Kustomization:
 		return r.Status.GetLastHandledReconcileRequest(), nil
 	case *helmv2.HelmRelease:
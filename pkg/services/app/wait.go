@@ -0,0 +1,177 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/fluxcd/pkg/apis/meta"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/applicationv2"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// DefaultWaitTimeout is how long to wait for the resources of an application to be ready by default.
+const DefaultWaitTimeout = 5 * time.Minute
+
+// WaitForReady waits until each of the flux resources reports that it is Ready for its latest generation,
+// logging the progress. Resources that do not exist yet are waited for, as they are created once the
+// changes to the config repository are reconciled. When the timeout is reached, the error has the message
+// of the Ready condition of the first resource that is not ready.
+func WaitForReady(ctx context.Context, kubeClient kube.Kube, appClock clock.Clock, log logger.Logger, timeout time.Duration, resources []kube.Resource) error {
+	scheme := kube.CreateScheme()
+	start := appClock.Now()
+
+	for _, resource := range resources {
+		kind := resource.GetObjectKind().GroupVersionKind().Kind
+		if gvk, err := apiutil.GVKForObject(resource, scheme); err == nil {
+			kind = gvk.Kind
+		}
+
+		name := types.NamespacedName{Name: resource.GetName(), Namespace: resource.GetNamespace()}
+
+		log.Waitingf("Waiting for %s %s to be ready", kind, name)
+
+		message := "not found"
+
+		err := utils.Poll(
+			appClock,
+			k8sPollInterval,
+			timeout-appClock.Since(start),
+			checkResourceReady(ctx, kubeClient, name, resource, &message),
+		)
+		if err != nil {
+			if errors.Is(err, utils.ErrPollTimeout) {
+				return fmt.Errorf("%s %s is not ready after %s: %s", kind, name, timeout, message)
+			}
+
+			return fmt.Errorf("failed waiting for %s %s: %w", kind, name, err)
+		}
+
+		log.Successf("%s %s is ready", kind, name)
+	}
+
+	return nil
+}
+
+// applicationResources returns the source and the Kustomization or HelmRelease of the applications.
+func applicationResources(apps []models.Application) ([]kube.Resource, error) {
+	resources := []kube.Resource{}
+
+	for _, app := range apps {
+		wegoApp := automation.AppToWegoApp(app)
+
+		src, deployment, err := applicationv2.FluxObjects(&wegoApp)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range []kube.Resource{src, deployment} {
+			obj.SetName(app.Name)
+			obj.SetNamespace(app.Namespace)
+			resources = append(resources, obj)
+		}
+	}
+
+	return resources, nil
+}
+
+func (a *AppSvc) waitForApplications(ctx context.Context, apps []models.Application, timeout time.Duration) error {
+	resources, err := applicationResources(apps)
+	if err != nil {
+		return err
+	}
+
+	if timeout == 0 {
+		timeout = DefaultWaitTimeout
+	}
+
+	return WaitForReady(ctx, a.Kube, a.Clock, a.Logger, timeout, resources)
+}
+
+func checkResourceReady(ctx context.Context, kubeClient kube.Kube, name types.NamespacedName, resource kube.Resource, message *string) func() (bool, error) {
+	return func() (bool, error) {
+		updatedResource, err := initResourceType(resource)
+		if err != nil {
+			return false, err
+		}
+
+		if err := kubeClient.GetResource(ctx, name, updatedResource); err != nil {
+			return false, err
+		}
+
+		// GetResource leaves the resource empty when it is not found
+		if updatedResource.GetName() == "" {
+			*message = "not found"
+			return false, nil
+		}
+
+		ready, readyMessage, err := resourceReadiness(updatedResource)
+		if err != nil {
+			return false, err
+		}
+
+		*message = readyMessage
+
+		return ready, nil
+	}
+}
+
+// resourceReadiness returns whether the Ready condition of a flux resource is true for its latest generation,
+// and the message of the condition.
+func resourceReadiness(resource kube.Resource) (bool, string, error) {
+	content, err := resourceContent(resource)
+	if err != nil {
+		return false, "", err
+	}
+
+	observedGeneration, _, err := unstructured.NestedInt64(content, "status", "observedGeneration")
+	if err != nil {
+		return false, "", fmt.Errorf("invalid observed generation: %w", err)
+	}
+
+	if observedGeneration < resource.GetGeneration() {
+		return false, "waiting for the latest changes to be reconciled", nil
+	}
+
+	conditions, _, err := unstructured.NestedSlice(content, "status", "conditions")
+	if err != nil {
+		return false, "", fmt.Errorf("invalid conditions: %w", err)
+	}
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != meta.ReadyCondition {
+			continue
+		}
+
+		message, _ := condition["message"].(string)
+
+		return condition["status"] == string(metav1.ConditionTrue), message, nil
+	}
+
+	return false, "waiting for the Ready condition", nil
+}
+
+func resourceContent(resource kube.Resource) (map[string]interface{}, error) {
+	if u, ok := resource.(*unstructured.Unstructured); ok {
+		return u.Object, nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert %s to unstructured: %w", resource.GetName(), err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func readyCondition(status metav1.ConditionStatus, message string) metav1.Condition {
+	return metav1.Condition{Type: meta.ReadyCondition, Status: status, Reason: "Reason", Message: message}
+}
+
+var _ = Describe("resourceReadiness", func() {
+	It("is ready when the Ready condition is true for the latest generation", func() {
+		repo := &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Generation: 2}}
+		repo.Status.ObservedGeneration = 2
+		repo.Status.Conditions = []metav1.Condition{readyCondition(metav1.ConditionTrue, "Fetched revision: main/abc")}
+
+		ready, message, err := resourceReadiness(repo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeTrue())
+		Expect(message).To(Equal("Fetched revision: main/abc"))
+	})
+
+	It("is not ready when the latest generation is not reconciled yet", func() {
+		repo := &sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Generation: 2}}
+		repo.Status.ObservedGeneration = 1
+		repo.Status.Conditions = []metav1.Condition{readyCondition(metav1.ConditionTrue, "Fetched revision: main/abc")}
+
+		ready, message, err := resourceReadiness(repo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeFalse())
+		Expect(message).To(Equal("waiting for the latest changes to be reconciled"))
+	})
+
+	It("returns the message of a failed Ready condition", func() {
+		kustomization := &kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Generation: 1}}
+		kustomization.Status.ObservedGeneration = 1
+		kustomization.Status.Conditions = []metav1.Condition{readyCondition(metav1.ConditionFalse, "kustomize build failed")}
+
+		ready, message, err := resourceReadiness(kustomization)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeFalse())
+		Expect(message).To(Equal("kustomize build failed"))
+	})
+
+	It("reads the conditions of unstructured resources", func() {
+		u := &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "podinfo", "generation": int64(1)},
+			"status": map[string]interface{}{
+				"observedGeneration": int64(1),
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "True", "message": "stored artifact"},
+				},
+			},
+		}}
+
+		ready, message, err := resourceReadiness(u)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeTrue())
+		Expect(message).To(Equal("stored artifact"))
+	})
+})
+
+var _ = Describe("WaitForReady", func() {
+	var (
+		mockClock *clock.Mock
+		resources []kube.Resource
+		ready     int32
+	)
+
+	BeforeEach(func() {
+		mockClock = clock.NewMock()
+		atomic.StoreInt32(&ready, 0)
+
+		resources = []kube.Resource{
+			&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "my-namespace"}},
+		}
+
+		kubeClient.GetResourceStub = func(ctx context.Context, name types.NamespacedName, resource kube.Resource) error {
+			kustomization := resource.(*kustomizev2.Kustomization)
+			kustomization.Name = name.Name
+			kustomization.Namespace = name.Namespace
+			kustomization.Generation = 1
+			kustomization.Status.ObservedGeneration = 1
+			kustomization.Status.Conditions = []metav1.Condition{readyCondition(metav1.ConditionFalse, "health check failed")}
+
+			if atomic.LoadInt32(&ready) == 1 {
+				kustomization.Status.Conditions = []metav1.Condition{readyCondition(metav1.ConditionTrue, "Applied revision: main/abc")}
+			}
+
+			return nil
+		}
+	})
+
+	wait := func(timeout time.Duration) chan error {
+		done := make(chan error, 1)
+
+		go func() {
+			done <- WaitForReady(context.Background(), kubeClient, mockClock, log, timeout, resources)
+		}()
+
+		return done
+	}
+
+	It("returns once the resources are ready", func() {
+		done := wait(time.Minute)
+
+		Eventually(func() int {
+			mockClock.Add(k8sPollInterval)
+			return kubeClient.GetResourceCallCount()
+		}).Should(BeNumerically(">", 0))
+
+		atomic.StoreInt32(&ready, 1)
+
+		Eventually(func() chan error {
+			mockClock.Add(k8sPollInterval)
+			return done
+		}).Should(Receive(BeNil()))
+
+		msg, args := log.SuccessfArgsForCall(0)
+		Expect(msg).To(Equal("%s %s is ready"))
+		Expect(args).To(Equal([]interface{}{"Kustomization", types.NamespacedName{Name: "podinfo", Namespace: "my-namespace"}}))
+	})
+
+	It("fails with the message of the Ready condition on timeout", func() {
+		done := wait(time.Minute)
+
+		var err error
+
+		Eventually(func() chan error {
+			mockClock.Add(k8sPollInterval)
+			return done
+		}).Should(Receive(&err))
+
+		Expect(err).To(MatchError("Kustomization my-namespace/podinfo is not ready after 1m0s: health check failed"))
+	})
+})
@@ -0,0 +1,166 @@
+package applicationv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// involvedObject identifies an object that events can be about.
+type involvedObject struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// ListEvents returns the events about an application, its source, its Kustomization or HelmRelease and the objects
+// they applied, oldest first. When eventType is not empty, only the events of that type are returned.
+func ListEvents(ctx context.Context, k8s client.Client, app *wego.Application, eventType string) ([]corev1.Event, error) {
+	if eventType != "" && eventType != corev1.EventTypeNormal && eventType != corev1.EventTypeWarning {
+		return nil, fmt.Errorf("invalid event type %q, must be one of %s or %s", eventType, corev1.EventTypeNormal, corev1.EventTypeWarning)
+	}
+
+	involved, err := involvedObjects(ctx, k8s, app)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := map[string]bool{}
+	for obj := range involved {
+		namespaces[obj.Namespace] = true
+	}
+
+	events := []corev1.Event{}
+
+	for namespace := range namespaces {
+		list := &corev1.EventList{}
+		if err := k8s.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("could not list events in namespace %q: %w", namespace, err)
+		}
+
+		for _, event := range list.Items {
+			if eventType != "" && event.Type != eventType {
+				continue
+			}
+
+			ref := event.InvolvedObject
+			if involved[involvedObject{Kind: ref.Kind, Namespace: eventNamespace(ref.Namespace, event.Namespace), Name: ref.Name}] {
+				events = append(events, event)
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return EventTime(events[i]).Before(EventTime(events[j]))
+	})
+
+	return events, nil
+}
+
+// EventTime returns the last time an event was seen.
+func EventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.FirstTimestamp.Time
+	}
+}
+
+func involvedObjects(ctx context.Context, k8s client.Client, app *wego.Application) (map[involvedObject]bool, error) {
+	involved := map[involvedObject]bool{
+		{Kind: wego.ApplicationKind, Namespace: app.Namespace, Name: app.Name}: true,
+	}
+
+	src, deployment, err := FluxObjects(app)
+	if err != nil {
+		return nil, err
+	}
+
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	for _, obj := range []client.Object{src, deployment} {
+		gvk, err := apiutil.GVKForObject(obj, k8s.Scheme())
+		if err != nil {
+			return nil, err
+		}
+
+		involved[involvedObject{Kind: gvk.Kind, Namespace: app.Namespace, Name: app.Name}] = true
+
+		if err := k8s.Get(ctx, name, obj); err != nil {
+			if apierrors.IsNotFound(err) || apimeta.IsNoMatchError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("could not get %s for application %q: %w", gvk.Kind, app.Name, err)
+		}
+
+		if err := addInventory(ctx, k8s, obj, involved); err != nil {
+			return nil, err
+		}
+	}
+
+	return involved, nil
+}
+
+// addInventory adds the objects applied by a Kustomization or a HelmRelease to the involved objects.
+func addInventory(ctx context.Context, k8s client.Client, obj client.Object, involved map[involvedObject]bool) error {
+	switch deployment := obj.(type) {
+	case *kustomizev2.Kustomization:
+		if deployment.Status.Inventory == nil {
+			return nil
+		}
+
+		for _, entry := range deployment.Status.Inventory.Entries {
+			objMeta, err := object.ParseObjMetadata(entry.ID)
+			if err != nil {
+				return fmt.Errorf("invalid inventory item '%s', error: %w", entry.ID, err)
+			}
+
+			involved[involvedObject{Kind: objMeta.GroupKind.Kind, Namespace: eventNamespace(objMeta.Namespace, ""), Name: objMeta.Name}] = true
+		}
+	case *helmv2.HelmRelease:
+		objects, err := HelmReleaseObjects(ctx, k8s, deployment)
+		if err != nil {
+			return err
+		}
+
+		releaseNamespace := deployment.Namespace
+		if deployment.Spec.TargetNamespace != "" {
+			releaseNamespace = deployment.Spec.TargetNamespace
+		}
+
+		for _, o := range objects {
+			involved[involvedObject{Kind: o.GetKind(), Namespace: eventNamespace(o.GetNamespace(), releaseNamespace), Name: o.GetName()}] = true
+		}
+	}
+
+	return nil
+}
+
+// eventNamespace returns the namespace of the events about an object. The events of cluster scoped objects are
+// in the default namespace.
+func eventNamespace(namespace, defaultNamespace string) string {
+	switch {
+	case namespace != "":
+		return namespace
+	case defaultNamespace != "":
+		return defaultNamespace
+	default:
+		return corev1.NamespaceDefault
+	}
+}
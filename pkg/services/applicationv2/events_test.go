@@ -0,0 +1,97 @@
+package applicationv2
+
+import (
+	"context"
+	"time"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newEvent(name, namespace, eventType, reason, kind, objectName string, lastSeen time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:           eventType,
+		Reason:         reason,
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Name: objectName, Namespace: namespace},
+		LastTimestamp:  metav1.NewTime(lastSeen),
+	}
+}
+
+var _ = Describe("ListEvents", func() {
+	var (
+		ctx context.Context
+		k8s client.Client
+		app *wego.Application
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now := time.Now()
+
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec: wego.ApplicationSpec{
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		kustomization := &kustomizev2.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Status: kustomizev2.KustomizationStatus{
+				Inventory: &kustomizev2.ResourceInventory{
+					Entries: []kustomizev2.ResourceRef{
+						{ID: "default_podinfo_apps_Deployment", Version: "v1"},
+						{ID: "_podinfo-reader_rbac.authorization.k8s.io_ClusterRole", Version: "v1"},
+					},
+				},
+			},
+		}
+
+		k8s = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			app,
+			kustomization,
+			&sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace}},
+			newEvent("deployment-scaled", "default", corev1.EventTypeNormal, "ScalingReplicaSet", "Deployment", "podinfo", now.Add(-time.Minute)),
+			newEvent("build-failed", wego.DefaultNamespace, corev1.EventTypeWarning, "BuildFailed", "Kustomization", "podinfo", now),
+			newEvent("source-fetched", wego.DefaultNamespace, corev1.EventTypeNormal, "Succeeded", "GitRepository", "podinfo", now.Add(-time.Hour)),
+			newEvent("role-created", "default", corev1.EventTypeNormal, "Created", "ClusterRole", "podinfo-reader", now.Add(-2*time.Hour)),
+			newEvent("other-app", wego.DefaultNamespace, corev1.EventTypeWarning, "BuildFailed", "Kustomization", "other", now),
+			newEvent("other-deployment", "default", corev1.EventTypeWarning, "FailedCreate", "Deployment", "other", now),
+		).Build()
+	})
+
+	It("returns the events of the application objects oldest first", func() {
+		events, err := ListEvents(ctx, k8s, app, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, event := range events {
+			names = append(names, event.Name)
+		}
+
+		Expect(names).To(Equal([]string{"role-created", "source-fetched", "deployment-scaled", "build-failed"}))
+	})
+
+	It("filters the events by type", func() {
+		events, err := ListEvents(ctx, k8s, app, corev1.EventTypeWarning)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Name).To(Equal("build-failed"))
+	})
+
+	It("rejects unknown event types", func() {
+		_, err := ListEvents(ctx, k8s, app, "Error")
+		Expect(err).To(MatchError(`invalid event type "Error", must be one of Normal or Warning`))
+	})
+})
@@ -74,10 +74,11 @@ func (f fetcher) List(ctx context.Context, namespace string) ([]models.Applicati
 
 func translateApp(app wego.Application) (models.Application, error) {
 	var (
-		appRepoUrl    gitproviders.RepoURL
-		configRepoUrl gitproviders.RepoURL
-		err           error
-		helmSourceURL string
+		appRepoUrl        gitproviders.RepoURL
+		configRepoUrl     gitproviders.RepoURL
+		err               error
+		helmSourceURL     string
+		artifactSourceURL string
 	)
 
 	if wego.DeploymentType(app.Spec.SourceType) == wego.DeploymentType(wego.SourceTypeGit) {
@@ -91,6 +92,10 @@ func translateApp(app wego.Application) (models.Application, error) {
 		helmSourceURL = app.Spec.URL
 	}
 
+	if app.IsArtifactSource() {
+		artifactSourceURL = app.Spec.URL
+	}
+
 	if models.IsExternalConfigRepo(app.Spec.ConfigRepo) {
 		configRepoUrl, err = gitproviders.NewRepoURL(app.Spec.ConfigRepo)
 		if err != nil {
@@ -109,6 +114,8 @@ func translateApp(app wego.Application) (models.Application, error) {
 		HelmTargetNamespace: app.Spec.HelmTargetNamespace,
 		SourceType:          models.SourceType(app.Spec.SourceType),
 		AutomationType:      models.AutomationType(app.Spec.DeploymentType),
+		ArtifactSourceURL:   artifactSourceURL,
+		DependsOn:           app.Spec.DependsOn,
 	}, nil
 }
 
@@ -0,0 +1,192 @@
+package applicationv2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/ssa"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OCIRepositoryGroupVersionKind identifies the OCIRepository API of the source-controller. It is newer than the
+// source-controller API this module depends on, so OCIRepositories are handled as unstructured objects.
+var OCIRepositoryGroupVersionKind = schema.GroupVersionKind{
+	Group:   sourcev1.GroupVersion.Group,
+	Version: "v1beta2",
+	Kind:    "OCIRepository",
+}
+
+// NewOCIRepository returns an empty unstructured OCIRepository.
+func NewOCIRepository() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(OCIRepositoryGroupVersionKind)
+
+	return obj
+}
+
+// UnstructuredConditions returns the status conditions of an unstructured flux object.
+func UnstructuredConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	raw, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	conditions := make([]metav1.Condition, 0, len(raw))
+
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var condition metav1.Condition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &condition); err != nil {
+			return nil, fmt.Errorf("invalid condition on %s %q: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+// FluxObjects returns k8s objects that can be used to find the cluster objects generated for an application.
+// The first return argument is the source, the second is the deployment
+func FluxObjects(app *wego.Application) (client.Object, client.Object, error) {
+	st := app.Spec.SourceType
+	if st == "" {
+		// Apps that were created before the SourceType field exists will not have a SourceType defined.
+		// Assume git, since thats what the CLI defaults to.
+		st = wego.SourceTypeGit
+	}
+
+	var src client.Object
+
+	switch st {
+	case wego.SourceTypeGit:
+		src = &sourcev1.GitRepository{}
+	case wego.SourceTypeHelm:
+		src = &sourcev1.HelmRepository{}
+	case wego.SourceTypeBucket:
+		src = &sourcev1.Bucket{}
+	case wego.SourceTypeOCI:
+		src = NewOCIRepository()
+	}
+
+	if src == nil {
+		return nil, nil, fmt.Errorf("invalid source type %q", st)
+	}
+
+	at := app.Spec.DeploymentType
+	if at == "" {
+		// Same as above, default to kustomize to match CLI default.
+		at = wego.DeploymentTypeKustomize
+	}
+
+	var deployment client.Object
+
+	switch at {
+	case wego.DeploymentTypeHelm:
+		deployment = &helmv2.HelmRelease{}
+	case wego.DeploymentTypeKustomize:
+		deployment = &kustomizev2.Kustomization{}
+	}
+
+	if deployment == nil {
+		return nil, nil, fmt.Errorf("invalid deployment type %q", at)
+	}
+
+	return src, deployment, nil
+}
+
+type hrStorage struct {
+	Name     string `json:"name,omitempty"`
+	Manifest string `json:"manifest,omitempty"`
+}
+
+// HelmReleaseObjects reads the objects installed by the last release of a HelmRelease from the Helm storage secret.
+// It returns nil if the release has not been installed yet.
+func HelmReleaseObjects(ctx context.Context, k8s client.Reader, hr *helmv2.HelmRelease) ([]*unstructured.Unstructured, error) {
+	storageNamespace := hr.GetNamespace()
+	if hr.Spec.StorageNamespace != "" {
+		storageNamespace = hr.Spec.StorageNamespace
+	}
+
+	storageName := hr.GetName()
+	if hr.Spec.ReleaseName != "" {
+		storageName = hr.Spec.ReleaseName
+	} else if hr.Spec.TargetNamespace != "" {
+		storageName = strings.Join([]string{hr.Spec.TargetNamespace, hr.Name}, "-")
+	}
+
+	storageVersion := hr.Status.LastReleaseRevision
+	// skip release if it failed to install
+	if storageVersion < 1 {
+		return nil, nil
+	}
+
+	storageSecret := &corev1.Secret{}
+	if err := k8s.Get(ctx, types.NamespacedName{
+		Namespace: storageNamespace,
+		Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%v", storageName, storageVersion),
+	}, storageSecret); err != nil {
+		return nil, fmt.Errorf("could not get storage secret for HelmRelease '%s': %w", hr.Name, err)
+	}
+
+	releaseData, releaseFound := storageSecret.Data["release"]
+	if !releaseFound {
+		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s'", hr.Name)
+	}
+
+	// adapted from https://github.com/helm/helm/blob/02685e94bd3862afcb44f6cd7716dbeb69743567/pkg/storage/driver/util.go
+	var b64 = base64.StdEncoding
+
+	b, err := b64.DecodeString(string(releaseData))
+	if err != nil {
+		return nil, err
+	}
+
+	var magicGzip = []byte{0x1f, 0x8b, 0x08}
+	if bytes.Equal(b[0:3], magicGzip) {
+		r, err := gzip.NewReader(bytes.NewReader(b))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+
+		b2, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		b = b2
+	}
+
+	var storage hrStorage
+	if err := json.Unmarshal(b, &storage); err != nil {
+		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s': %w", hr.Name, err)
+	}
+
+	objects, err := ssa.ReadObjects(strings.NewReader(storage.Manifest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the Helm storage object for HelmRelease '%s': %w", hr.Name, err)
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,244 @@
+package applicationv2
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxLogLineSize is the size of the longest log line that can be streamed.
+const maxLogLineSize = 1024 * 1024
+
+// LogsOptions select the containers whose logs are streamed and the logs to stream.
+type LogsOptions struct {
+	// Container only streams the logs of the containers with this name
+	Container string
+	// SinceSeconds only streams the logs newer than this number of seconds, or all logs when 0
+	SinceSeconds int64
+	// Follow keeps streaming new logs until the context is done
+	Follow bool
+}
+
+// LogLine is a line of the logs of a container.
+type LogLine struct {
+	Namespace string
+	Pod       string
+	Container string
+	Line      string
+}
+
+// ApplicationPods returns the pods of the workloads reconciled for an application, and the pods it reconciled
+// directly, that match labelSelector.
+func ApplicationPods(ctx context.Context, k8s client.Client, app *wego.Application, labelSelector string) ([]corev1.Pod, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	involved, err := involvedObjects(ctx, k8s, app)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[types.NamespacedName]corev1.Pod{}
+
+	for obj := range involved {
+		pods, err := workloadPods(ctx, k8s, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range pods {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				found[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = pod
+			}
+		}
+	}
+
+	pods := []corev1.Pod{}
+	for _, pod := range found {
+		pods = append(pods, pod)
+	}
+
+	sort.Slice(pods, func(i, j int) bool {
+		if pods[i].Namespace != pods[j].Namespace {
+			return pods[i].Namespace < pods[j].Namespace
+		}
+
+		return pods[i].Name < pods[j].Name
+	})
+
+	return pods, nil
+}
+
+// workloadPods returns the pods selected by a workload, or the pod itself. Other objects have no pods.
+func workloadPods(ctx context.Context, k8s client.Client, obj involvedObject) ([]corev1.Pod, error) {
+	var workload client.Object
+
+	switch obj.Kind {
+	case "Pod":
+		workload = &corev1.Pod{}
+	case "Deployment":
+		workload = &appsv1.Deployment{}
+	case "StatefulSet":
+		workload = &appsv1.StatefulSet{}
+	case "DaemonSet":
+		workload = &appsv1.DaemonSet{}
+	case "ReplicaSet":
+		workload = &appsv1.ReplicaSet{}
+	case "Job":
+		workload = &batchv1.Job{}
+	default:
+		return nil, nil
+	}
+
+	if err := k8s.Get(ctx, types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}, workload); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get %s %q: %w", obj.Kind, obj.Name, err)
+	}
+
+	var podSelector *metav1.LabelSelector
+
+	switch w := workload.(type) {
+	case *corev1.Pod:
+		return []corev1.Pod{*w}, nil
+	case *appsv1.Deployment:
+		podSelector = w.Spec.Selector
+	case *appsv1.StatefulSet:
+		podSelector = w.Spec.Selector
+	case *appsv1.DaemonSet:
+		podSelector = w.Spec.Selector
+	case *appsv1.ReplicaSet:
+		podSelector = w.Spec.Selector
+	case *batchv1.Job:
+		podSelector = w.Spec.Selector
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(podSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector of %s %q: %w", obj.Kind, obj.Name, err)
+	}
+
+	// An empty selector would select every pod in the namespace
+	if selector.Empty() {
+		return nil, nil
+	}
+
+	list := &corev1.PodList{}
+	if err := k8s.List(ctx, list, client.InNamespace(obj.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("could not list pods of %s %q: %w", obj.Kind, obj.Name, err)
+	}
+
+	return list.Items, nil
+}
+
+// StreamLogs streams the logs of the containers of pods line by line. The logs of the containers are read
+// concurrently and interleaved in the order lines are read, but send is never called concurrently. It returns
+// when all the logs have been sent, when the context is done or when send fails. Containers whose logs
+// cannot be read do not stop the others; the first error is returned once the others are done.
+func StreamLogs(ctx context.Context, clientset kubernetes.Interface, pods []corev1.Pod, opts LogsOptions, send func(LogLine) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	lines := make(chan LogLine)
+	errs := make(chan error, 1)
+
+	var wg sync.WaitGroup
+
+	containers := 0
+
+	for i := range pods {
+		for _, container := range pods[i].Spec.Containers {
+			if opts.Container != "" && container.Name != opts.Container {
+				continue
+			}
+
+			containers++
+
+			wg.Add(1)
+
+			go func(pod *corev1.Pod, container string) {
+				defer wg.Done()
+
+				if err := streamContainerLogs(ctx, clientset, pod, container, opts, lines); err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+				}
+			}(&pods[i], container.Name)
+		}
+	}
+
+	if opts.Container != "" && len(pods) > 0 && containers == 0 {
+		return fmt.Errorf("no container named %q in the pods of the application", opts.Container)
+	}
+
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	for line := range lines {
+		if err := send(line); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+func streamContainerLogs(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod, container string, opts LogsOptions, lines chan<- LogLine) error {
+	logOptions := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    opts.Follow,
+	}
+
+	if opts.SinceSeconds > 0 {
+		logOptions.SinceSeconds = &opts.SinceSeconds
+	}
+
+	stream, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions).Stream(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get logs of container %q of pod %q: %w", container, pod.Name, err)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+
+	for scanner.Scan() {
+		select {
+		case lines <- LogLine{Namespace: pod.Namespace, Pod: pod.Name, Container: container, Line: scanner.Text()}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return fmt.Errorf("could not read logs of container %q of pod %q: %w", container, pod.Name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package applicationv2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newPod(name string, labels map[string]string, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+	}
+
+	for _, container := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: container})
+	}
+
+	return pod
+}
+
+var _ = Describe("ApplicationPods", func() {
+	var (
+		ctx context.Context
+		k8s client.Client
+		app *wego.Application
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+			Spec:       wego.ApplicationSpec{SourceType: wego.SourceTypeGit, DeploymentType: wego.DeploymentTypeKustomize},
+		}
+
+		k8s = fakeclient.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			app,
+			&kustomizev2.Kustomization{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: wego.DefaultNamespace},
+				Status: kustomizev2.KustomizationStatus{
+					Inventory: &kustomizev2.ResourceInventory{
+						Entries: []kustomizev2.ResourceRef{
+							{ID: "default_podinfo_apps_Deployment", Version: "v1"},
+							{ID: "default_migrate__Pod", Version: "v1"},
+							{ID: "default_podinfo__Service", Version: "v1"},
+						},
+					},
+				},
+			},
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "podinfo"}},
+				},
+			},
+			newPod("podinfo-1", map[string]string{"app": "podinfo", "tier": "frontend"}, "podinfo"),
+			newPod("podinfo-2", map[string]string{"app": "podinfo", "tier": "backend"}, "podinfo"),
+			newPod("migrate", map[string]string{"job": "migrate"}, "migrate"),
+			newPod("redis-1", map[string]string{"app": "redis"}, "redis"),
+		).Build()
+	})
+
+	It("returns the pods of the application workloads", func() {
+		pods, err := ApplicationPods(ctx, k8s, app, "")
+		Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, pod := range pods {
+			names = append(names, pod.Name)
+		}
+
+		Expect(names).To(Equal([]string{"migrate", "podinfo-1", "podinfo-2"}))
+	})
+
+	It("filters the pods with a label selector", func() {
+		pods, err := ApplicationPods(ctx, k8s, app, "tier=frontend")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(pods).To(HaveLen(1))
+		Expect(pods[0].Name).To(Equal("podinfo-1"))
+	})
+
+	It("rejects invalid label selectors", func() {
+		_, err := ApplicationPods(ctx, k8s, app, "tier in frontend")
+		Expect(err).To(MatchError(HavePrefix(`invalid label selector "tier in frontend"`)))
+	})
+})
+
+var _ = Describe("StreamLogs", func() {
+	var pods []corev1.Pod
+
+	BeforeEach(func() {
+		pods = []corev1.Pod{
+			*newPod("podinfo-1", nil, "podinfo", "linkerd-proxy"),
+			*newPod("podinfo-2", nil, "podinfo"),
+		}
+	})
+
+	It("streams the logs of every container", func() {
+		lines := []string{}
+
+		err := StreamLogs(context.Background(), fake.NewSimpleClientset(), pods, LogsOptions{}, func(line LogLine) error {
+			lines = append(lines, line.Pod+"/"+line.Container+" "+line.Line)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		sort.Strings(lines)
+		Expect(lines).To(Equal([]string{
+			"podinfo-1/linkerd-proxy fake logs",
+			"podinfo-1/podinfo fake logs",
+			"podinfo-2/podinfo fake logs",
+		}))
+	})
+
+	It("only streams the logs of the selected containers", func() {
+		lines := []LogLine{}
+
+		err := StreamLogs(context.Background(), fake.NewSimpleClientset(), pods, LogsOptions{Container: "linkerd-proxy"}, func(line LogLine) error {
+			lines = append(lines, line)
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lines).To(Equal([]LogLine{{Namespace: "default", Pod: "podinfo-1", Container: "linkerd-proxy", Line: "fake logs"}}))
+	})
+
+	It("fails when no container has the selected name", func() {
+		err := StreamLogs(context.Background(), fake.NewSimpleClientset(), pods, LogsOptions{Container: "nginx"}, func(line LogLine) error {
+			return nil
+		})
+		Expect(err).To(MatchError(`no container named "nginx" in the pods of the application`))
+	})
+
+	It("stops when the logs cannot be sent", func() {
+		sendErr := errors.New("stream closed")
+
+		err := StreamLogs(context.Background(), fake.NewSimpleClientset(), pods, LogsOptions{}, func(line LogLine) error {
+			return sendErr
+		})
+		Expect(err).To(Equal(sendErr))
+	})
+})
@@ -0,0 +1,69 @@
+package applicationv2
+
+import (
+	"context"
+	"fmt"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// SetSuspended suspends or resumes the reconciliation of an application by patching spec.suspend of its
+// Kustomization or HelmRelease and of its source. It returns false without changing anything when the
+// Kustomization or HelmRelease is already in the requested state.
+func SetSuspended(ctx context.Context, k8s client.Client, app *wego.Application, suspend bool) (bool, error) {
+	src, deployment, err := FluxObjects(app)
+	if err != nil {
+		return false, err
+	}
+
+	name := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	if err := k8s.Get(ctx, name, deployment); err != nil {
+		return false, fmt.Errorf("could not get the automation of application %q: %w", app.Name, err)
+	}
+
+	if isSuspended(deployment) == suspend {
+		return false, nil
+	}
+
+	// The deployment is suspended before its source and resumed after it, so that it never reconciles
+	// while its source is being suspended or resumed.
+	objects := []client.Object{deployment, src}
+	if !suspend {
+		objects = []client.Object{src, deployment}
+	}
+
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)))
+
+	for _, obj := range objects {
+		obj.SetName(app.Name)
+		obj.SetNamespace(app.Namespace)
+
+		if err := k8s.Patch(ctx, obj, patch); err != nil {
+			kind := "object"
+			if gvk, gvkErr := apiutil.GVKForObject(obj, k8s.Scheme()); gvkErr == nil {
+				kind = gvk.Kind
+			}
+
+			return false, fmt.Errorf("could not patch %s %q: %w", kind, app.Name, err)
+		}
+	}
+
+	return true, nil
+}
+
+func isSuspended(deployment client.Object) bool {
+	switch d := deployment.(type) {
+	case *kustomizev2.Kustomization:
+		return d.Spec.Suspend
+	case *helmv2.HelmRelease:
+		return d.Spec.Suspend
+	}
+
+	return false
+}
@@ -0,0 +1,105 @@
+package applicationv2
+
+import (
+	"context"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("SetSuspended", func() {
+	var (
+		ctx  context.Context
+		k8s  client.Client
+		app  *wego.Application
+		name types.NamespacedName
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		name = types.NamespacedName{Name: "podinfo", Namespace: wego.DefaultNamespace}
+
+		app = &wego.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+			Spec: wego.ApplicationSpec{
+				SourceType:     wego.SourceTypeGit,
+				DeploymentType: wego.DeploymentTypeKustomize,
+			},
+		}
+
+		k8s = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			app,
+			&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+			&sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+		).Build()
+	})
+
+	It("suspends and resumes the Kustomization and the GitRepository", func() {
+		changed, err := SetSuspended(ctx, k8s, app, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		kustomization := &kustomizev2.Kustomization{}
+		Expect(k8s.Get(ctx, name, kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Suspend).To(BeTrue())
+
+		repo := &sourcev1.GitRepository{}
+		Expect(k8s.Get(ctx, name, repo)).To(Succeed())
+		Expect(repo.Spec.Suspend).To(BeTrue())
+
+		changed, err = SetSuspended(ctx, k8s, app, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		Expect(k8s.Get(ctx, name, kustomization)).To(Succeed())
+		Expect(kustomization.Spec.Suspend).To(BeFalse())
+		Expect(k8s.Get(ctx, name, repo)).To(Succeed())
+		Expect(repo.Spec.Suspend).To(BeFalse())
+	})
+
+	It("does nothing when the application is already in the requested state", func() {
+		changed, err := SetSuspended(ctx, k8s, app, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("suspends a HelmRelease and its HelmRepository", func() {
+		app.Spec.SourceType = wego.SourceTypeHelm
+		app.Spec.DeploymentType = wego.DeploymentTypeHelm
+
+		k8s = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			&helmv2.HelmRelease{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+			&sourcev1.HelmRepository{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+		).Build()
+
+		changed, err := SetSuspended(ctx, k8s, app, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		release := &helmv2.HelmRelease{}
+		Expect(k8s.Get(ctx, name, release)).To(Succeed())
+		Expect(release.Spec.Suspend).To(BeTrue())
+
+		repo := &sourcev1.HelmRepository{}
+		Expect(k8s.Get(ctx, name, repo)).To(Succeed())
+		Expect(repo.Spec.Suspend).To(BeTrue())
+	})
+
+	It("fails when the source does not exist", func() {
+		k8s = fake.NewClientBuilder().WithScheme(kube.CreateScheme()).WithObjects(
+			&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace}},
+		).Build()
+
+		_, err := SetSuspended(ctx, k8s, app, true)
+		Expect(err).To(MatchError(ContainSubstring(`could not patch GitRepository "podinfo"`)))
+	})
+})
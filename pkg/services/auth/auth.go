@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"time"
 
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth/internal"
 
+	"github.com/benbjohnson/clock"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
@@ -36,6 +40,8 @@ func NewAuthCLIHandler(name gitproviders.GitProviderName) (BlockingCLIAuthHandle
 		}
 
 		return NewGitlabAuthFlowHandler(http.DefaultClient, authFlow), nil
+	case gitproviders.GitProviderBitbucketServer, gitproviders.GitProviderGitea:
+		return NewTokenPromptHandler(name, os.Stdin), nil
 	}
 
 	return nil, fmt.Errorf("unsupported auth provider \"%s\"", name)
@@ -70,6 +76,8 @@ type AuthService interface {
 	CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool) (git.Git, error)
 	GetGitProvider() gitproviders.GitProvider
 	SetupDeployKey(ctx context.Context, namespace string, repo gitproviders.RepoURL) (*ssh.PublicKeys, error)
+	SetupGitCredentials(ctx context.Context, namespace string, repo gitproviders.RepoURL) (transport.AuthMethod, error)
+	RotateDeployKey(ctx context.Context, namespace string, repo gitproviders.RepoURL, timeout time.Duration) error
 }
 
 type authSvc struct {
@@ -79,16 +87,27 @@ type authSvc struct {
 	// That interface wasn't providing any valuable abstraction for this service.
 	k8sClient   client.Client
 	gitProvider gitproviders.GitProvider
+	clock       clock.Clock
+	// The sources of the tokens of the token and github-app authentication modes
+	providerToken GitTokenSource
+	githubApp     GitTokenSource
 }
 
 // NewAuthService constructs an auth service for doing git operations with an authenticated client.
-func NewAuthService(fluxClient flux.Flux, k8sClient client.Client, provider gitproviders.GitProvider, l logger.Logger) (AuthService, error) {
-	return &authSvc{
+func NewAuthService(fluxClient flux.Flux, k8sClient client.Client, provider gitproviders.GitProvider, l logger.Logger, opts ...Option) (AuthService, error) {
+	a := &authSvc{
 		logger:      l,
 		fluxClient:  fluxClient,
 		k8sClient:   k8sClient,
 		gitProvider: provider,
-	}, nil
+		clock:       clock.New(),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a, nil
 }
 
 // GetGitProvider returns the GitProvider associated with the AuthService instance
@@ -96,27 +115,36 @@ func (a *authSvc) GetGitProvider() gitproviders.GitProvider {
 	return a.gitProvider
 }
 
-// CreateGitClient creates a git.Git client instrumented with existing or generated deploy keys.
-// This ensures that git operations are done with stored deploy keys instead of a user's local ssh-agent or equivalent.
+// CreateGitClient creates a git.Git client instrumented with existing or generated deploy keys, or with tokens
+// when the repository is accessed over HTTPS. When repoUrl has no authentication mode, the mode the repository
+// was set up with is used.
+// This ensures that git operations are done with stored credentials instead of a user's local ssh-agent or equivalent.
 func (a *authSvc) CreateGitClient(ctx context.Context, repoUrl gitproviders.RepoURL, namespace string, dryRun bool) (git.Git, error) {
 	if dryRun {
 		d, _ := makePublicKey([]byte(""))
 		return git.New(d, wrapper.NewGoGit()), nil
 	}
 
-	pubKey, keyErr := a.SetupDeployKey(ctx, namespace, repoUrl)
-	if keyErr != nil {
-		return nil, fmt.Errorf("error setting up deploy keys: %w", keyErr)
+	if repoUrl.GitAuth() == "" {
+		mode, err := RepoGitAuth(ctx, a.k8sClient, namespace, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+
+		repoUrl = repoUrl.WithGitAuth(mode)
+	}
+
+	credentials, err := a.SetupGitCredentials(ctx, namespace, repoUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error setting up git credentials: %w", err)
 	}
 
-	if pubKey == nil {
-		// Don't return git.New(pubkey, wrapper.NewGoGit()), nil here. It will fail
-		// "nil" of type *ssh.PublicKeys does not behave correctly
-		return git.New(nil, wrapper.NewGoGit()), nil
+	signer, err := CommitSigner(ctx, a.k8sClient, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the commit signing key: %w", err)
 	}
 
-	// Set the git client to use the existing deploy key.
-	return git.New(pubKey, wrapper.NewGoGit()), nil
+	return git.New(credentials, wrapper.NewGoGit(), git.WithSigner(signer)), nil
 }
 
 // SetupDeployKey creates a git.Git client instrumented with existing or generated deploy keys.
@@ -132,7 +160,9 @@ func (a *authSvc) SetupDeployKey(ctx context.Context, namespace string, repo git
 		return nil, fmt.Errorf("failed check for existing deploy key: %w", err)
 	}
 
-	if deployKeyExists {
+	// The keys authorized on plain git servers cannot be listed, the deploy key of the cluster is
+	// reused when there is one.
+	if deployKeyExists || repo.Provider() == gitproviders.GitProviderGit {
 		// The deploy key was found on the Git Provider, fetch it from the cluster.
 		secret, err := a.retrieveDeployKey(ctx, secretName)
 		if apierrors.IsNotFound(err) {
@@ -140,7 +170,10 @@ func (a *authSvc) SetupDeployKey(ctx context.Context, namespace string, repo git
 			// Users might end up here if we uploaded the deploy key, but it failed to save on the cluster,
 			// or if a cluster was destroyed during development work.
 			// Create and upload a new deploy key.
-			a.logger.Warningf("A deploy key named %s was found on the git provider, but not in the cluster.", secretName.Name)
+			if deployKeyExists {
+				a.logger.Warningf("A deploy key named %s was found on the git provider, but not in the cluster.", secretName.Name)
+			}
+
 			return a.provisionDeployKey(ctx, secretName, repo)
 		} else if err != nil {
 			return nil, fmt.Errorf("error retrieving deploy key: %w", err)
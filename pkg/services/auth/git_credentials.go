@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	gossh "golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// GitAuthLabel is set on the Secrets of the repositories accessed over HTTPS, with their authentication mode.
+	GitAuthLabel = "wego.weave.works/git-auth"
+	// TokenExpiresAtAnnotation is when the token of a Secret expires, for the tokens that do.
+	TokenExpiresAtAnnotation = "wego.weave.works/token-expires-at"
+	// GitHostAnnotation is the host of the repository of a Secret, whose API new tokens are requested from.
+	GitHostAnnotation = "wego.weave.works/git-host"
+
+	// GitHubAppSecretName is the name of the Secret the wego-app Deployment reads the GitHub App from.
+	GitHubAppSecretName = "weave-gitops-github-app"
+	// GitHubAppSecretAppIDKey is the ID of the GitHub App.
+	GitHubAppSecretAppIDKey = "app-id"
+	// GitHubAppSecretInstallationIDKey is the ID of the installation of the GitHub App.
+	GitHubAppSecretInstallationIDKey = "installation-id"
+	// GitHubAppSecretPrivateKeyKey is the PEM encoded private key of the GitHub App.
+	GitHubAppSecretPrivateKeyKey = "private-key"
+)
+
+// Option configures the auth service.
+type Option func(*authSvc)
+
+// WithProviderToken sets the source of the git provider tokens used by the token authentication mode.
+func WithProviderToken(source GitTokenSource) Option {
+	return func(a *authSvc) {
+		a.providerToken = source
+	}
+}
+
+// WithGitHubApp sets the source of the GitHub App installation tokens used by the github-app authentication mode.
+func WithGitHubApp(source GitTokenSource) Option {
+	return func(a *authSvc) {
+		a.githubApp = source
+	}
+}
+
+// SetupGitCredentials sets up the credentials of the authentication mode of the repository, and returns the
+// authentication method git operations on the repository are done with. Deploy keys are generated and uploaded
+// by SetupDeployKey. For the token modes, the token is stored in a Secret Flux authenticates with over HTTPS.
+func (a *authSvc) SetupGitCredentials(ctx context.Context, namespace string, repo gitproviders.RepoURL) (transport.AuthMethod, error) {
+	if !repo.GitAuth().IsToken() {
+		pubKey, err := a.SetupDeployKey(ctx, namespace, repo)
+		if err != nil || pubKey == nil {
+			// Don't return a nil *ssh.PublicKeys, it would not be a nil transport.AuthMethod
+			return nil, err
+		}
+
+		if repo.Provider() == gitproviders.GitProviderGit {
+			return a.plainGitAuth(repo, pubKey), nil
+		}
+
+		return pubKey, nil
+	}
+
+	source, err := a.tokenSource(repo.GitAuth())
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := source.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenAuth := newTokenAuth(repo.Provider(), source, token)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      models.CreateRepoSecretName(repo).String(),
+			Namespace: namespace,
+		},
+	}
+
+	if err := storeToken(ctx, a.k8sClient, secret, repo.GitAuth(), repo.URL().Host, tokenAuth.username, token, expiresAt); err != nil {
+		return nil, fmt.Errorf("error storing git token: %w", err)
+	}
+
+	return tokenAuth, nil
+}
+
+// plainGitAuth returns the authentication method of repositories on plain git servers. Their deploy keys
+// are authorized by hand after being printed, so the keys of the SSH agent are offered first, like the
+// plain git provider does, and the deploy key when the server rejects them. Only the deploy key is used
+// when there is no agent.
+func (a *authSvc) plainGitAuth(repo gitproviders.RepoURL, deployKey *ssh.PublicKeys) transport.AuthMethod {
+	if repo.URL().Scheme == "file" {
+		return deployKey
+	}
+
+	agentAuth, err := ssh.NewSSHAgentAuth(deployKey.User)
+	if err != nil {
+		a.logger.Warningf("Could not use the SSH agent for %s, the deploy key must be authorized before continuing: %s", repo, err)
+
+		return deployKey
+	}
+
+	return &ssh.PublicKeysCallback{
+		User: deployKey.User,
+		Callback: func() ([]gossh.Signer, error) {
+			signers, err := agentAuth.Callback()
+			if err != nil {
+				return []gossh.Signer{deployKey.Signer}, nil
+			}
+
+			return append(signers, deployKey.Signer), nil
+		},
+		HostKeyCallbackHelper: agentAuth.HostKeyCallbackHelper,
+	}
+}
+
+func (a *authSvc) tokenSource(mode gitproviders.GitAuth) (GitTokenSource, error) {
+	switch mode {
+	case gitproviders.GitAuthToken:
+		if a.providerToken != nil {
+			return a.providerToken, nil
+		}
+	case gitproviders.GitAuthGitHubApp:
+		if a.githubApp != nil {
+			return a.githubApp, nil
+		}
+
+		return nil, fmt.Errorf("no GitHub App configured, set --github-app-id, --github-app-installation-id and --github-app-private-key-file")
+	}
+
+	return nil, fmt.Errorf("no token configured for the %s git authentication", mode)
+}
+
+// storeToken creates or updates the Secret Flux authenticates with over HTTPS.
+func storeToken(ctx context.Context, k8sClient client.Client, secret *corev1.Secret, mode gitproviders.GitAuth, host, username, token string, expiresAt time.Time) error {
+	existing := &corev1.Secret{}
+
+	err := k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	found := err == nil
+	if found {
+		secret = existing
+	}
+
+	secret.Labels = mergeStringMaps(secret.Labels, map[string]string{GitAuthLabel: string(mode)})
+	secret.Annotations = mergeStringMaps(secret.Annotations, map[string]string{GitHostAnnotation: host})
+
+	if expiresAt.IsZero() {
+		delete(secret.Annotations, TokenExpiresAtAnnotation)
+	} else {
+		secret.Annotations[TokenExpiresAtAnnotation] = expiresAt.UTC().Format(time.RFC3339)
+	}
+
+	// Replace the data, the Secret may have held a deploy key before
+	secret.Data = map[string][]byte{
+		"username": []byte(username),
+		"password": []byte(token),
+	}
+
+	if found {
+		return k8sClient.Update(ctx, secret)
+	}
+
+	return k8sClient.Create(ctx, secret)
+}
+
+// RepoGitAuth returns the authentication mode the repository was set up with. It defaults to deploy keys
+// when the repository has no credentials yet.
+func RepoGitAuth(ctx context.Context, k8sClient client.Client, namespace string, repo gitproviders.RepoURL) (gitproviders.GitAuth, error) {
+	secret := &corev1.Secret{}
+
+	err := k8sClient.Get(ctx, client.ObjectKey{Name: models.CreateRepoSecretName(repo).String(), Namespace: namespace}, secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return gitproviders.GitAuthDeployKey, nil
+		}
+
+		return "", fmt.Errorf("could not get the git credentials: %w", err)
+	}
+
+	mode, ok := secret.Labels[GitAuthLabel]
+	if !ok {
+		return gitproviders.GitAuthDeployKey, nil
+	}
+
+	return gitproviders.ParseGitAuth(mode)
+}
+
+// RefreshGitCredentials replaces the GitHub App installation tokens of the Secrets in the namespace, or in
+// all namespaces when it is empty, that expire soon, as Flux cannot refresh them. The tokens are requested
+// from the GitHub instance the repository of each Secret is hosted on.
+func RefreshGitCredentials(ctx context.Context, k8sClient client.Client, namespace string, sources GitTokenSources) error {
+	secrets := &corev1.SecretList{}
+
+	if err := k8sClient.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{GitAuthLabel: string(gitproviders.GitAuthGitHubApp)}); err != nil {
+		return fmt.Errorf("could not list the git credentials: %w", err)
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+
+		expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[TokenExpiresAtAnnotation])
+		if err == nil && time.Now().Add(tokenRefreshMargin).Before(expiresAt) {
+			continue
+		}
+
+		// Secrets stored before the host was recorded are for github.com
+		host := secret.Annotations[GitHostAnnotation]
+		if host == "" {
+			host = "github.com"
+		}
+
+		source, err := sources(host)
+		if err != nil {
+			return err
+		}
+
+		if source == nil {
+			return fmt.Errorf("no GitHub App configured to refresh the git credentials %s/%s", secret.Namespace, secret.Name)
+		}
+
+		token, expiresAt, err := source.Token(ctx)
+		if err != nil {
+			return err
+		}
+
+		username := string(secret.Data["username"])
+
+		if err := storeToken(ctx, k8sClient, secret, gitproviders.GitAuthGitHubApp, host, username, token, expiresAt); err != nil {
+			return fmt.Errorf("could not refresh the git credentials %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// StoreGitHubAppConfig stores the GitHub App configured with the github-app-id, github-app-installation-id
+// and github-app-private-key-file settings in the GitHubAppSecretName Secret of the namespace, so that the
+// wego-app Deployment can refresh the installation tokens.
+func StoreGitHubAppConfig(ctx context.Context, k8sClient client.Client, namespace string) error {
+	key, err := ioutil.ReadFile(viper.GetString("github-app-private-key-file"))
+	if err != nil {
+		return fmt.Errorf("failed to read the GitHub App private key: %w", err)
+	}
+
+	secret := &corev1.Secret{}
+
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: GitHubAppSecretName, Namespace: namespace}, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("could not get the GitHub App secret: %w", err)
+	}
+
+	found := err == nil
+	if !found {
+		secret.Name = GitHubAppSecretName
+		secret.Namespace = namespace
+	}
+
+	secret.Data = map[string][]byte{
+		GitHubAppSecretAppIDKey:          []byte(viper.GetString("github-app-id")),
+		GitHubAppSecretInstallationIDKey: []byte(viper.GetString("github-app-installation-id")),
+		GitHubAppSecretPrivateKeyKey:     key,
+	}
+
+	if found {
+		return k8sClient.Update(ctx, secret)
+	}
+
+	return k8sClient.Create(ctx, secret)
+}
+
+func mergeStringMaps(m map[string]string, values map[string]string) map[string]string {
+	if m == nil {
+		m = map[string]string{}
+	}
+
+	for k, v := range values {
+		m[k] = v
+	}
+
+	return m
+}
@@ -0,0 +1,370 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	gogitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
+	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("GitHub App token source", func() {
+	var (
+		server   *httptest.Server
+		key      *rsa.PrivateKey
+		requests int
+		now      time.Time
+		source   GitTokenSource
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		requests = 0
+		now = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/app/installations/5678/access_tokens"))
+
+			claims := &jwt.StandardClaims{}
+			parser := &jwt.Parser{SkipClaimsValidation: true}
+			_, err := parser.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), claims, func(token *jwt.Token) (interface{}, error) {
+				return &key.PublicKey, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Issuer).To(Equal("1234"))
+
+			requests++
+
+			w.WriteHeader(http.StatusCreated)
+			Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+				"token":      fmt.Sprintf("token-%d", requests),
+				"expires_at": now.Add(time.Hour),
+			})).To(Succeed())
+		}))
+
+		pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+		source, err = NewGitHubAppTokenSource(server.Client(), server.URL, 1234, 5678, pemKey)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("caches the installation token until it is about to expire", func() {
+		mock := clock.NewMock()
+		mock.Set(now)
+		source.(*githubAppTokenSource).clock = mock
+
+		token, expiresAt, err := source.Token(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("token-1"))
+		Expect(expiresAt).To(BeTemporally("==", now.Add(time.Hour)))
+
+		mock.Add(45 * time.Minute)
+
+		token, _, err = source.Token(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("token-1"))
+
+		mock.Add(10 * time.Minute)
+
+		token, _, err = source.Token(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("token-2"))
+		Expect(requests).To(Equal(2))
+	})
+
+	It("fails with an invalid private key", func() {
+		_, err := NewGitHubAppTokenSource(server.Client(), server.URL, 1234, 5678, []byte("not a key"))
+		Expect(err).To(MatchError(ContainSubstring("invalid GitHub App private key")))
+	})
+})
+
+var _ = Describe("Git credentials", func() {
+	var (
+		ctx       context.Context
+		namespace string
+		repoUrl   gitproviders.RepoURL
+		gp        *gitprovidersfakes.FakeGitProvider
+		as        *authSvc
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+		namespace = "git-credentials-" + utilrand.String(5)
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+
+		repoUrl, err = gitproviders.NewRepoURL("ssh://git@github.com/my-org/my-repo.git")
+		Expect(err).NotTo(HaveOccurred())
+
+		gp = &gitprovidersfakes.FakeGitProvider{}
+		as = &authSvc{
+			logger:        &loggerfakes.FakeLogger{},
+			k8sClient:     k8sClient,
+			gitProvider:   gp,
+			providerToken: NewStaticTokenSource("the-token"),
+		}
+	})
+
+	secretKey := func() client.ObjectKey {
+		return client.ObjectKey{Name: models.CreateRepoSecretName(repoUrl).String(), Namespace: namespace}
+	}
+
+	It("stores the provider token instead of uploading a deploy key", func() {
+		credentials, err := as.SetupGitCredentials(ctx, namespace, repoUrl.WithGitAuth(gitproviders.GitAuthToken))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gp.UploadDeployKeyCallCount()).To(Equal(0))
+
+		req := httptest.NewRequest(http.MethodGet, "https://github.com/my-org/my-repo.git/info/refs", nil)
+		credentials.(*tokenAuth).SetAuth(req)
+
+		username, password, ok := req.BasicAuth()
+		Expect(ok).To(BeTrue())
+		Expect(username).To(Equal("x-access-token"))
+		Expect(password).To(Equal("the-token"))
+		Expect(credentials.(*tokenAuth).RewriteURL(repoUrl.String())).To(Equal("https://github.com/my-org/my-repo.git"))
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, secretKey(), secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(map[string][]byte{"username": []byte("x-access-token"), "password": []byte("the-token")}))
+
+		mode, err := RepoGitAuth(ctx, k8sClient, namespace, repoUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mode).To(Equal(gitproviders.GitAuthToken))
+	})
+
+	It("defaults to deploy keys", func() {
+		mode, err := RepoGitAuth(ctx, k8sClient, namespace, repoUrl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mode).To(Equal(gitproviders.GitAuthDeployKey))
+	})
+
+	It("fails when no GitHub App is configured", func() {
+		_, err := as.SetupGitCredentials(ctx, namespace, repoUrl.WithGitAuth(gitproviders.GitAuthGitHubApp))
+		Expect(err).To(MatchError(ContainSubstring("no GitHub App configured")))
+	})
+
+	It("refreshes the GitHub App tokens that expire soon", func() {
+		as.githubApp = &fakeTokenSource{token: "old", expiresAt: time.Now().Add(5 * time.Minute)}
+
+		_, err := as.SetupGitCredentials(ctx, namespace, repoUrl.WithGitAuth(gitproviders.GitAuthGitHubApp))
+		Expect(err).NotTo(HaveOccurred())
+
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		Expect(RefreshGitCredentials(ctx, k8sClient, namespace, tokenSources(&fakeTokenSource{token: "new", expiresAt: expiresAt}))).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, secretKey(), secret)).To(Succeed())
+		Expect(string(secret.Data["password"])).To(Equal("new"))
+		Expect(secret.Annotations[TokenExpiresAtAnnotation]).To(Equal(expiresAt.UTC().Format(time.RFC3339)))
+		Expect(secret.Annotations[GitHostAnnotation]).To(Equal("github.com"))
+
+		// The new token is valid for long enough
+		Expect(RefreshGitCredentials(ctx, k8sClient, namespace, tokenSources(&fakeTokenSource{token: "newer"}))).To(Succeed())
+		Expect(k8sClient.Get(ctx, secretKey(), secret)).To(Succeed())
+		Expect(string(secret.Data["password"])).To(Equal("new"))
+	})
+
+	It("refreshes the GitHub App tokens of all namespaces from the GitHub instance of their repository", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "wego-github-enterprise-repo",
+				Namespace: namespace,
+				Labels:    map[string]string{GitAuthLabel: string(gitproviders.GitAuthGitHubApp)},
+				Annotations: map[string]string{
+					TokenExpiresAtAnnotation: time.Now().Format(time.RFC3339),
+					GitHostAnnotation:        "github.example.com",
+				},
+			},
+			Data: map[string][]byte{"username": []byte("x-access-token"), "password": []byte("old")},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		hosts := map[string]bool{}
+		sources := func(host string) (GitTokenSource, error) {
+			hosts[host] = true
+			return &fakeTokenSource{token: "new-" + host, expiresAt: time.Now().Add(time.Hour)}, nil
+		}
+
+		Expect(RefreshGitCredentials(ctx, k8sClient, metav1.NamespaceAll, sources)).To(Succeed())
+		Expect(hosts).To(HaveKey("github.example.com"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+		Expect(string(secret.Data["password"])).To(Equal("new-github.example.com"))
+	})
+
+	It("stores the GitHub App the deployment refreshes the tokens with", func() {
+		dir, err := ioutil.TempDir("", "github-app")
+		Expect(err).NotTo(HaveOccurred())
+
+		defer os.RemoveAll(dir)
+
+		keyFile := filepath.Join(dir, "app.pem")
+		Expect(ioutil.WriteFile(keyFile, []byte("the-key"), 0600)).To(Succeed())
+
+		viper.Set("github-app-id", "1234")
+		viper.Set("github-app-installation-id", "5678")
+		viper.Set("github-app-private-key-file", keyFile)
+
+		defer func() {
+			viper.Set("github-app-id", "")
+			viper.Set("github-app-installation-id", "")
+			viper.Set("github-app-private-key-file", "")
+		}()
+
+		Expect(StoreGitHubAppConfig(ctx, k8sClient, namespace)).To(Succeed())
+		Expect(StoreGitHubAppConfig(ctx, k8sClient, namespace)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: GitHubAppSecretName, Namespace: namespace}, secret)).To(Succeed())
+		Expect(secret.Data).To(Equal(map[string][]byte{
+			GitHubAppSecretAppIDKey:          []byte("1234"),
+			GitHubAppSecretInstallationIDKey: []byte("5678"),
+			GitHubAppSecretPrivateKeyKey:     []byte("the-key"),
+		}))
+	})
+})
+
+var _ = Describe("Plain git credentials", func() {
+	var (
+		logger    *loggerfakes.FakeLogger
+		as        *authSvc
+		deployKey *gogitssh.PublicKeys
+		repoUrl   gitproviders.RepoURL
+		authSock  string
+		sockSet   bool
+	)
+
+	BeforeEach(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		deployKey, err = makePublicKey(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+		Expect(err).NotTo(HaveOccurred())
+
+		viper.Set("git-host-types", "git.acme.org=git")
+		repoUrl, err = gitproviders.NewRepoURL("ssh://git@git.acme.org/config.git")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repoUrl.Provider()).To(Equal(gitproviders.GitProviderGit))
+
+		logger = &loggerfakes.FakeLogger{}
+		as = &authSvc{logger: logger}
+
+		authSock, sockSet = os.LookupEnv("SSH_AUTH_SOCK")
+	})
+
+	AfterEach(func() {
+		viper.Set("git-host-types", "")
+
+		if sockSet {
+			Expect(os.Setenv("SSH_AUTH_SOCK", authSock)).To(Succeed())
+		} else {
+			Expect(os.Unsetenv("SSH_AUTH_SOCK")).To(Succeed())
+		}
+	})
+
+	It("offers the keys of the SSH agent before the deploy key", func() {
+		dir, err := ioutil.TempDir("", "ssh-agent-")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+
+		listener, err := net.Listen("unix", filepath.Join(dir, "agent.sock"))
+		Expect(err).NotTo(HaveOccurred())
+		defer listener.Close()
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		keyring := agent.NewKeyring()
+		Expect(keyring.Add(agent.AddedKey{PrivateKey: key})).To(Succeed())
+
+		agentKey, err := gossh.NewSignerFromKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+
+				go func() {
+					_ = agent.ServeAgent(keyring, conn)
+				}()
+			}
+		}()
+
+		Expect(os.Setenv("SSH_AUTH_SOCK", listener.Addr().String())).To(Succeed())
+
+		credentials := as.plainGitAuth(repoUrl, deployKey)
+		Expect(credentials).To(BeAssignableToTypeOf(&gogitssh.PublicKeysCallback{}))
+		Expect(credentials.(*gogitssh.PublicKeysCallback).User).To(Equal("git"))
+
+		signers, err := credentials.(*gogitssh.PublicKeysCallback).Callback()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signers).To(HaveLen(2))
+		Expect(signers[0].PublicKey().Marshal()).To(Equal(agentKey.PublicKey().Marshal()))
+		Expect(signers[1]).To(BeIdenticalTo(deployKey.Signer))
+	})
+
+	It("falls back to the deploy key without an SSH agent", func() {
+		Expect(os.Unsetenv("SSH_AUTH_SOCK")).To(Succeed())
+
+		Expect(as.plainGitAuth(repoUrl, deployKey)).To(BeIdenticalTo(deployKey))
+		Expect(logger.WarningfCallCount()).To(Equal(1))
+	})
+})
+
+type fakeTokenSource struct {
+	token     string
+	expiresAt time.Time
+}
+
+func (s *fakeTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	return s.token, s.expiresAt, nil
+}
+
+// tokenSources returns the source for all the hosts.
+func tokenSources(source GitTokenSource) GitTokenSources {
+	return func(host string) (GitTokenSource, error) {
+		return source, nil
+	}
+}
@@ -0,0 +1,255 @@
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+// tokenRefreshMargin is how long before they expire tokens are refreshed.
+const tokenRefreshMargin = 10 * time.Minute
+
+// GitTokenSource returns the tokens git operations over HTTPS are authenticated with.
+type GitTokenSource interface {
+	// Token returns a valid token and when it expires, or the zero time when it does not expire.
+	Token(ctx context.Context) (string, time.Time, error)
+}
+
+// GitTokenSources returns the token source for the git provider at host, or nil when there is none.
+type GitTokenSources func(host string) (GitTokenSource, error)
+
+type staticTokenSource string
+
+// NewStaticTokenSource returns a source of a token that does not expire, like the token of a git provider.
+func NewStaticTokenSource(token string) GitTokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	if s == "" {
+		return "", time.Time{}, errors.New("the git provider token is empty")
+	}
+
+	return string(s), time.Time{}, nil
+}
+
+// githubAppTokenSource returns the installation tokens of a GitHub App. They expire after an hour, so they
+// are cached and refreshed shortly before they expire.
+type githubAppTokenSource struct {
+	http           *http.Client
+	clock          clock.Clock
+	apiURL         string
+	appID          string
+	installationID string
+	key            *rsa.PrivateKey
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewGitHubAppTokenSource returns a source of the installation tokens of a GitHub App, authenticated with
+// the PEM encoded private key of the app. apiURL is the URL of the GitHub API, e.g. https://api.github.com.
+func NewGitHubAppTokenSource(client *http.Client, apiURL string, appID, installationID int64, privateKey []byte) (GitTokenSource, error) {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub App private key: %w", err)
+	}
+
+	return &githubAppTokenSource{
+		http:           client,
+		clock:          clock.New(),
+		apiURL:         apiURL,
+		appID:          strconv.FormatInt(appID, 10),
+		installationID: strconv.FormatInt(installationID, 10),
+		key:            key,
+	}, nil
+}
+
+// GitHubAppTokenSourceFromConfig returns the token source of the GitHub App configured with the
+// github-app-id, github-app-installation-id and github-app-private-key-file settings, for the GitHub
+// instance at host. It returns nil when no GitHub App is configured.
+func GitHubAppTokenSourceFromConfig(host string) (GitTokenSource, error) {
+	if viper.GetString("github-app-id") == "" {
+		return nil, nil
+	}
+
+	appID := viper.GetInt64("github-app-id")
+	installationID := viper.GetInt64("github-app-installation-id")
+
+	if appID == 0 || installationID == 0 {
+		return nil, errors.New("the GitHub App ID and installation ID must be numbers")
+	}
+
+	key, err := ioutil.ReadFile(viper.GetString("github-app-private-key-file"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the GitHub App private key: %w", err)
+	}
+
+	apiURL := "https://api.github.com"
+	if host != "" && host != "github.com" {
+		apiURL = fmt.Sprintf("https://%s/api/v3", host)
+	}
+
+	return NewGitHubAppTokenSource(http.DefaultClient, apiURL, appID, installationID, key)
+}
+
+// GitHubAppTokenSourcesFromConfig returns the token sources of the GitHub App configured like in
+// GitHubAppTokenSourceFromConfig for each GitHub instance. The sources are kept so that their tokens
+// are reused.
+func GitHubAppTokenSourcesFromConfig() GitTokenSources {
+	var mu sync.Mutex
+
+	sources := map[string]GitTokenSource{}
+
+	return func(host string) (GitTokenSource, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if source, ok := sources[host]; ok {
+			return source, nil
+		}
+
+		source, err := GitHubAppTokenSourceFromConfig(host)
+		if err != nil {
+			return nil, err
+		}
+
+		sources[host] = source
+
+		return source, nil
+	}
+}
+
+func (s *githubAppTokenSource) Token(ctx context.Context) (string, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && s.clock.Now().Add(tokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, s.expiresAt, nil
+	}
+
+	token, expiresAt, err := s.installationToken(ctx)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get a GitHub App installation token: %w", err)
+	}
+
+	s.token = token
+	s.expiresAt = expiresAt
+
+	return token, expiresAt, nil
+}
+
+func (s *githubAppTokenSource) installationToken(ctx context.Context) (string, time.Time, error) {
+	now := s.clock.Now()
+
+	// The issue time is set in the past to allow for clock drift
+	appToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{
+		IssuedAt:  now.Add(-time.Minute).Unix(),
+		ExpiresAt: now.Add(9 * time.Minute).Unix(),
+		Issuer:    s.appID,
+	}).SignedString(s.key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%s/access_tokens", s.apiURL, s.installationID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("Authorization", "Bearer "+appToken)
+
+	res, err := s.http.Do(req)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		return "", time.Time{}, fmt.Errorf("request failed with status code: %v", res.StatusCode)
+	}
+
+	var body struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to decode the installation token: %w", err)
+	}
+
+	return body.Token, body.ExpiresAt, nil
+}
+
+// tokenAuth authenticates git operations over HTTPS with the tokens of a source, so that short-lived tokens
+// are refreshed during long-running operations.
+type tokenAuth struct {
+	username string
+	source   GitTokenSource
+
+	mu    sync.Mutex
+	token string
+}
+
+func newTokenAuth(provider gitproviders.GitProviderName, source GitTokenSource, token string) *tokenAuth {
+	return &tokenAuth{username: tokenUsername(provider), source: source, token: token}
+}
+
+func (a *tokenAuth) Name() string {
+	return "http-token-auth"
+}
+
+func (a *tokenAuth) String() string {
+	return fmt.Sprintf("%s - %s:*******", a.Name(), a.username)
+}
+
+// SetAuth sets the current token on the request. When the token cannot be refreshed, the last one is used
+// and the request fails if it expired.
+func (a *tokenAuth) SetAuth(r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if token, _, err := a.source.Token(r.Context()); err == nil {
+		a.token = token
+	}
+
+	r.SetBasicAuth(a.username, a.token)
+}
+
+// RewriteURL returns the HTTPS URL of repositories referenced by their SSH URL.
+func (a *tokenAuth) RewriteURL(url string) string {
+	repo, err := gitproviders.NewRepoURL(url)
+	if err != nil {
+		return url
+	}
+
+	return repo.WithGitAuth(gitproviders.GitAuthToken).CloneURL()
+}
+
+// tokenUsername returns the username git providers expect tokens to be sent with.
+func tokenUsername(provider gitproviders.GitProviderName) string {
+	switch provider {
+	case gitproviders.GitProviderGitHub:
+		return "x-access-token"
+	case gitproviders.GitProviderGitLab:
+		return "oauth2"
+	}
+
+	return "git"
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// deployKeyPollInterval is how often sources are checked while they reconcile with a new deploy key.
+const deployKeyPollInterval = 2 * time.Second
+
+// ErrNoDeployKey is returned when rotating the deploy key of a repository that does not have one.
+var ErrNoDeployKey = errors.New("the repository has no deploy key")
+
+// RotateDeployKey replaces the deploy key of the repository. The new key is uploaded to the git provider and
+// stored in the Secret of the repository, and the old key is only deleted from the git provider once the
+// GitRepositories using the Secret reconciled with the new key. When they fail to, the old key is restored.
+func (a *authSvc) RotateDeployKey(ctx context.Context, namespace string, repo gitproviders.RepoURL, timeout time.Duration) error {
+	name := SecretName{Name: models.CreateRepoSecretName(repo), Namespace: namespace}
+
+	secret, err := a.retrieveDeployKey(ctx, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: secret %s not found", ErrNoDeployKey, name)
+		}
+
+		return err
+	}
+
+	oldPublicKey := extractPublicKey(secret)
+	if gitproviders.GitAuth(secret.Labels[GitAuthLabel]).IsToken() || len(oldPublicKey) == 0 {
+		return fmt.Errorf("%w: secret %s does not hold a deploy key", ErrNoDeployKey, name)
+	}
+
+	_, newSecret, err := a.generateDeployKey(name, repo)
+	if err != nil {
+		return fmt.Errorf("error generating deploy key: %w", err)
+	}
+
+	newPublicKey := extractPublicKey(newSecret)
+
+	if err := a.gitProvider.UploadDeployKey(ctx, repo, newPublicKey); err != nil {
+		return fmt.Errorf("error uploading deploy key: %w", err)
+	}
+
+	oldData := secretData(secret)
+	secret.Data = secretData(newSecret)
+	secret.StringData = nil
+
+	if err := a.k8sClient.Update(ctx, secret); err != nil {
+		return a.abortRotation(ctx, repo, newPublicKey, nil, fmt.Errorf("error storing deploy key: %w", err))
+	}
+
+	a.logger.Actionf("Deploy key of %s replaced in secret %s", repo, name)
+
+	sources, err := a.deployKeySources(ctx, name)
+	if err != nil {
+		return a.abortRotation(ctx, repo, newPublicKey, restoreData(secret, oldData), err)
+	}
+
+	for i := range sources {
+		if err := a.reconcileSource(ctx, &sources[i], timeout); err != nil {
+			return a.abortRotation(ctx, repo, newPublicKey, restoreData(secret, oldData), err)
+		}
+	}
+
+	if err := a.gitProvider.DeleteDeployKey(ctx, repo, oldPublicKey); err != nil {
+		return fmt.Errorf("error deleting the old deploy key: %w", err)
+	}
+
+	a.logger.Successf("Deploy key of %s rotated", repo)
+
+	return nil
+}
+
+// abortRotation deletes the new deploy key from the git provider and restores the Secret, so that the
+// sources keep using the old key.
+func (a *authSvc) abortRotation(ctx context.Context, repo gitproviders.RepoURL, newPublicKey []byte, secret *corev1.Secret, cause error) error {
+	if secret != nil {
+		if err := a.k8sClient.Update(ctx, secret); err != nil {
+			return fmt.Errorf("%s, and restoring the old deploy key failed: %w", cause, err)
+		}
+	}
+
+	if err := a.gitProvider.DeleteDeployKey(ctx, repo, newPublicKey); err != nil {
+		a.logger.Warningf("The new deploy key of %s could not be deleted from the git provider: %v", repo, err)
+	}
+
+	return fmt.Errorf("%w; the old deploy key was kept", cause)
+}
+
+// deployKeySources returns the GitRepositories authenticating with the deploy key in the Secret.
+func (a *authSvc) deployKeySources(ctx context.Context, name SecretName) ([]sourcev1.GitRepository, error) {
+	list := &sourcev1.GitRepositoryList{}
+	if err := a.k8sClient.List(ctx, list, client.InNamespace(name.Namespace)); err != nil {
+		return nil, fmt.Errorf("could not list git repositories: %w", err)
+	}
+
+	sources := []sourcev1.GitRepository{}
+
+	for _, source := range list.Items {
+		if source.Spec.SecretRef != nil && source.Spec.SecretRef.Name == name.Name.String() {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources, nil
+}
+
+// reconcileSource requests a reconciliation of the source and waits for it to be ready.
+func (a *authSvc) reconcileSource(ctx context.Context, source *sourcev1.GitRepository, timeout time.Duration) error {
+	requestedAt := a.clock.Now().Format(time.RFC3339Nano)
+
+	// Patch the annotation so that concurrent changes to the source, e.g. by flux, are not overwritten
+	patch := client.MergeFrom(source.DeepCopy())
+
+	annotations := source.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[meta.ReconcileRequestAnnotation] = requestedAt
+	source.SetAnnotations(annotations)
+
+	if err := a.k8sClient.Patch(ctx, source, patch); err != nil {
+		return fmt.Errorf("could not request the reconciliation of %s/%s: %w", source.Namespace, source.Name, err)
+	}
+
+	a.logger.Waitingf("Waiting for GitRepository %s/%s to reconcile with the new deploy key", source.Namespace, source.Name)
+
+	message := "not reconciled"
+
+	err := utils.Poll(a.clock, deployKeyPollInterval, timeout, func() (bool, error) {
+		if err := a.k8sClient.Get(ctx, client.ObjectKeyFromObject(source), source); err != nil {
+			return false, err
+		}
+
+		if source.Status.LastHandledReconcileAt != requestedAt {
+			return false, nil
+		}
+
+		ready := apimeta.FindStatusCondition(source.Status.Conditions, meta.ReadyCondition)
+		if ready == nil {
+			return false, nil
+		}
+
+		message = ready.Message
+
+		return ready.Status == metav1.ConditionTrue, nil
+	})
+	if err != nil {
+		if errors.Is(err, utils.ErrPollTimeout) {
+			return fmt.Errorf("GitRepository %s/%s did not reconcile with the new deploy key after %s: %s", source.Namespace, source.Name, timeout, message)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// DeployKeyRepositories returns the repositories of the GitRepositories in the namespace that authenticate
+// with a deploy key.
+func DeployKeyRepositories(ctx context.Context, k8sClient client.Client, namespace string) ([]gitproviders.RepoURL, error) {
+	list := &sourcev1.GitRepositoryList{}
+	if err := k8sClient.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list git repositories: %w", err)
+	}
+
+	repos := []gitproviders.RepoURL{}
+	seen := map[string]bool{}
+
+	for _, source := range list.Items {
+		if source.Spec.SecretRef == nil {
+			continue
+		}
+
+		repo, err := gitproviders.NewRepoURL(source.Spec.URL)
+		if err != nil || seen[repo.String()] || source.Spec.SecretRef.Name != models.CreateRepoSecretName(repo).String() {
+			continue
+		}
+
+		mode, err := RepoGitAuth(ctx, k8sClient, namespace, repo)
+		if err != nil {
+			return nil, err
+		}
+
+		if !mode.IsToken() {
+			seen[repo.String()] = true
+			repos = append(repos, repo)
+		}
+	}
+
+	return repos, nil
+}
+
+// secretData returns the data of a Secret, including the StringData set by the Secrets flux generates.
+func secretData(secret *corev1.Secret) map[string][]byte {
+	data := map[string][]byte{}
+
+	for k, v := range secret.Data {
+		data[k] = v
+	}
+
+	for k, v := range secret.StringData {
+		data[k] = []byte(v)
+	}
+
+	return data
+}
+
+func restoreData(secret *corev1.Secret, data map[string][]byte) *corev1.Secret {
+	secret.Data = data
+
+	return secret
+}
@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/flux/fluxfakes"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders/gitprovidersfakes"
+	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// deployKeySecret returns a Secret like the ones generated by flux, and its public key.
+func deployKeySecret(name, namespace string) (*corev1.Secret, []byte) {
+	privateKey := sshSigningKey()
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	publicKey := ssh.MarshalAuthorizedKey(signer.PublicKey())
+
+	return &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		StringData: map[string]string{
+			"identity":     string(privateKey),
+			"identity.pub": string(publicKey),
+		},
+	}, publicKey
+}
+
+var _ = Describe("RotateDeployKey", func() {
+	var (
+		ctx          context.Context
+		namespace    string
+		repoUrl      gitproviders.RepoURL
+		secretName   string
+		oldPublicKey []byte
+		newPublicKey []byte
+		gp           *gitprovidersfakes.FakeGitProvider
+		mockClock    *clock.Mock
+		as           *authSvc
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		ctx = context.Background()
+		namespace = "rotate-test-" + utilrand.String(5)
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+
+		repoUrl, err = gitproviders.NewRepoURL("ssh://git@github.com/my-org/my-repo.git")
+		Expect(err).NotTo(HaveOccurred())
+
+		secretName = models.CreateRepoSecretName(repoUrl).String()
+
+		var secret *corev1.Secret
+		secret, oldPublicKey = deployKeySecret(secretName, namespace)
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+
+		newSecret, publicKey := deployKeySecret(secretName, namespace)
+		newPublicKey = publicKey
+
+		fluxClient := &fluxfakes.FakeFlux{}
+		fluxClient.CreateSecretGitStub = func(string, gitproviders.RepoURL, string) ([]byte, error) {
+			return yaml.Marshal(newSecret)
+		}
+
+		Expect(k8sClient.Create(ctx, &sourcev1.GitRepository{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-repo", Namespace: namespace},
+			Spec: sourcev1.GitRepositorySpec{
+				URL:       repoUrl.String(),
+				SecretRef: &meta.LocalObjectReference{Name: secretName},
+			},
+		})).To(Succeed())
+
+		gp = &gitprovidersfakes.FakeGitProvider{}
+		mockClock = clock.NewMock()
+		as = &authSvc{
+			logger:      &loggerfakes.FakeLogger{},
+			fluxClient:  fluxClient,
+			k8sClient:   k8sClient,
+			gitProvider: gp,
+			clock:       mockClock,
+		}
+	})
+
+	rotate := func() chan error {
+		done := make(chan error, 1)
+
+		go func() {
+			done <- as.RotateDeployKey(ctx, namespace, repoUrl, time.Minute)
+		}()
+
+		return done
+	}
+
+	storedPublicKey := func() []byte {
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: namespace}, secret)).To(Succeed())
+
+		return extractPublicKey(secret)
+	}
+
+	It("deletes the old key once the source reconciled with the new key", func() {
+		done := rotate()
+
+		source := &sourcev1.GitRepository{}
+
+		Eventually(func() string {
+			mockClock.Add(deployKeyPollInterval)
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "my-repo", Namespace: namespace}, source)).To(Succeed())
+
+			return source.Annotations[meta.ReconcileRequestAnnotation]
+		}).ShouldNot(BeEmpty())
+
+		Expect(gp.DeleteDeployKeyCallCount()).To(Equal(0))
+
+		source.Status.LastHandledReconcileAt = source.Annotations[meta.ReconcileRequestAnnotation]
+		source.Status.Conditions = []metav1.Condition{{
+			Type:               meta.ReadyCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "Succeeded",
+			LastTransitionTime: metav1.Now(),
+		}}
+		Expect(k8sClient.Status().Update(ctx, source)).To(Succeed())
+
+		Eventually(func() chan error {
+			mockClock.Add(deployKeyPollInterval)
+			return done
+		}).Should(Receive(BeNil()))
+
+		_, _, uploaded := gp.UploadDeployKeyArgsForCall(0)
+		Expect(uploaded).To(Equal(newPublicKey))
+
+		_, _, deleted := gp.DeleteDeployKeyArgsForCall(0)
+		Expect(deleted).To(Equal(oldPublicKey))
+
+		Expect(storedPublicKey()).To(Equal(newPublicKey))
+	})
+
+	It("keeps the old key when the source does not reconcile", func() {
+		done := rotate()
+
+		var err error
+
+		Eventually(func() chan error {
+			mockClock.Add(deployKeyPollInterval)
+			return done
+		}).Should(Receive(&err))
+
+		Expect(err).To(MatchError(ContainSubstring("did not reconcile with the new deploy key after 1m0s")))
+		Expect(err).To(MatchError(ContainSubstring("the old deploy key was kept")))
+
+		Expect(gp.DeleteDeployKeyCallCount()).To(Equal(1))
+		_, _, deleted := gp.DeleteDeployKeyArgsForCall(0)
+		Expect(deleted).To(Equal(newPublicKey))
+
+		Expect(storedPublicKey()).To(Equal(oldPublicKey))
+	})
+
+	It("requests the reconciliation of a source that changed since it was read", func() {
+		stale := &sourcev1.GitRepository{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "my-repo", Namespace: namespace}, stale)).To(Succeed())
+
+		changed := stale.DeepCopy()
+		changed.Labels = map[string]string{"changed": "true"}
+		Expect(k8sClient.Update(ctx, changed)).To(Succeed())
+
+		done := make(chan error, 1)
+
+		go func() {
+			done <- as.reconcileSource(ctx, stale, time.Minute)
+		}()
+
+		var err error
+
+		Eventually(func() chan error {
+			mockClock.Add(deployKeyPollInterval)
+			return done
+		}).Should(Receive(&err))
+
+		Expect(err).To(MatchError(ContainSubstring("did not reconcile with the new deploy key")))
+
+		source := &sourcev1.GitRepository{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "my-repo", Namespace: namespace}, source)).To(Succeed())
+		Expect(source.Labels).To(HaveKeyWithValue("changed", "true"))
+		Expect(source.Annotations).To(HaveKey(meta.ReconcileRequestAnnotation))
+	})
+
+	It("lists the repositories with a deploy key", func() {
+		repos, err := DeployKeyRepositories(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(repos).To(Equal([]gitproviders.RepoURL{repoUrl}))
+	})
+
+	It("fails for repositories authenticated with a token", func() {
+		as.providerToken = NewStaticTokenSource("the-token")
+
+		_, err := as.SetupGitCredentials(ctx, namespace, repoUrl.WithGitAuth(gitproviders.GitAuthToken))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(as.RotateDeployKey(ctx, namespace, repoUrl, time.Minute)).To(MatchError(ContainSubstring(ErrNoDeployKey.Error())))
+		Expect(gp.UploadDeployKeyCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SigningKeySecretName is the name of the Secret holding the key commits to config repositories are signed with.
+	SigningKeySecretName = "weave-gitops-signing-key"
+
+	// SigningKeySecretFormatKey is the format of the signing key, openpgp or ssh. Defaults to openpgp.
+	SigningKeySecretFormatKey = "format"
+	// SigningKeySecretKeyKey is the armored OpenPGP or the OpenSSH private key.
+	SigningKeySecretKeyKey = "key"
+	// SigningKeySecretPassphraseKey is the passphrase of the private key, when it is encrypted.
+	SigningKeySecretPassphraseKey = "passphrase"
+
+	// TrustedKeysConfigMapName is the name of the ConfigMap holding the public keys whose signatures are verified.
+	// It is kept apart from SigningKeySecretName so that verifying commits never reads the private key.
+	TrustedKeysConfigMapName = "weave-gitops-trusted-keys"
+	// TrustedKeysConfigMapKey are the armored OpenPGP or the authorized OpenSSH public keys, including the
+	// public key of the signing key.
+	TrustedKeysConfigMapKey = "trusted-keys"
+)
+
+// CommitSigner returns the signer of the commits made to config repositories. The key is read from the
+// file in the signing-key-file setting (the --signing-key-file flag or the GITOPS_SIGNING_KEY_FILE
+// environment variable) when it is set, otherwise from the SigningKeySecretName Secret in the namespace.
+// It returns a nil signer when no signing key is configured.
+func CommitSigner(ctx context.Context, k8sClient client.Client, namespace string) (git.Signer, error) {
+	if keyFile := viper.GetString("signing-key-file"); keyFile != "" {
+		return git.LoadSigner(signingFormat(viper.GetString("signing-key-format")), keyFile, []byte(viper.GetString("signing-key-passphrase")))
+	}
+
+	secret, err := signingKeySecret(ctx, k8sClient, namespace)
+	if err != nil || secret == nil {
+		return nil, err
+	}
+
+	signer, err := git.NewSigner(
+		signingFormat(string(secret.Data[SigningKeySecretFormatKey])),
+		secret.Data[SigningKeySecretKeyKey],
+		secret.Data[SigningKeySecretPassphraseKey],
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing key in secret %s/%s: %w", namespace, SigningKeySecretName, err)
+	}
+
+	return signer, nil
+}
+
+// CommitVerifier returns a verifier trusting the public keys of the TrustedKeysConfigMapName ConfigMap.
+// It trusts no key when the ConfigMap does not exist.
+func CommitVerifier(ctx context.Context, k8sClient client.Client, namespace string) (*git.Verifier, error) {
+	configMap := &corev1.ConfigMap{}
+
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: TrustedKeysConfigMapName, Namespace: namespace}, configMap)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return git.NewVerifier()
+		}
+
+		return nil, fmt.Errorf("could not get the trusted keys config map: %w", err)
+	}
+
+	return git.NewVerifier([]byte(configMap.Data[TrustedKeysConfigMapKey]))
+}
+
+// signingKeySecret returns the SigningKeySecretName Secret, or nil if it does not exist.
+func signingKeySecret(ctx context.Context, k8sClient client.Client, namespace string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	err := k8sClient.Get(ctx, types.NamespacedName{Name: SigningKeySecretName, Namespace: namespace}, secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("could not get the signing key secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+func signingFormat(format string) git.SigningFormat {
+	if format == "" {
+		return git.SigningFormatOpenPGP
+	}
+
+	return git.SigningFormat(format)
+}
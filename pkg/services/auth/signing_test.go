@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+func sshSigningKey() []byte {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+var _ = Describe("CommitSigner", func() {
+	var (
+		ctx       context.Context
+		namespace string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		namespace = "signing-test-" + utilrand.String(5)
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+	})
+
+	It("returns no signer when no signing key is configured", func() {
+		signer, err := CommitSigner(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer).To(BeNil())
+	})
+
+	It("loads the signing key from the secret", func() {
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecretName, Namespace: namespace},
+			Data: map[string][]byte{
+				SigningKeySecretFormatKey: []byte("ssh"),
+				SigningKeySecretKeyKey:    sshSigningKey(),
+			},
+		})).To(Succeed())
+
+		signer, err := CommitSigner(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer).NotTo(BeNil())
+	})
+
+	It("fails when the secret has an invalid key", func() {
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecretName, Namespace: namespace},
+			Data:       map[string][]byte{SigningKeySecretKeyKey: []byte("not a key")},
+		})).To(Succeed())
+
+		_, err := CommitSigner(ctx, k8sClient, namespace)
+		Expect(err).To(MatchError(ContainSubstring("invalid signing key in secret " + namespace + "/" + SigningKeySecretName)))
+	})
+
+	It("prefers the signing key file", func() {
+		dir, err := ioutil.TempDir("", "signing-key")
+		Expect(err).NotTo(HaveOccurred())
+
+		defer os.RemoveAll(dir)
+
+		keyFile := filepath.Join(dir, "key")
+		Expect(ioutil.WriteFile(keyFile, sshSigningKey(), 0600)).To(Succeed())
+
+		viper.Set("signing-key-file", keyFile)
+		viper.Set("signing-key-format", "ssh")
+
+		defer viper.Set("signing-key-file", "")
+
+		signer, err := CommitSigner(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(signer).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("CommitVerifier", func() {
+	var (
+		ctx       context.Context
+		namespace string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		namespace = "verifying-test-" + utilrand.String(5)
+		Expect(k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})).To(Succeed())
+	})
+
+	It("trusts no key when no trusted keys are configured", func() {
+		signer, err := git.NewSigner(git.SigningFormatSSH, sshSigningKey(), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		signature, err := signer.Sign(bytes.NewReader([]byte("payload")))
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier, err := CommitVerifier(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(verifier.Verify([]byte("payload"), signature)).NotTo(Succeed())
+	})
+
+	It("verifies the signatures of the trusted keys without reading the signing key", func() {
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecretName, Namespace: namespace},
+			Data:       map[string][]byte{SigningKeySecretKeyKey: []byte("not a key")},
+		})).To(Succeed())
+
+		signers := []git.Signer{}
+		trustedKeys := []byte{}
+
+		for i := 0; i < 2; i++ {
+			signer, err := git.NewSigner(git.SigningFormatSSH, sshSigningKey(), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, err := signer.PublicKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			signers = append(signers, signer)
+			trustedKeys = append(trustedKeys, publicKey...)
+		}
+
+		Expect(k8sClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: TrustedKeysConfigMapName, Namespace: namespace},
+			Data:       map[string]string{TrustedKeysConfigMapKey: string(trustedKeys)},
+		})).To(Succeed())
+
+		verifier, err := CommitVerifier(ctx, k8sClient, namespace)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, s := range signers {
+			signature, err := s.Sign(bytes.NewReader([]byte("payload")))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(verifier.Verify([]byte("payload"), signature)).To(Succeed())
+		}
+	})
+})
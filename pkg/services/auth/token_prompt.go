@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+)
+
+// NewTokenPromptHandler returns an auth handler for git providers without an OAuth flow we can drive from the CLI,
+// such as self-hosted Bitbucket Server and Gitea instances. The user is asked to paste a personal access token.
+func NewTokenPromptHandler(name gitproviders.GitProviderName, in io.Reader) BlockingCLIAuthHandler {
+	return func(ctx context.Context, w io.Writer) (string, error) {
+		fmt.Fprintf(w, "Enter a personal access token for %s: ", name)
+
+		token, err := bufio.NewReader(in).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("could not read token: %w", err)
+		}
+
+		token = strings.TrimSpace(token)
+		if token == "" {
+			return "", errors.New("no token provided")
+		}
+
+		return token, nil
+	}
+}
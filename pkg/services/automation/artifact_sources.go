@@ -0,0 +1,136 @@
+package automation
+
+import (
+	"fmt"
+	"time"
+
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	artifactSourceInterval = 30 * time.Second
+	artifactSyncInterval   = time.Minute
+)
+
+// ociRepository mirrors the OCIRepository API of the source-controller, which
+// is newer than the source-controller API this module depends on.
+type ociRepository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ociRepositorySpec `json:"spec"`
+}
+
+type ociRepositorySpec struct {
+	URL       string                     `json:"url"`
+	Reference *ociRepositoryRef          `json:"ref,omitempty"`
+	SecretRef *meta.LocalObjectReference `json:"secretRef,omitempty"`
+	Interval  metav1.Duration            `json:"interval"`
+}
+
+type ociRepositoryRef struct {
+	Tag string `json:"tag,omitempty"`
+}
+
+func generateOCIRepository(app models.Application, secretRef string) ([]byte, error) {
+	source, err := models.ParseOCIURL(app.ArtifactSourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := ociRepository{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kube.GVROCIRepository.GroupVersion().String(),
+			Kind:       string(ResourceKindOCIRepository),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+		},
+		Spec: ociRepositorySpec{
+			URL:       source.Repository,
+			Reference: &ociRepositoryRef{Tag: source.Tag},
+			SecretRef: localObjectReference(secretRef),
+			Interval:  metav1.Duration{Duration: artifactSourceInterval},
+		},
+	}
+
+	return marshalArtifactManifest(repository)
+}
+
+func generateBucket(app models.Application, secretRef string) ([]byte, error) {
+	source, err := models.ParseBucketURL(app.ArtifactSourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket := sourcev1.Bucket{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: sourcev1.GroupVersion.String(),
+			Kind:       sourcev1.BucketKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+		},
+		Spec: sourcev1.BucketSpec{
+			Provider:   sourcev1.GenericBucketProvider,
+			BucketName: source.BucketName,
+			Endpoint:   source.Endpoint,
+			Insecure:   source.Insecure,
+			SecretRef:  localObjectReference(secretRef),
+			Interval:   metav1.Duration{Duration: artifactSourceInterval},
+		},
+	}
+
+	return marshalArtifactManifest(bucket)
+}
+
+// generateArtifactKustomization generates the Kustomization of an application
+// whose source is an OCI repository or a bucket, as the flux CLI can only
+// reference git, helm and bucket sources.
+func generateArtifactKustomization(app models.Application) ([]byte, error) {
+	kustomization := kustomizev2.Kustomization{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kustomizev2.GroupVersion.String(),
+			Kind:       kustomizev2.KustomizationKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+		},
+		Spec: kustomizev2.KustomizationSpec{
+			SourceRef: kustomizev2.CrossNamespaceSourceReference{
+				Kind: string(SourceKind(app)),
+				Name: app.Name,
+			},
+			Path:     app.Path,
+			Prune:    true,
+			Interval: metav1.Duration{Duration: artifactSyncInterval},
+		},
+	}
+
+	return marshalArtifactManifest(kustomization)
+}
+
+func localObjectReference(name string) *meta.LocalObjectReference {
+	if name == "" {
+		return nil
+	}
+
+	return &meta.LocalObjectReference{Name: name}
+}
+
+func marshalArtifactManifest(obj interface{}) ([]byte, error) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal source manifest: %w", err)
+	}
+
+	return sanitizeK8sYaml(data), nil
+}
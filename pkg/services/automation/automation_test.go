@@ -361,6 +361,241 @@ var _ = Describe("Generate manifests", func() {
 	})
 })
 
+var _ = Describe("Generate manifests for artifact sources", func() {
+	var app models.Application
+
+	BeforeEach(func() {
+		app = models.Application{
+			AutomationType:  models.AutomationTypeKustomize,
+			ConfigRepo:      createRepoURL("ssh://git@github.com/owner/config-repo.git"),
+			Name:            "podinfo",
+			Namespace:       wego.DefaultNamespace,
+			Path:            "./deploy",
+			SourceType:      models.SourceTypeOCI,
+			SourceSecretRef: "registry-credentials",
+		}
+
+		ctx = context.Background()
+	})
+
+	It("creates an OCIRepository and a kustomization referencing it when source type is oci", func() {
+		app.ArtifactSourceURL = "oci://ghcr.io/org/manifests/podinfo:v6.0.0"
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(fluxClient.CreateKustomizationCallCount()).To(Equal(0))
+		Expect(gitProviders.GetRepoVisibilityCallCount()).To(Equal(0))
+
+		Expect(string(results.AppSource.Content)).To(Equal(`---
+apiVersion: source.toolkit.fluxcd.io/v1beta2
+kind: OCIRepository
+metadata:
+  name: podinfo
+  namespace: ` + wego.DefaultNamespace + `
+spec:
+  interval: 30s
+  ref:
+    tag: v6.0.0
+  secretRef:
+    name: registry-credentials
+  url: oci://ghcr.io/org/manifests/podinfo
+`))
+
+		Expect(string(results.AppAutomation.Content)).To(ContainSubstring(`  sourceRef:
+    kind: OCIRepository
+    name: podinfo
+`))
+		Expect(string(results.AppAutomation.Content)).To(ContainSubstring("path: ./deploy\n"))
+
+		wegoApp := AppToWegoApp(app)
+		Expect(wegoApp.Spec.URL).To(Equal("oci://ghcr.io/org/manifests/podinfo:v6.0.0"))
+		Expect(wegoApp.Spec.SourceType).To(Equal(wego.SourceTypeOCI))
+	})
+
+	It("creates a Bucket when source type is bucket", func() {
+		app.SourceType = models.SourceTypeBucket
+		app.ArtifactSourceURL = "http://minio.minio.svc:9000/podinfo"
+		app.SourceSecretRef = ""
+
+		results, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		source := string(results.AppSource.Content)
+		Expect(source).To(ContainSubstring("kind: Bucket\n"))
+		Expect(source).To(ContainSubstring("bucketName: podinfo\n"))
+		Expect(source).To(ContainSubstring("endpoint: minio.minio.svc:9000\n"))
+		Expect(source).To(ContainSubstring("insecure: true\n"))
+		Expect(source).To(ContainSubstring("provider: generic\n"))
+		Expect(source).NotTo(ContainSubstring("secretRef"))
+
+		Expect(string(results.AppAutomation.Content)).To(ContainSubstring("kind: Bucket\n"))
+	})
+
+	It("fails for a helm release with an oci source", func() {
+		app.AutomationType = models.AutomationTypeHelm
+		app.ArtifactSourceURL = "oci://ghcr.io/org/manifests/podinfo"
+
+		_, err := automationGen.GenerateApplicationAutomation(ctx, app, "test-cluster")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("round trips the source url through the Application resource", func() {
+		app.ArtifactSourceURL = "oci://ghcr.io/org/manifests/podinfo"
+
+		converted, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(converted.ArtifactSourceURL).To(Equal(app.ArtifactSourceURL))
+		Expect(GetAppHash(converted)).To(Equal(GetAppHash(app)))
+		Expect(SourceKind(converted)).To(Equal(ResourceKindOCIRepository))
+	})
+})
+
+var _ = Describe("AddHelmValues", func() {
+	helmRelease := []byte(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: loki
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: loki
+      sourceRef:
+        kind: HelmRepository
+        name: loki
+  interval: 5m0s
+`)
+
+	It("leaves the manifest untouched without values", func() {
+		manifest, err := AddHelmValues(helmRelease, models.Application{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifest).To(Equal(helmRelease))
+	})
+
+	It("sets the values and the values references of the helm release", func() {
+		manifest, err := AddHelmValues(helmRelease, models.Application{
+			HelmValues: map[string]interface{}{
+				"persistence": map[string]interface{}{"enabled": true},
+			},
+			HelmValuesFrom: []models.HelmValuesReference{
+				{Kind: "ConfigMap", Name: "loki-values"},
+			},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(manifest)).To(HavePrefix("---\n"))
+		Expect(string(manifest)).To(ContainSubstring(`  values:
+    persistence:
+      enabled: true
+  valuesFrom:
+  - kind: ConfigMap
+    name: loki-values
+`))
+		Expect(string(manifest)).NotTo(ContainSubstring("status:"))
+	})
+})
+
+var _ = Describe("AddDependsOn", func() {
+	kustomization := []byte(`---
+apiVersion: kustomize.toolkit.fluxcd.io/v1beta2
+kind: Kustomization
+metadata:
+  name: podinfo
+  namespace: wego-system
+spec:
+  interval: 1m0s
+  path: ./deploy
+  prune: true
+  sourceRef:
+    kind: GitRepository
+    name: podinfo
+`)
+
+	helmRelease := []byte(`---
+apiVersion: helm.toolkit.fluxcd.io/v2beta1
+kind: HelmRelease
+metadata:
+  name: loki
+  namespace: wego-system
+spec:
+  chart:
+    spec:
+      chart: loki
+      sourceRef:
+        kind: HelmRepository
+        name: loki
+  interval: 5m0s
+`)
+
+	It("leaves the manifest untouched without dependencies", func() {
+		manifest, err := AddDependsOn(kustomization, models.Application{AutomationType: models.AutomationTypeKustomize})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(manifest).To(Equal(kustomization))
+	})
+
+	It("sets the dependencies of a kustomization", func() {
+		manifest, err := AddDependsOn(kustomization, models.Application{
+			AutomationType: models.AutomationTypeKustomize,
+			DependsOn:      []string{"cert-manager", "ingress-nginx"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(manifest)).To(HavePrefix("---\n"))
+		Expect(string(manifest)).To(ContainSubstring(`spec:
+  dependsOn:
+  - name: cert-manager
+  - name: ingress-nginx
+`))
+		Expect(string(manifest)).NotTo(ContainSubstring("status:"))
+	})
+
+	It("sets the dependencies of a helm release", func() {
+		manifest, err := AddDependsOn(helmRelease, models.Application{
+			AutomationType: models.AutomationTypeHelm,
+			DependsOn:      []string{"cert-manager"},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(string(manifest)).To(ContainSubstring(`  dependsOn:
+  - name: cert-manager
+`))
+		Expect(string(manifest)).NotTo(ContainSubstring("status:"))
+	})
+
+	It("round trips the dependencies through the Application resource", func() {
+		app := models.Application{
+			Name:           "podinfo",
+			GitSourceURL:   createRepoURL("https://github.com/foo/podinfo"),
+			SourceType:     models.SourceTypeGit,
+			AutomationType: models.AutomationTypeKustomize,
+			DependsOn:      []string{"cert-manager"},
+		}
+
+		converted, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(converted.DependsOn).To(Equal(app.DependsOn))
+	})
+})
+
+var _ = Describe("Artifact source applications", func() {
+	It("round trips the source secret through the Application resource", func() {
+		app := models.Application{
+			Name:              "podinfo",
+			ArtifactSourceURL: "https://minio.example.com/podinfo",
+			SourceSecretRef:   "minio-credentials",
+			SourceType:        models.SourceTypeBucket,
+			AutomationType:    models.AutomationTypeKustomize,
+		}
+
+		converted, err := WegoAppToApp(AppToWegoApp(app))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(converted.ArtifactSourceURL).To(Equal(app.ArtifactSourceURL))
+		Expect(converted.SourceSecretRef).To(Equal("minio-credentials"))
+	})
+})
+
 var _ = Describe("Test app hash", func() {
 
 	It("should return right hash for a helm app", func() {
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/fluxcd/source-controller/pkg/sourceignore"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
@@ -19,6 +22,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/models"
 
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/kustomize/api/types"
@@ -46,6 +50,8 @@ const (
 	ResourceKindSecret         ResourceKind = "Secret"
 	ResourceKindGitRepository  ResourceKind = "GitRepository"
 	ResourceKindHelmRepository ResourceKind = "HelmRepository"
+	ResourceKindBucket         ResourceKind = "Bucket"
+	ResourceKindOCIRepository  ResourceKind = "OCIRepository"
 	ResourceKindKustomization  ResourceKind = "Kustomization"
 	ResourceKindHelmRelease    ResourceKind = "HelmRelease"
 )
@@ -79,11 +85,15 @@ func NewAutomationGenerator(gp gitproviders.GitProvider, flux flux.Flux, logger
 }
 
 func (a *AutomationGen) getAppSecretRef(ctx context.Context, app models.Application) (models.GeneratedSecretName, error) {
-	if app.SourceType != models.SourceTypeHelm {
+	switch {
+	case app.SourceType == models.SourceTypeHelm:
+		return "", nil
+	case app.IsArtifactSource():
+		// The credentials of OCI repositories and buckets are created by the user
+		return models.GeneratedSecretName(app.SourceSecretRef), nil
+	default:
 		return a.GetSecretRefForPrivateGitSources(ctx, app.GitSourceURL)
 	}
-
-	return "", nil
 }
 
 func (a *AutomationGen) GetSecretRefForPrivateGitSources(ctx context.Context, url gitproviders.RepoURL) (models.GeneratedSecretName, error) {
@@ -120,6 +130,10 @@ func (a *AutomationGen) generateAppSource(ctx context.Context, app models.Applic
 		}
 	case models.SourceTypeHelm:
 		source, err = a.Flux.CreateSourceHelm(app.Name, app.HelmSourceURL, app.Namespace)
+	case models.SourceTypeOCI:
+		source, err = generateOCIRepository(app, appSecretRef.String())
+	case models.SourceTypeBucket:
+		source, err = generateBucket(app, appSecretRef.String())
 	default:
 		return models.Manifest{}, fmt.Errorf("unknown source type: %v", app.SourceType)
 	}
@@ -187,6 +201,83 @@ func AddWegoIgnore(sourceManifest []byte) ([]byte, error) {
 	return updatedManifest, nil
 }
 
+// AddHelmValues sets the values of the application on a HelmRelease manifest.
+func AddHelmValues(helmReleaseManifest []byte, app models.Application) ([]byte, error) {
+	if len(app.HelmValues) == 0 && len(app.HelmValuesFrom) == 0 {
+		return helmReleaseManifest, nil
+	}
+
+	var helmRelease helmv2.HelmRelease
+
+	if err := yaml.Unmarshal(helmReleaseManifest, &helmRelease); err != nil {
+		return nil, err
+	}
+
+	if len(app.HelmValues) > 0 {
+		raw, err := json.Marshal(app.HelmValues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal helm values: %w", err)
+		}
+
+		helmRelease.Spec.Values = &apiextensionsv1.JSON{Raw: raw}
+	}
+
+	for _, ref := range app.HelmValuesFrom {
+		helmRelease.Spec.ValuesFrom = append(helmRelease.Spec.ValuesFrom, helmv2.ValuesReference{
+			Kind: ref.Kind,
+			Name: ref.Name,
+		})
+	}
+
+	updatedManifest, err := yaml.Marshal(helmRelease)
+	if err != nil {
+		return nil, err
+	}
+
+	return sanitizeK8sYaml(updatedManifest), nil
+}
+
+// AddDependsOn sets the applications the application depends on on a Kustomization or HelmRelease manifest.
+// Flux only resolves dependencies on objects of the same kind, so the dependencies must be deployed with
+// the same automation type as the application.
+func AddDependsOn(deployManifest []byte, app models.Application) ([]byte, error) {
+	if len(app.DependsOn) == 0 {
+		return deployManifest, nil
+	}
+
+	_, dependsOn := app.GetDependsOn()
+
+	var deploy interface{}
+
+	switch app.AutomationType {
+	case models.AutomationTypeKustomize:
+		kustomization := &kustomizev2.Kustomization{}
+		if err := yaml.Unmarshal(deployManifest, kustomization); err != nil {
+			return nil, err
+		}
+
+		kustomization.Spec.DependsOn = dependsOn
+		deploy = kustomization
+	case models.AutomationTypeHelm:
+		helmRelease := &helmv2.HelmRelease{}
+		if err := yaml.Unmarshal(deployManifest, helmRelease); err != nil {
+			return nil, err
+		}
+
+		helmRelease.Spec.DependsOn = dependsOn
+		deploy = helmRelease
+	default:
+		return nil, fmt.Errorf("invalid automation type: %v", app.AutomationType)
+	}
+
+	updatedManifest, err := yaml.Marshal(deploy)
+	if err != nil {
+		return nil, err
+	}
+
+	return sanitizeK8sYaml(updatedManifest), nil
+}
+
 func (a *AutomationGen) GenerateApplicationAutomation(ctx context.Context, app models.Application, clusterName string) (ApplicationAutomation, error) {
 	a.Logger.Generatef("Generating application spec manifest")
 
@@ -236,7 +327,11 @@ func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Ap
 
 	switch app.AutomationType {
 	case models.AutomationTypeKustomize:
-		b, err = a.Flux.CreateKustomization(app.Name, app.Name, app.Path, app.Namespace)
+		if app.IsArtifactSource() {
+			b, err = generateArtifactKustomization(app)
+		} else {
+			b, err = a.Flux.CreateKustomization(app.Name, app.Name, app.Path, app.Namespace)
+		}
 	case models.AutomationTypeHelm:
 		switch app.SourceType {
 		case models.SourceTypeHelm:
@@ -246,10 +341,18 @@ func (a *AutomationGen) generateAppAutomation(ctx context.Context, app models.Ap
 		default:
 			return models.Manifest{}, fmt.Errorf("invalid source type: %v", app.SourceType)
 		}
+
+		if err == nil {
+			b, err = AddHelmValues(b, app)
+		}
 	default:
 		return models.Manifest{}, fmt.Errorf("invalid automation type: %v", app.AutomationType)
 	}
 
+	if err == nil {
+		b, err = AddDependsOn(b, app)
+	}
+
 	return models.Manifest{Path: AppAutomationDeployPath(app), Content: sanitizeWegoDirectory(b)}, err
 }
 
@@ -270,18 +373,22 @@ func generateAppYaml(app models.Application) (models.Manifest, error) {
 
 func WegoAppToApp(app wego.Application) (models.Application, error) {
 	var (
-		helmRepoUrl   string
-		appRepoUrl    gitproviders.RepoURL
-		configRepoUrl gitproviders.RepoURL
-		err           error
+		helmRepoUrl       string
+		artifactSourceUrl string
+		appRepoUrl        gitproviders.RepoURL
+		configRepoUrl     gitproviders.RepoURL
+		err               error
 	)
 
-	if wego.SourceType(app.Spec.SourceType) == wego.SourceType(wego.SourceTypeGit) {
+	switch {
+	case wego.SourceType(app.Spec.SourceType) == wego.SourceType(wego.SourceTypeGit):
 		appRepoUrl, err = gitproviders.NewRepoURL(app.Spec.URL)
 		if err != nil {
 			return models.Application{}, err
 		}
-	} else {
+	case app.IsArtifactSource():
+		artifactSourceUrl = app.Spec.URL
+	default:
 		helmRepoUrl = app.Spec.URL
 	}
 
@@ -303,15 +410,14 @@ func WegoAppToApp(app wego.Application) (models.Application, error) {
 		HelmTargetNamespace: app.Spec.HelmTargetNamespace,
 		SourceType:          models.SourceType(app.Spec.SourceType),
 		AutomationType:      models.AutomationType(app.Spec.DeploymentType),
+		ArtifactSourceURL:   artifactSourceUrl,
+		SourceSecretRef:     app.Spec.SourceSecretRef,
+		DependsOn:           app.Spec.DependsOn,
 	}, nil
 }
 
 func AppToWegoApp(app models.Application) wego.Application {
-	sourceUrl := app.GitSourceURL.String()
-
-	if app.SourceType == models.SourceTypeHelm {
-		sourceUrl = app.HelmSourceURL
-	}
+	sourceUrl := app.SourceURL()
 
 	gvk := wego.GroupVersion.WithKind(wego.ApplicationKind)
 	wegoApp := wego.Application{
@@ -331,6 +437,8 @@ func AppToWegoApp(app models.Application) wego.Application {
 			DeploymentType:      wego.DeploymentType(string(app.AutomationType)),
 			SourceType:          wego.SourceType(string(app.SourceType)),
 			HelmTargetNamespace: app.HelmTargetNamespace,
+			SourceSecretRef:     app.SourceSecretRef,
+			DependsOn:           app.DependsOn,
 		},
 	}
 
@@ -370,13 +478,16 @@ func AppDeployName(a models.Application) string {
 }
 
 func SourceKind(a models.Application) ResourceKind {
-	result := ResourceKindGitRepository
-
-	if a.SourceType == models.SourceTypeHelm {
-		result = ResourceKindHelmRepository
+	switch a.SourceType {
+	case models.SourceTypeHelm:
+		return ResourceKindHelmRepository
+	case models.SourceTypeOCI:
+		return ResourceKindOCIRepository
+	case models.SourceTypeBucket:
+		return ResourceKindBucket
+	default:
+		return ResourceKindGitRepository
 	}
-
-	return result
 }
 
 func DeployKind(a models.Application) ResourceKind {
@@ -402,7 +513,7 @@ func GetAppHash(a models.Application) string {
 			return "wego-" + getHash(a.GitSourceURL.String(), a.Name, a.Branch)
 		}
 	} else {
-		return "wego-" + getHash(a.GitSourceURL.String(), a.Path, a.Branch)
+		return "wego-" + getHash(a.SourceURL(), a.Path, a.Branch)
 	}
 }
 
@@ -420,6 +531,10 @@ func (rk ResourceKind) ToGVR() (schema.GroupVersionResource, error) {
 		return kube.GVRGitRepository, nil
 	case ResourceKindHelmRepository:
 		return kube.GVRHelmRepository, nil
+	case ResourceKindBucket:
+		return kube.GVRBucket, nil
+	case ResourceKindOCIRepository:
+		return kube.GVROCIRepository, nil
 	case ResourceKindHelmRelease:
 		return kube.GVRHelmRelease, nil
 	case ResourceKindKustomization:
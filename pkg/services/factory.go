@@ -30,7 +30,10 @@ type GitConfigParams struct {
 	ConfigRepo       string
 	Namespace        string
 	IsHelmRepository bool
+	IsArtifactSource bool
 	DryRun           bool
+	// GitAuth is how the application repository is authenticated with. Defaults to the mode it was set up with.
+	GitAuth gitproviders.GitAuth
 }
 
 func NewGitConfigParamsFromApp(app *wego.Application, dryRun bool) GitConfigParams {
@@ -41,6 +44,7 @@ func NewGitConfigParamsFromApp(app *wego.Application, dryRun bool) GitConfigPara
 		ConfigRepo:       app.Spec.ConfigRepo,
 		Namespace:        app.Namespace,
 		IsHelmRepository: isHelmRepository,
+		IsArtifactSource: app.IsArtifactSource(),
 		DryRun:           dryRun,
 	}
 }
@@ -72,8 +76,8 @@ func (f *defaultFactory) GetGitClients(ctx context.Context, kubeClient kube.Kube
 		return nil, nil, fmt.Errorf("error getting auth service: %w", err)
 	}
 
-	// Do not add deploy key for helm repo, empty url or if its gonna be added below
-	if !params.IsHelmRepository && params.URL != "" && params.URL != params.ConfigRepo {
+	// Do not add deploy key for helm repo, OCI repositories, buckets, empty url or if its gonna be added below
+	if !params.IsHelmRepository && !params.IsArtifactSource && params.URL != "" && params.URL != params.ConfigRepo {
 		normalizedUrl, err := gitproviders.NewRepoURL(params.URL)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error normalizing url: %w", err)
@@ -88,9 +92,16 @@ func (f *defaultFactory) GetGitClients(ctx context.Context, kubeClient kube.Kube
 
 		// Do not add deploy key for public repo. Issue https://github.com/weaveworks/weave-gitops/issues/1111
 		if *repoVisibility == gitprovider.RepositoryVisibilityPrivate {
-			_, err = authSvc.SetupDeployKey(ctx, params.Namespace, normalizedUrl)
+			gitAuth := params.GitAuth
+			if gitAuth == "" {
+				if gitAuth, err = auth.RepoGitAuth(ctx, kubeClient.Raw(), params.Namespace, normalizedUrl); err != nil {
+					return nil, nil, err
+				}
+			}
+
+			_, err = authSvc.SetupGitCredentials(ctx, params.Namespace, normalizedUrl.WithGitAuth(gitAuth))
 			if err != nil {
-				return nil, nil, fmt.Errorf("error setting up deploy key: %w", err)
+				return nil, nil, fmt.Errorf("error setting up git credentials: %w", err)
 			}
 		}
 	}
@@ -119,5 +130,25 @@ func (f *defaultFactory) getAuthService(kubeClient kube.Kube, normalizedUrl gitp
 		}
 	}
 
-	return auth.NewAuthService(f.fluxClient, kubeClient.Raw(), gitProvider, f.log)
+	opts := []auth.Option{}
+
+	if tokenGetter, ok := gpClient.(gitproviders.TokenGetter); ok && !dryRun {
+		token, err := tokenGetter.GetToken(normalizedUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error obtaining git provider token: %w", err)
+		}
+
+		opts = append(opts, auth.WithProviderToken(auth.NewStaticTokenSource(token)))
+	}
+
+	githubApp, err := auth.GitHubAppTokenSourceFromConfig(normalizedUrl.URL().Host)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring the GitHub App: %w", err)
+	}
+
+	if githubApp != nil {
+		opts = append(opts, auth.WithGitHubApp(githubApp))
+	}
+
+	return auth.NewAuthService(f.fluxClient, kubeClient.Raw(), gitProvider, f.log, opts...)
 }
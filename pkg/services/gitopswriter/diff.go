@@ -0,0 +1,207 @@
+package gitopswriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+)
+
+// Actions of a FileChange
+const (
+	FileCreated = "create"
+	FileUpdated = "update"
+	FileDeleted = "delete"
+)
+
+// FileChange describes how a file in the config repository would change, with a unified diff of its content.
+type FileChange struct {
+	Path   string `json:"path"`
+	Action string `json:"action"`
+	Diff   string `json:"diff"`
+}
+
+// DiffAddApplication returns the changes AddApplication would make to the config repository,
+// without committing or pushing them.
+func (dw *gitOpsDirectoryWriterSvc) DiffAddApplication(ctx context.Context, app models.Application, clusterName string) ([]FileChange, error) {
+	auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate GitOps Automation manifests for application %s: %w", app.Name, err)
+	}
+
+	remover, repoDir, err := dw.cloneDefaultBranch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer remover()
+
+	resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	kManifest, err := addKustomizeResources(app, repoDir, clusterName, resourceEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffManifests(repoDir, append(auto.Manifests(), kManifest), nil)
+}
+
+// DiffRemoveApplication returns the changes RemoveApplication would make to the config repository,
+// without committing or pushing them.
+func (dw *gitOpsDirectoryWriterSvc) DiffRemoveApplication(ctx context.Context, app models.Application, clusterName string) ([]FileChange, error) {
+	remover, repoDir, err := dw.cloneDefaultBranch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer remover()
+
+	appSubDir := automation.AppYamlDir(app)
+
+	resourcePaths, err := dw.Osys.ReadDir(filepath.Join(repoDir, appSubDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource files: %w", err)
+	}
+
+	removed := []string{}
+	for _, resourcePath := range resourcePaths {
+		removed = append(removed, filepath.Join(appSubDir, resourcePath.Name()))
+	}
+
+	resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	kManifest, err := removeKustomizeResources(app, repoDir, clusterName, resourceEntry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove app reference from user kustomize file: %w", err)
+	}
+
+	return diffManifests(repoDir, []models.Manifest{kManifest}, removed)
+}
+
+func (dw *gitOpsDirectoryWriterSvc) cloneDefaultBranch(ctx context.Context) (func(), string, error) {
+	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to retrieve default branch for repository: %w", err)
+	}
+
+	remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to clone configuration repo: %w", err)
+	}
+
+	return remover, repoDir, nil
+}
+
+// diffManifests compares the manifests and removed paths against the files checked out in repoDir.
+// Manifests matching the current content of their file are left out.
+func diffManifests(repoDir string, manifests []models.Manifest, removed []string) ([]FileChange, error) {
+	changes := []FileChange{}
+
+	for _, manifest := range manifests {
+		current, exists, err := readRepoFile(repoDir, manifest.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists && current == string(manifest.Content) {
+			continue
+		}
+
+		action := FileUpdated
+		if !exists {
+			action = FileCreated
+		}
+
+		diff, err := unifiedDiff(manifest.Path, current, string(manifest.Content), exists, true)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, FileChange{Path: manifest.Path, Action: action, Diff: diff})
+	}
+
+	for _, path := range removed {
+		current, _, err := readRepoFile(repoDir, path)
+		if err != nil {
+			return nil, err
+		}
+
+		diff, err := unifiedDiff(path, current, "", true, false)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, FileChange{Path: path, Action: FileDeleted, Diff: diff})
+	}
+
+	return changes, nil
+}
+
+func readRepoFile(repoDir, path string) (string, bool, error) {
+	content, err := ioutil.ReadFile(filepath.Join(repoDir, path))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", false, nil
+		}
+
+		return "", false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return string(content), true, nil
+}
+
+// unifiedDiff follows the git conventions of naming a missing side /dev/null.
+func unifiedDiff(path, from, to string, fromExists, toExists bool) (string, error) {
+	fromFile, toFile := "a/"+path, "b/"+path
+
+	if !fromExists {
+		fromFile = "/dev/null"
+	}
+
+	if !toExists {
+		toFile = "/dev/null"
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to diff %s: %w", path, err)
+	}
+
+	return diff, nil
+}
+
+// splitLines splits s into lines that keep their newline. Unlike difflib.SplitLines,
+// content ending with a newline does not get an extra empty line.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
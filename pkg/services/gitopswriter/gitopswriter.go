@@ -2,10 +2,17 @@ package gitopswriter
 
 import (
 	"context"
+	"crypto/md5"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/fluxcd/go-git-providers/gitprovider"
+	"github.com/fluxcd/pkg/runtime/dependency"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
@@ -27,7 +34,12 @@ var _ GitOpsDirectoryWriter = &gitOpsDirectoryWriterSvc{}
 
 type GitOpsDirectoryWriter interface {
 	AddApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error
+	AddApplications(ctx context.Context, apps []models.Application, clusterName string, autoMerge bool) error
 	RemoveApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error
+	DiffAddApplication(ctx context.Context, app models.Application, clusterName string) ([]FileChange, error)
+	DiffRemoveApplication(ctx context.Context, app models.Application, clusterName string) ([]FileChange, error)
+	PinApplication(ctx context.Context, app models.Application, revision string, autoMerge bool) error
+	UnpinApplication(ctx context.Context, app models.Application, autoMerge bool) error
 }
 
 type gitOpsDirectoryWriterSvc struct {
@@ -47,18 +59,57 @@ func NewGitOpsDirectoryWriter(automationSvc automation.AutomationGenerator, repo
 }
 
 func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error {
-	auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app, clusterName)
-	if err != nil {
-		return fmt.Errorf("could not generate GitOps Automation manifests for application %s: %w", app.Name, err)
+	return dw.addApplications(ctx, []models.Application{app}, clusterName, autoMerge, gitproviders.PullRequestInfo{
+		Title:       fmt.Sprintf("Gitops add %s", app.Name),
+		Description: fmt.Sprintf("Added yamls for %s", app.Name),
+		NewBranch:   automation.GetAppHash(app),
+	})
+}
+
+// AddApplications adds the automation of several applications in a single commit or pull request.
+func (dw *gitOpsDirectoryWriterSvc) AddApplications(ctx context.Context, apps []models.Application, clusterName string, autoMerge bool) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	names := []string{}
+	hashes := []string{}
+
+	for _, app := range apps {
+		names = append(names, app.Name)
+		hashes = append(hashes, automation.GetAppHash(app))
 	}
 
-	manifests := auto.Manifests()
+	return dw.addApplications(ctx, apps, clusterName, autoMerge, gitproviders.PullRequestInfo{
+		Title:       fmt.Sprintf("Gitops add %d applications", len(apps)),
+		Description: fmt.Sprintf("Added yamls for %s", strings.Join(names, ", ")),
+		NewBranch:   fmt.Sprintf("wego-batch-%x", md5.Sum([]byte(strings.Join(hashes, "")))),
+	})
+}
+
+func (dw *gitOpsDirectoryWriterSvc) addApplications(ctx context.Context, apps []models.Application, clusterName string, autoMerge bool, prInfo gitproviders.PullRequestInfo) error {
+	manifests := []models.Manifest{}
+
+	for _, app := range apps {
+		auto, err := dw.Automation.GenerateApplicationAutomation(ctx, app, clusterName)
+		if err != nil {
+			return fmt.Errorf("could not generate GitOps Automation manifests for application %s: %w", app.Name, err)
+		}
+
+		manifests = append(manifests, auto.Manifests()...)
+	}
 
 	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve default branch for repository: %w", err)
 	}
 
+	return retryWrite(dw.Logger, func(attempt *writeAttempt) error {
+		return dw.writeApplications(ctx, attempt, apps, manifests, defaultBranch, clusterName, autoMerge, prInfo)
+	})
+}
+
+func (dw *gitOpsDirectoryWriterSvc) writeApplications(ctx context.Context, attempt *writeAttempt, apps []models.Application, appManifests []models.Manifest, defaultBranch, clusterName string, autoMerge bool, prInfo gitproviders.PullRequestInfo) error {
 	remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
 	if err != nil {
 		return fmt.Errorf("failed to clone repo: %w", err)
@@ -66,19 +117,35 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 
 	defer remover()
 
-	resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
-	if err != nil {
+	if err := dw.checkDependencies(repoDir, apps); err != nil {
+		return err
+	}
+
+	if err := attempt.checkConflicts(repoDir, manifestChanges(appManifests)); err != nil {
 		return err
 	}
 
-	kManifest, err := addKustomizeResources(app, repoDir, clusterName, resourceEntry)
+	resourceEntries := []string{}
+
+	for _, app := range apps {
+		resourceEntry, err := appKustomizeReference(getUserKustomizationRepoPath(clusterName), appPath(app.Name))
+		if err != nil {
+			return err
+		}
+
+		resourceEntries = append(resourceEntries, resourceEntry)
+	}
+
+	kManifest, err := addKustomizeResources(apps[0], repoDir, clusterName, resourceEntries...)
 	if err != nil {
 		return err
 	}
 
-	manifests = append(manifests, kManifest)
+	manifests := append(append([]models.Manifest{}, appManifests...), kManifest)
 
-	dw.Logger.Actionf("Adding application %q to cluster %q and repository", app.Name, clusterName)
+	for _, app := range apps {
+		dw.Logger.Actionf("Adding application %q to cluster %q and repository", app.Name, clusterName)
+	}
 
 	if autoMerge {
 		if err := dw.RepoWriter.WriteAndMerge(ctx, repoDir, AddCommitMessage, manifests); err != nil {
@@ -97,14 +164,9 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 		files = append(files, gitprovider.CommitFile{Path: &manifestPath, Content: &content})
 	}
 
-	prInfo := gitproviders.PullRequestInfo{
-		Title:         fmt.Sprintf("Gitops add %s", app.Name),
-		Description:   fmt.Sprintf("Added yamls for %s", app.Name),
-		CommitMessage: AddCommitMessage,
-		TargetBranch:  defaultBranch,
-		NewBranch:     automation.GetAppHash(app),
-		Files:         files,
-	}
+	prInfo.CommitMessage = AddCommitMessage
+	prInfo.TargetBranch = defaultBranch
+	prInfo.Files = files
 
 	if err := dw.RepoWriter.CreatePullRequest(ctx, prInfo); err != nil {
 		return fmt.Errorf("failed creating pull request: %w", err)
@@ -113,12 +175,104 @@ func (dw *gitOpsDirectoryWriterSvc) AddApplication(ctx context.Context, app mode
 	return nil
 }
 
+// checkDependencies fails when the applications depend on themselves, on applications that are not in the
+// repository or deployed with a different automation type, or when adding them would create a dependency
+// cycle with the applications already in the repository. Flux only resolves the dependencies of a
+// Kustomization or HelmRelease on objects of the same kind.
+func (dw *gitOpsDirectoryWriterSvc) checkDependencies(repoDir string, apps []models.Application) error {
+	hasDependencies := false
+
+	for _, app := range apps {
+		for _, name := range app.DependsOn {
+			if name == app.Name {
+				return fmt.Errorf("application %q cannot depend on itself", app.Name)
+			}
+
+			hasDependencies = true
+		}
+	}
+
+	// Applications without dependencies cannot close a cycle
+	if !hasDependencies {
+		return nil
+	}
+
+	appsDir := filepath.Join(repoDir, git.WegoRoot, git.WegoAppDir)
+
+	entries, err := dw.Osys.ReadDir(appsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read applications from repository: %w", err)
+	}
+
+	dependents := []dependency.Dependent{}
+	automationTypes := map[string]models.AutomationType{}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		content, err := ioutil.ReadFile(filepath.Join(appsDir, entry.Name(), "app.yaml"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to read application %q from repository: %w", entry.Name(), err)
+		}
+
+		existing := wego.Application{}
+		if err := yaml.Unmarshal(content, &existing); err != nil {
+			return fmt.Errorf("failed to parse application %q from repository: %w", entry.Name(), err)
+		}
+
+		dependents = append(dependents, models.Application{
+			Name:      existing.Name,
+			Namespace: existing.Namespace,
+			DependsOn: existing.Spec.DependsOn,
+		})
+		automationTypes[existing.Name] = models.AutomationType(existing.Spec.DeploymentType)
+	}
+
+	for _, app := range apps {
+		dependents = append(dependents, app)
+		automationTypes[app.Name] = app.AutomationType
+	}
+
+	for _, app := range apps {
+		for _, name := range app.DependsOn {
+			automationType, ok := automationTypes[name]
+			if !ok {
+				return fmt.Errorf("application %q depends on %q, which is not in the repository", app.Name, name)
+			}
+
+			if automationType != app.AutomationType {
+				return fmt.Errorf("application %q is deployed with %s and cannot depend on %q, which is deployed with %s", app.Name, app.AutomationType, name, automationType)
+			}
+		}
+	}
+
+	if _, err := dependency.Sort(dependents); err != nil {
+		return fmt.Errorf("invalid application dependencies: %w", err)
+	}
+
+	return nil
+}
+
 func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app models.Application, clusterName string, autoMerge bool) error {
 	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to retrieve default branch for repository: %w", err)
 	}
 
+	dw.Logger.Actionf("Removing application %q from cluster %q and repository", app.Name, clusterName)
+
+	return retryWrite(dw.Logger, func(attempt *writeAttempt) error {
+		return dw.removeApplication(ctx, attempt, app, defaultBranch, clusterName, autoMerge)
+	})
+}
+
+func (dw *gitOpsDirectoryWriterSvc) removeApplication(ctx context.Context, attempt *writeAttempt, app models.Application, defaultBranch, clusterName string, autoMerge bool) error {
 	newBranchName := automation.GetAppHash(app)
 
 	remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
@@ -128,8 +282,6 @@ func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app m
 
 	defer remover()
 
-	dw.Logger.Actionf("Removing application %q from cluster %q and repository", app.Name, clusterName)
-
 	appSubDir := automation.AppYamlDir(app)
 	appDir := filepath.Join(repoDir, appSubDir)
 
@@ -138,6 +290,15 @@ func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app m
 		return fmt.Errorf("failed to read resource files: %w", err)
 	}
 
+	removed := map[string][]byte{}
+	for _, resourcePath := range resourcePaths {
+		removed[filepath.Join(appSubDir, resourcePath.Name())] = nil
+	}
+
+	if err := attempt.checkConflicts(repoDir, removed); err != nil {
+		return err
+	}
+
 	if !autoMerge {
 		err = dw.RepoWriter.CheckoutBranch(newBranchName)
 		if err != nil {
@@ -170,6 +331,12 @@ func (dw *gitOpsDirectoryWriterSvc) RemoveApplication(ctx context.Context, app m
 
 	err = dw.RepoWriter.CommitAndPush(ctx, RemoveCommitMessage)
 	if err != nil {
+		// The pull request branch is created from the default branch, so it only moved when an earlier
+		// removal left it behind, and cloning again would not help
+		if !autoMerge && errors.Is(err, git.ErrNonFastForward) {
+			return fmt.Errorf("the branch %q of the pull request removing %q already exists in the config repository, merge or delete it and retry", newBranchName, app.Name)
+		}
+
 		return fmt.Errorf("failed to commit and push changes %w", err)
 	}
 
@@ -210,14 +377,29 @@ func NewRepoWriter(log logger.Logger, gitClient git.Git, gitProvider gitprovider
 }
 
 func (rw *repoWriter) Write(ctx context.Context, repoURL gitproviders.RepoURL, branch string, manifests []gitprovider.CommitFile) error {
+	return retryWrite(rw.log, func(attempt *writeAttempt) error {
+		return rw.write(ctx, attempt, repoURL, branch, manifests)
+	})
+}
+
+func (rw *repoWriter) write(ctx context.Context, attempt *writeAttempt, repoURL gitproviders.RepoURL, branch string, manifests []gitprovider.CommitFile) error {
 	// TODO: auto-merge will not work for most users
-	remover, _, err := gitrepo.CloneRepo(ctx, rw.gitClient, repoURL, branch)
+	remover, repoDir, err := gitrepo.CloneRepo(ctx, rw.gitClient, repoURL, branch)
 	if err != nil {
 		return fmt.Errorf("failed to clone repo: %w", err)
 	}
 
 	defer remover()
 
+	changes := map[string][]byte{}
+	for _, m := range manifests {
+		changes[*m.Path] = []byte(*m.Content)
+	}
+
+	if err := attempt.checkConflicts(repoDir, changes); err != nil {
+		return err
+	}
+
 	for _, m := range manifests {
 		if err := rw.gitClient.Write(*m.Path, []byte(*m.Content)); err != nil {
 			return fmt.Errorf("failed to write manifest: %w", err)
@@ -0,0 +1,148 @@
+package gitopswriter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/fluxcd/go-git-providers/gitprovider"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/automation"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	PinCommitMessage   = "Pin application source"
+	UnpinCommitMessage = "Unpin application source"
+)
+
+var (
+	fullCommitSHA  = regexp.MustCompile(`^[0-9a-f]{40}$`)
+	shortCommitSHA = regexp.MustCompile(`^[0-9a-f]{7,39}$`)
+)
+
+// PinApplication pins the GitRepository of an application to a commit or tag, so that
+// the application is rolled back to it. Revisions that are 40 hexadecimal characters are
+// commits, anything else is a tag.
+func (dw *gitOpsDirectoryWriterSvc) PinApplication(ctx context.Context, app models.Application, revision string, autoMerge bool) error {
+	ref, err := pinnedSourceRef(app.Branch, revision)
+	if err != nil {
+		return err
+	}
+
+	dw.Logger.Actionf("Pinning application %q to %q", app.Name, revision)
+
+	return dw.updateAppSourceRef(ctx, app, ref, autoMerge, gitproviders.PullRequestInfo{
+		Title:         fmt.Sprintf("Gitops rollback %s", app.Name),
+		Description:   fmt.Sprintf("Pinned %s to %s", app.Name, revision),
+		CommitMessage: PinCommitMessage,
+		NewBranch:     fmt.Sprintf("%s-pin-%s", automation.GetAppHash(app), revision),
+	})
+}
+
+// UnpinApplication makes the GitRepository of an application track its branch again.
+func (dw *gitOpsDirectoryWriterSvc) UnpinApplication(ctx context.Context, app models.Application, autoMerge bool) error {
+	dw.Logger.Actionf("Unpinning application %q to track branch %q", app.Name, app.Branch)
+
+	return dw.updateAppSourceRef(ctx, app, &sourcev1.GitRepositoryRef{Branch: app.Branch}, autoMerge, gitproviders.PullRequestInfo{
+		Title:         fmt.Sprintf("Gitops unpin %s", app.Name),
+		Description:   fmt.Sprintf("Unpinned %s to track branch %s", app.Name, app.Branch),
+		CommitMessage: UnpinCommitMessage,
+		NewBranch:     fmt.Sprintf("%s-unpin", automation.GetAppHash(app)),
+	})
+}
+
+func (dw *gitOpsDirectoryWriterSvc) updateAppSourceRef(ctx context.Context, app models.Application, ref *sourcev1.GitRepositoryRef, autoMerge bool, prInfo gitproviders.PullRequestInfo) error {
+	if app.SourceType != models.SourceTypeGit {
+		return fmt.Errorf("application %q does not have a git source", app.Name)
+	}
+
+	defaultBranch, err := dw.RepoWriter.GetDefaultBranch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve default branch for repository: %w", err)
+	}
+
+	return retryWrite(dw.Logger, func(attempt *writeAttempt) error {
+		return dw.writeAppSourceRef(ctx, attempt, app, ref, defaultBranch, autoMerge, prInfo)
+	})
+}
+
+func (dw *gitOpsDirectoryWriterSvc) writeAppSourceRef(ctx context.Context, attempt *writeAttempt, app models.Application, ref *sourcev1.GitRepositoryRef, defaultBranch string, autoMerge bool, prInfo gitproviders.PullRequestInfo) error {
+	remover, repoDir, err := dw.RepoWriter.CloneRepo(ctx, defaultBranch)
+	if err != nil {
+		return fmt.Errorf("failed to clone repo: %w", err)
+	}
+
+	defer remover()
+
+	sourcePath := automation.AppAutomationSourcePath(app)
+
+	content, exists, err := readRepoFile(repoDir, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("source of application %q not found in the config repository at %s", app.Name, sourcePath)
+	}
+
+	var source sourcev1.GitRepository
+	if err := yaml.Unmarshal([]byte(content), &source); err != nil {
+		return fmt.Errorf("failed to read source of application %q: %w", app.Name, err)
+	}
+
+	source.Spec.Reference = ref
+
+	updated, err := yaml.Marshal(source)
+	if err != nil {
+		return fmt.Errorf("failed to marshal source of application %q: %w", app.Name, err)
+	}
+
+	if string(updated) == content {
+		dw.Logger.Println("Source of application %q is already up to date", app.Name)
+		return nil
+	}
+
+	manifest := models.Manifest{Path: sourcePath, Content: updated}
+
+	if err := attempt.checkConflicts(repoDir, manifestChanges([]models.Manifest{manifest})); err != nil {
+		return err
+	}
+
+	if autoMerge {
+		if err := dw.RepoWriter.WriteAndMerge(ctx, repoDir, prInfo.CommitMessage, []models.Manifest{manifest}); err != nil {
+			return fmt.Errorf("failed writing source to disk: %w", err)
+		}
+
+		return nil
+	}
+
+	path := manifest.Path
+	contentStr := string(manifest.Content)
+
+	prInfo.TargetBranch = defaultBranch
+	prInfo.Files = []gitprovider.CommitFile{{Path: &path, Content: &contentStr}}
+
+	if err := dw.RepoWriter.CreatePullRequest(ctx, prInfo); err != nil {
+		return fmt.Errorf("failed creating pull request: %w", err)
+	}
+
+	return nil
+}
+
+// pinnedSourceRef returns a GitRepository reference that checks out revision. The branch is
+// kept, as the source-controller clones it to check out a commit.
+func pinnedSourceRef(branch, revision string) (*sourcev1.GitRepositoryRef, error) {
+	switch {
+	case revision == "":
+		return nil, fmt.Errorf("a commit or tag to pin the application to is required")
+	case fullCommitSHA.MatchString(revision):
+		return &sourcev1.GitRepositoryRef{Branch: branch, Commit: revision}, nil
+	case shortCommitSHA.MatchString(revision):
+		return nil, fmt.Errorf("commit %q must be a full 40 character SHA, e.g. from 'gitops get commits -o wide'", revision)
+	default:
+		return &sourcev1.GitRepositoryRef{Branch: branch, Tag: revision}, nil
+	}
+}
@@ -0,0 +1,107 @@
+package gitopswriter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/weaveworks/weave-gitops/pkg/git"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+)
+
+// maxWriteAttempts bounds how many times a write is retried when someone else pushes to the config
+// repository between cloning and pushing it.
+const maxWriteAttempts = 3
+
+// ErrConflict is returned when a file of a write was changed in the config repository by someone else
+// while it was written.
+var ErrConflict = errors.New("conflicting change in the config repository")
+
+// writeAttempt holds the state shared by the attempts of a write.
+type writeAttempt struct {
+	number int
+	// base holds the content of the files of the write in the clone of the first attempt, and
+	// whether they existed.
+	base map[string]*string
+}
+
+// retryWrite runs write until it does not fail because the config repository moved. Every attempt clones
+// the repository again and regenerates its changes on top of the new head. Only pushes to the branch that
+// is cloned are retried, writes pushing to other branches, like the ones of pull requests, must not fail
+// with git.ErrNonFastForward.
+func retryWrite(log logger.Logger, write func(attempt *writeAttempt) error) error {
+	attempt := &writeAttempt{base: map[string]*string{}}
+
+	for attempt.number = 1; ; attempt.number++ {
+		err := write(attempt)
+		if err == nil || !errors.Is(err, git.ErrNonFastForward) {
+			return err
+		}
+
+		if attempt.number == maxWriteAttempts {
+			return fmt.Errorf("the config repository kept changing, gave up after %d attempts: %w", maxWriteAttempts, err)
+		}
+
+		log.Warningf("The config repository changed while writing to it, retrying on top of its new head (attempt %d of %d)", attempt.number+1, maxWriteAttempts)
+	}
+}
+
+// checkConflicts fails when a file the write changes was changed by someone else since the first attempt
+// and the write would discard that change. A nil content stands for a removed file. Files derived from the
+// content of the repository, like the kustomization of a cluster, are regenerated and must not be checked.
+func (a *writeAttempt) checkConflicts(repoDir string, changes map[string][]byte) error {
+	for path, content := range changes {
+		current, exists, err := readRepoFile(repoDir, path)
+		if err != nil {
+			return err
+		}
+
+		var head *string
+		if exists {
+			head = &current
+		}
+
+		base, seen := a.base[path]
+		if !seen {
+			a.base[path] = head
+			continue
+		}
+
+		if sameContent(base, head) || sameContent(head, bytesContent(content)) {
+			continue
+		}
+
+		return fmt.Errorf("%w: %s was changed by someone else while it was written, retry once the change is reviewed", ErrConflict, path)
+	}
+
+	return nil
+}
+
+// manifestChanges returns the files a write of the manifests changes.
+func manifestChanges(manifests []models.Manifest) map[string][]byte {
+	changes := map[string][]byte{}
+
+	for _, m := range manifests {
+		changes[m.Path] = m.Content
+	}
+
+	return changes
+}
+
+func bytesContent(content []byte) *string {
+	if content == nil {
+		return nil
+	}
+
+	s := string(content)
+
+	return &s
+}
+
+func sameContent(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
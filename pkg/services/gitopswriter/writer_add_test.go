@@ -2,6 +2,11 @@ package gitopswriter
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	"github.com/go-git/go-billy/v5/memfs"
 	gogit "github.com/go-git/go-git/v5"
@@ -328,4 +333,121 @@ var _ = Describe("Add", func() {
 			})
 		})
 	})
+
+	Context("add app with dependencies", func() {
+		BeforeEach(func() {
+			app.ConfigRepo = createRepoURL("https://github.com/foo/config")
+			app.DependsOn = []string{"cert-manager"}
+			gitOpsDirWriter = createDirWriter()
+
+			osysClient.ReadDirStub = os.ReadDir
+			gitClient.CloneStub = func(_ context.Context, repoDir, _, _ string) (bool, error) {
+				appDir := filepath.Join(repoDir, git.WegoRoot, git.WegoAppDir, "cert-manager")
+				Expect(os.MkdirAll(appDir, 0755)).To(Succeed())
+
+				return true, ioutil.WriteFile(filepath.Join(appDir, "app.yaml"), []byte(`---
+apiVersion: wego.weave.works/v1alpha1
+kind: Application
+metadata:
+  name: cert-manager
+  namespace: wego-system
+spec:
+  deployment_type: kustomize
+  depends_on:
+  - bar
+`), 0644)
+			}
+		})
+
+		It("fails when the application closes a dependency cycle", func() {
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).To(MatchError(ContainSubstring("invalid application dependencies: circular dependencies")))
+
+			Expect(gitClient.WriteCallCount()).To(Equal(0))
+		})
+
+		It("fails when the application depends on itself", func() {
+			app.DependsOn = []string{"bar"}
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).To(MatchError(`application "bar" cannot depend on itself`))
+		})
+
+		It("fails when the dependency is not in the repository", func() {
+			app.DependsOn = []string{"ingress-nginx"}
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).To(MatchError(`application "bar" depends on "ingress-nginx", which is not in the repository`))
+
+			Expect(gitClient.WriteCallCount()).To(Equal(0))
+		})
+
+		It("fails when the dependency is deployed with a different automation type", func() {
+			app.AutomationType = models.AutomationTypeHelm
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).To(MatchError(`application "bar" is deployed with helm and cannot depend on "cert-manager", which is deployed with kustomize`))
+
+			Expect(gitClient.WriteCallCount()).To(Equal(0))
+		})
+
+		It("adds the application when there is no cycle", func() {
+			app.Namespace = "other-namespace"
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(gitClient.CommitCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the config repository moved while writing", func() {
+		var nonFastForward error
+
+		BeforeEach(func() {
+			app.ConfigRepo = createRepoURL("https://github.com/foo/config")
+			gitOpsDirWriter = createDirWriter()
+
+			nonFastForward = fmt.Errorf("%w: non-fast-forward update: refs/heads/main", git.ErrNonFastForward)
+			gitClient.PushReturnsOnCall(0, nonFastForward)
+		})
+
+		It("clones the new head and pushes again", func() {
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			Expect(gitClient.CloneCallCount()).To(Equal(2))
+			Expect(gitClient.CommitCallCount()).To(Equal(2))
+			Expect(gitClient.PushCallCount()).To(Equal(2))
+		})
+
+		It("gives up after a bounded number of attempts", func() {
+			gitClient.PushReturns(nonFastForward)
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(err).To(MatchError(ContainSubstring("gave up after 3 attempts")))
+			Expect(errors.Is(err, git.ErrNonFastForward)).To(BeTrue())
+
+			Expect(gitClient.PushCallCount()).To(Equal(3))
+		})
+
+		It("fails when someone else changed the files of the application", func() {
+			gitClient.CloneStub = func(_ context.Context, repoDir, _, _ string) (bool, error) {
+				if gitClient.CloneCallCount() == 1 {
+					return true, nil
+				}
+
+				appDir := filepath.Join(repoDir, git.WegoRoot, git.WegoAppDir, "bar")
+				Expect(os.MkdirAll(appDir, 0755)).To(Succeed())
+
+				return true, ioutil.WriteFile(filepath.Join(appDir, "app.yaml"), []byte("kind: Application"), 0644)
+			}
+
+			err := gitOpsDirWriter.AddApplication(ctx, app, "test-cluster", true)
+			Expect(errors.Is(err, ErrConflict)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(".weave-gitops/apps/bar/app.yaml was changed by someone else")))
+
+			Expect(gitClient.PushCallCount()).To(Equal(1))
+		})
+	})
 })
@@ -0,0 +1,102 @@
+package gitopswriter
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+)
+
+var _ = Describe("Diff", func() {
+	// Files in the config repo when it is cloned
+	var repoFiles map[string][]byte
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		app = models.Application{
+			Name:           "bar",
+			Namespace:      wego.DefaultNamespace,
+			GitSourceURL:   createRepoURL("ssh://git@github.com/foo/bar.git"),
+			ConfigRepo:     createRepoURL("ssh://git@github.com/foo/config.git"),
+			Branch:         "main",
+			Path:           "./kustomize",
+			AutomationType: models.AutomationTypeKustomize,
+			SourceType:     models.SourceTypeGit,
+		}
+
+		repoFiles = map[string][]byte{}
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitClient.CloneStub = func(_ context.Context, dir, _, _ string) (bool, error) {
+			for path, content := range repoFiles {
+				Expect(os.MkdirAll(filepath.Join(dir, filepath.Dir(path)), 0700)).To(Succeed())
+				Expect(ioutil.WriteFile(filepath.Join(dir, path), content, 0600)).To(Succeed())
+			}
+
+			return true, nil
+		}
+		osysClient.ReadDirStub = os.ReadDir
+		fluxClient.CreateSourceGitReturns(dummyGitSource, nil)
+		fluxClient.CreateKustomizationReturns([]byte("kustomization\n"), nil)
+
+		gitOpsDirWriter = createDirWriter()
+	})
+
+	It("shows the files adding an application creates and updates", func() {
+		repoFiles[".weave-gitops/clusters/test-cluster/user/kustomization.yaml"] = []byte(`apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+metadata:
+  name: test-cluster
+  namespace: wego-system
+resources:
+- ../../../apps/foo
+`)
+
+		changes, err := gitOpsDirWriter.DiffAddApplication(ctx, app, "test-cluster")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changes).To(HaveLen(5))
+
+		Expect(changes[1]).To(Equal(FileChange{
+			Path:   ".weave-gitops/apps/bar/bar-gitops-deploy.yaml",
+			Action: FileCreated,
+			Diff:   "--- /dev/null\n+++ b/.weave-gitops/apps/bar/bar-gitops-deploy.yaml\n@@ -0,0 +1 @@\n+kustomization\n",
+		}))
+
+		Expect(changes[4].Path).To(Equal(".weave-gitops/clusters/test-cluster/user/kustomization.yaml"))
+		Expect(changes[4].Action).To(Equal(FileUpdated))
+		Expect(changes[4].Diff).To(ContainSubstring(" - ../../../apps/foo\n+- ../../../apps/bar\n"))
+
+		Expect(gitClient.CommitCallCount()).To(Equal(0))
+		Expect(gitClient.PushCallCount()).To(Equal(0))
+		Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(0))
+	})
+
+	It("shows the files removing an application deletes and updates", func() {
+		repoFiles[".weave-gitops/apps/bar/app.yaml"] = []byte("kind: Application\n")
+		repoFiles[".weave-gitops/clusters/test-cluster/user/kustomization.yaml"] = dummyUserKustomization
+
+		changes, err := gitOpsDirWriter.DiffRemoveApplication(ctx, app, "test-cluster")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(changes).To(HaveLen(2))
+
+		Expect(changes[0].Path).To(Equal(".weave-gitops/clusters/test-cluster/user/kustomization.yaml"))
+		Expect(changes[0].Action).To(Equal(FileUpdated))
+		Expect(changes[0].Diff).To(ContainSubstring("-- ../../../apps/bar\n"))
+
+		Expect(changes[1]).To(Equal(FileChange{
+			Path:   ".weave-gitops/apps/bar/app.yaml",
+			Action: FileDeleted,
+			Diff:   "--- a/.weave-gitops/apps/bar/app.yaml\n+++ /dev/null\n@@ -1 +0,0 @@\n-kind: Application\n",
+		}))
+
+		Expect(gitClient.RemoveCallCount()).To(Equal(0))
+		Expect(gitClient.CommitCallCount()).To(Equal(0))
+		Expect(gitClient.PushCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,123 @@
+package gitopswriter
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
+	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/testutils"
+	"sigs.k8s.io/yaml"
+)
+
+var _ = Describe("Pin", func() {
+	const sha = "4f4c0a2b1d9e8f7a6b5c4d3e2f1a0b9c8d7e6f5a"
+
+	sourcePath := ".weave-gitops/apps/bar/bar-gitops-source.yaml"
+
+	writtenRef := func() *sourcev1.GitRepositoryRef {
+		path, content := gitClient.WriteArgsForCall(0)
+		Expect(path).To(Equal(sourcePath))
+
+		var source sourcev1.GitRepository
+		Expect(yaml.Unmarshal(content, &source)).To(Succeed())
+
+		return source.Spec.Reference
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		app = models.Application{
+			Name:           "bar",
+			Namespace:      wego.DefaultNamespace,
+			GitSourceURL:   createRepoURL("ssh://git@github.com/foo/bar.git"),
+			ConfigRepo:     createRepoURL("ssh://git@github.com/foo/config.git"),
+			Branch:         "main",
+			Path:           "./kustomize",
+			AutomationType: models.AutomationTypeKustomize,
+			SourceType:     models.SourceTypeGit,
+		}
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitProviders.CreatePullRequestReturns(testutils.DummyPullRequest{}, nil)
+		gitClient.CloneStub = func(_ context.Context, dir, _, _ string) (bool, error) {
+			Expect(os.MkdirAll(filepath.Join(dir, filepath.Dir(sourcePath)), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, sourcePath), dummyGitSource, 0600)).To(Succeed())
+
+			return true, nil
+		}
+
+		gitOpsDirWriter = createDirWriter()
+	})
+
+	It("pins the source to a commit", func() {
+		Expect(gitOpsDirWriter.PinApplication(ctx, app, sha, true)).To(Succeed())
+
+		Expect(writtenRef()).To(Equal(&sourcev1.GitRepositoryRef{Branch: "main", Commit: sha}))
+		Expect(gitClient.PushCallCount()).To(Equal(1))
+
+		commit, _ := gitClient.CommitArgsForCall(0)
+		Expect(commit.Message).To(Equal(PinCommitMessage))
+	})
+
+	It("pins the source to a tag via a pull request", func() {
+		Expect(gitOpsDirWriter.PinApplication(ctx, app, "v1.2.0", false)).To(Succeed())
+
+		Expect(gitClient.PushCallCount()).To(Equal(0))
+		Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(1))
+
+		_, _, prInfo := gitProviders.CreatePullRequestArgsForCall(0)
+		Expect(prInfo.TargetBranch).To(Equal("main"))
+		Expect(prInfo.CommitMessage).To(Equal(PinCommitMessage))
+		Expect(prInfo.Files).To(HaveLen(1))
+		Expect(*prInfo.Files[0].Path).To(Equal(sourcePath))
+
+		var source sourcev1.GitRepository
+		Expect(yaml.Unmarshal([]byte(*prInfo.Files[0].Content), &source)).To(Succeed())
+		Expect(source.Spec.Reference).To(Equal(&sourcev1.GitRepositoryRef{Branch: "main", Tag: "v1.2.0"}))
+		Expect(source.Spec.URL).To(Equal("ssh://git@github.com/user/wego-fork-test.git"))
+	})
+
+	It("fails for a short commit SHA", func() {
+		err := gitOpsDirWriter.PinApplication(ctx, app, sha[:7], true)
+		Expect(err).To(MatchError(ContainSubstring("must be a full 40 character SHA")))
+		Expect(gitClient.CloneCallCount()).To(Equal(0))
+	})
+
+	It("fails for an application with a helm source", func() {
+		app.SourceType = models.SourceTypeHelm
+
+		err := gitOpsDirWriter.PinApplication(ctx, app, sha, true)
+		Expect(err).To(MatchError("application \"bar\" does not have a git source"))
+	})
+
+	It("unpins the source", func() {
+		Expect(gitOpsDirWriter.PinApplication(ctx, app, sha, true)).To(Succeed())
+
+		_, pinned := gitClient.WriteArgsForCall(0)
+		gitClient.CloneStub = func(_ context.Context, dir, _, _ string) (bool, error) {
+			Expect(os.MkdirAll(filepath.Join(dir, filepath.Dir(sourcePath)), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, sourcePath), pinned, 0600)).To(Succeed())
+
+			return true, nil
+		}
+
+		Expect(gitOpsDirWriter.UnpinApplication(ctx, app, true)).To(Succeed())
+
+		path, content := gitClient.WriteArgsForCall(1)
+		Expect(path).To(Equal(sourcePath))
+
+		var source sourcev1.GitRepository
+		Expect(yaml.Unmarshal(content, &source)).To(Succeed())
+		Expect(source.Spec.Reference).To(Equal(&sourcev1.GitRepositoryRef{Branch: "main"}))
+
+		commit, _ := gitClient.CommitArgsForCall(1)
+		Expect(commit.Message).To(Equal(UnpinCommitMessage))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	. "github.com/onsi/gomega"
 	wego "github.com/weaveworks/weave-gitops/api/v1alpha1"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
+	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/automation"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
@@ -401,3 +403,54 @@ func NewFakePullRequest(owner string, repoName string, prNumber int) gitprovider
 		},
 	}
 }
+
+var _ = Describe("Remove when the config repository moved while writing", func() {
+	var nonFastForward error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		app = models.Application{
+			Name:           "bar",
+			Namespace:      wego.DefaultNamespace,
+			GitSourceURL:   createRepoURL("ssh://git@github.com/foo/bar.git"),
+			ConfigRepo:     createRepoURL("ssh://git@github.com/foo/config.git"),
+			Branch:         "main",
+			Path:           "./kustomize",
+			AutomationType: models.AutomationTypeKustomize,
+			SourceType:     models.SourceTypeGit,
+		}
+
+		gitProviders.GetDefaultBranchReturns("main", nil)
+		gitOpsDirWriter = createDirWriter()
+
+		osysClient.ReadDirStub = os.ReadDir
+		gitClient.CloneStub = func(_ context.Context, repoDir, _, _ string) (bool, error) {
+			appDir := filepath.Join(repoDir, automation.AppYamlDir(app))
+			Expect(os.MkdirAll(appDir, 0755)).To(Succeed())
+
+			return true, ioutil.WriteFile(filepath.Join(appDir, "app.yaml"), []byte("kind: Application\n"), 0644)
+		}
+		gitClient.CommitReturns("sha", nil)
+
+		nonFastForward = fmt.Errorf("%w: non-fast-forward update: refs/heads/main", git.ErrNonFastForward)
+		gitClient.PushReturnsOnCall(0, nonFastForward)
+	})
+
+	It("pushes the removal again on top of the new head of the default branch", func() {
+		err := gitOpsDirWriter.RemoveApplication(ctx, app, "test-cluster", true)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(gitClient.CloneCallCount()).To(Equal(2))
+		Expect(gitClient.PushCallCount()).To(Equal(2))
+	})
+
+	It("fails without retrying when the branch of the pull request already exists", func() {
+		err := gitOpsDirWriter.RemoveApplication(ctx, app, "test-cluster", false)
+		Expect(err).To(MatchError(fmt.Sprintf("the branch %q of the pull request removing %q already exists in the config repository, merge or delete it and retry", automation.GetAppHash(app), "bar")))
+		Expect(errors.Is(err, git.ErrNonFastForward)).To(BeFalse())
+
+		Expect(gitClient.CloneCallCount()).To(Equal(1))
+		Expect(gitProviders.CreatePullRequestCallCount()).To(Equal(0))
+	})
+})
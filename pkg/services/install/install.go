@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 
+	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta1"
 	"github.com/weaveworks/weave-gitops/pkg/flux"
 	"github.com/weaveworks/weave-gitops/pkg/git"
 	"github.com/weaveworks/weave-gitops/pkg/gitproviders"
@@ -13,6 +15,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/models"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitopswriter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Installer interface {
@@ -124,6 +127,20 @@ func (i *install) Install(namespace string, configURL gitproviders.RepoURL, auto
 	return nil
 }
 
+// BootstrapResources returns the flux resources Install applies to reconcile the config repository, which
+// are ready once the gitops manifests are in the config repository.
+func BootstrapResources(clusterName, namespace string, configURL gitproviders.RepoURL) []kube.Resource {
+	sourceName := models.CreateClusterSourceName(configURL)
+	systemResourceName := models.ConstrainResourceName(fmt.Sprintf("%s-system", clusterName))
+	userResourceName := models.ConstrainResourceName(fmt.Sprintf("%s-user", clusterName))
+
+	return []kube.Resource{
+		&sourcev1.GitRepository{ObjectMeta: metav1.ObjectMeta{Name: sourceName, Namespace: namespace}},
+		&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: systemResourceName, Namespace: namespace}},
+		&kustomizev2.Kustomization{ObjectMeta: metav1.ObjectMeta{Name: userResourceName, Namespace: namespace}},
+	}
+}
+
 func validateWegoInstall(ctx context.Context, kubeClient kube.Kube, namespace string) error {
 	status := kubeClient.GetClusterStatus(ctx)
 
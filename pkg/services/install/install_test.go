@@ -378,6 +378,21 @@ var _ = Describe("Installer", func() {
 	})
 })
 
+var _ = Describe("BootstrapResources", func() {
+	It("returns the source of the config repository and the cluster kustomizations", func() {
+		configRepo, err := gitproviders.NewRepoURL("ssh://git@github.com/test-user/test-repo")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		names := []string{}
+		for _, resource := range BootstrapResources("test-cluster", "test-namespace", configRepo) {
+			Expect(resource.GetNamespace()).To(Equal("test-namespace"))
+			names = append(names, resource.GetName())
+		}
+
+		Expect(names).To(Equal([]string{models.CreateClusterSourceName(configRepo), "test-cluster-system", "test-cluster-user"}))
+	})
+})
+
 type fakePullRequest struct {
 	pullRequestInfo gitprovider.PullRequestInfo
 }
@@ -2,6 +2,7 @@ package profiles
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
@@ -13,6 +14,8 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/profiles"
 	"github.com/weaveworks/weave-gitops/pkg/helm/watcher/controller"
+	"github.com/weaveworks/weave-gitops/pkg/output"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type GetOptions struct {
@@ -22,6 +25,7 @@ type GetOptions struct {
 	Namespace string
 	Writer    io.Writer
 	Port      string
+	Output    output.Options
 }
 
 // Get returns a list of available profiles.
@@ -31,9 +35,7 @@ func (s *ProfilesSvc) Get(ctx context.Context, opts GetOptions) error {
 		return err
 	}
 
-	printProfiles(profiles, opts.Writer)
-
-	return nil
+	return printProfiles(profiles, opts.Writer, opts.Output)
 }
 
 func doKubeGetRequest(ctx context.Context, namespace, serviceName, servicePort, path string, clientset kubernetes.Interface) (*pb.GetProfilesResponse, error) {
@@ -103,15 +105,44 @@ func foundVersion(availableVersions []string, version string) bool {
 	return false
 }
 
-func printProfiles(profiles *pb.GetProfilesResponse, w io.Writer) {
-	fmt.Fprintf(w, "NAME\tDESCRIPTION\tAVAILABLE_VERSIONS\n")
+func printProfiles(profiles *pb.GetProfilesResponse, w io.Writer, o output.Options) error {
+	if !o.IsTable() {
+		// Use the field names of the profiles API
+		items := []json.RawMessage{}
+
+		for _, p := range profiles.Profiles {
+			item, err := protojson.Marshal(p)
+			if err != nil {
+				return fmt.Errorf("failed to marshal profile %s: %w", p.Name, err)
+			}
+
+			items = append(items, item)
+		}
+
+		return o.Print(w, items)
+	}
+
+	fmt.Fprintf(w, "NAME\tDESCRIPTION\tAVAILABLE_VERSIONS")
+
+	if o.IsWide() {
+		fmt.Fprintf(w, "\tHELM_REPOSITORY")
+	}
+
+	fmt.Fprintln(w, "")
 
 	if profiles.Profiles != nil && len(profiles.Profiles) > 0 {
 		for _, p := range profiles.Profiles {
 			fmt.Fprintf(w, "%s\t%s\t%v", p.Name, p.Description, strings.Join(p.AvailableVersions, ","))
+
+			if o.IsWide() {
+				fmt.Fprintf(w, "\t%s/%s", p.GetHelmRepository().GetNamespace(), p.GetHelmRepository().GetName())
+			}
+
 			fmt.Fprintln(w, "")
 		}
 	}
+
+	return nil
 }
 
 func kubernetesDoRequest(ctx context.Context, namespace, serviceName, servicePort, path string, clientset kubernetes.Interface) ([]byte, error) {
@@ -16,6 +16,7 @@ import (
 	"k8s.io/client-go/testing"
 
 	"github.com/weaveworks/weave-gitops/pkg/logger/loggerfakes"
+	"github.com/weaveworks/weave-gitops/pkg/output"
 	"github.com/weaveworks/weave-gitops/pkg/services/profiles"
 )
 
@@ -84,6 +85,33 @@ podinfo	Podinfo Helm chart for Kubernetes	6.0.0,6.0.1
 `))
 		})
 
+		It("prints the available profiles in wide and structured formats", func() {
+			clientSet.AddProxyReactor("services", func(action testing.Action) (handled bool, ret restclient.ResponseWrapper, err error) {
+				return true, newFakeResponseWrapper(getProfilesResp), nil
+			})
+
+			Expect(profilesSvc.Get(context.TODO(), profiles.GetOptions{
+				Namespace: "test-namespace",
+				Writer:    buffer,
+				Port:      "9001",
+				Output:    output.Options{Format: output.FormatWide},
+			})).To(Succeed())
+
+			Expect(string(buffer.Contents())).To(Equal(`NAME	DESCRIPTION	AVAILABLE_VERSIONS	HELM_REPOSITORY
+podinfo	Podinfo Helm chart for Kubernetes	6.0.0,6.0.1	weave-system/podinfo
+`))
+
+			buffer = gbytes.NewBuffer()
+			Expect(profilesSvc.Get(context.TODO(), profiles.GetOptions{
+				Namespace: "test-namespace",
+				Writer:    buffer,
+				Port:      "9001",
+				Output:    output.Options{Format: output.FormatJSONPath, Template: "{[0].availableVersions[*]}"},
+			})).To(Succeed())
+
+			Expect(string(buffer.Contents())).To(Equal("6.0.0 6.0.1"))
+		})
+
 		When("the response isn't valid", func() {
 			It("errors", func() {
 				clientSet.AddProxyReactor("services", func(action testing.Action) (handled bool, ret restclient.ResponseWrapper, err error) {
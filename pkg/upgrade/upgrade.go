@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
 	kustomizev2 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	"github.com/fluxcd/pkg/apis/meta"
@@ -21,6 +22,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/models"
+	"github.com/weaveworks/weave-gitops/pkg/services/app"
 	"github.com/weaveworks/weave-gitops/pkg/services/gitrepo"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -41,6 +43,8 @@ type UpgradeValues struct {
 	Namespace     string
 	Values        []string
 	DryRun        bool
+	Wait          bool
+	Timeout       time.Duration
 }
 
 const EnterpriseChartURL string = "https://charts.dev.wkp.weave.works/releases/charts-v3"
@@ -141,6 +145,32 @@ func upgrade(ctx context.Context, uv UpgradeValues, kube kube.Kube, gitClient gi
 
 	logger.Successf("Pull Request created: %s", pr.Get().WebURL)
 
+	if uv.Wait {
+		return waitForResources(ctx, kube, resources, uv.Timeout, logger)
+	}
+
+	return nil
+}
+
+// waitForResources waits for the flux resources of the upgrade to be ready, once the pull request is merged.
+func waitForResources(ctx context.Context, kubeClient kube.Kube, objects []runtime.Object, timeout time.Duration, logger logger.Logger) error {
+	resources := []kube.Resource{}
+
+	for _, obj := range objects {
+		resource, ok := obj.(kube.Resource)
+		if !ok {
+			return fmt.Errorf("unexpected upgrade object %T", obj)
+		}
+
+		resources = append(resources, resource)
+	}
+
+	logger.Waitingf("Merge the pull request to complete the upgrade")
+
+	if err := app.WaitForReady(ctx, kubeClient, clock.New(), logger, timeout, resources); err != nil {
+		return fmt.Errorf("failed waiting for the upgrade: %w", err)
+	}
+
 	return nil
 }
 
@@ -53,6 +53,9 @@ func WaitUntil(out io.Writer, poll, timeout time.Duration, checkDone func() erro
 	return err
 }
 
+// ErrPollTimeout is returned by Poll when the condition is not met before the timeout.
+var ErrPollTimeout = errors.New("poll timeout")
+
 func Poll(appClock clock.Clock, intervalDur time.Duration, timeoutDur time.Duration, condition func() (bool, error)) error {
 	timeout := appClock.After(timeoutDur)
 	ticker := appClock.Ticker(intervalDur)
@@ -60,7 +63,7 @@ func Poll(appClock clock.Clock, intervalDur time.Duration, timeoutDur time.Durat
 	for {
 		select {
 		case <-timeout:
-			return errors.New("poll timeout")
+			return ErrPollTimeout
 		case <-ticker.C:
 			ok, err := condition()
 			if err != nil {
@@ -24,11 +24,15 @@ export enum GitProvider {
   Unknown = "Unknown",
   GitHub = "GitHub",
   GitLab = "GitLab",
+  BitbucketServer = "BitbucketServer",
+  Gitea = "Gitea",
 }
 
 export enum SourceType {
   Git = "Git",
   Helm = "Helm",
+  OCI = "OCI",
+  Bucket = "Bucket",
 }
 
 export type Condition = {
@@ -51,6 +55,7 @@ export type Application = {
   kustomization?: Kustomization
   helmRelease?: HelmRelease
   source?: Source
+  clusterName?: string
 }
 
 export type Kustomization = {
@@ -72,6 +77,16 @@ export type HelmRelease = {
   interval?: string
   lastAppliedRevision?: string
   conditions?: Condition[]
+  values?: string
+  valuesFrom?: HelmValuesReference[]
+}
+
+export type HelmValuesReference = {
+  kind?: string
+  name?: string
+  valuesKey?: string
+  targetPath?: string
+  optional?: boolean
 }
 
 export type HelmChart = {
@@ -103,19 +118,34 @@ export type AuthenticateResponse = {
 
 export type ListApplicationsRequest = {
   namespace?: string
+  clusterName?: string
+  allClusters?: boolean
 }
 
 export type ListApplicationsResponse = {
   applications?: Application[]
+  errors?: ClusterError[]
+}
+
+export type ClusterError = {
+  clusterName?: string
+  message?: string
 }
 
 export type GetApplicationRequest = {
   name?: string
   namespace?: string
+  clusterName?: string
+}
+
+export type ApplicationHealth = {
+  status?: string
+  message?: string
 }
 
 export type GetApplicationResponse = {
   application?: Application
+  health?: ApplicationHealth
 }
 
 export type AddApplicationRequest = {
@@ -153,12 +183,59 @@ export type SyncApplicationResponse = {
   success?: boolean
 }
 
+export type SuspendApplicationRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+}
+
+export type SuspendApplicationResponse = {
+  success?: boolean
+  suspended?: boolean
+}
+
+export type ResumeApplicationRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+}
+
+export type ResumeApplicationResponse = {
+  success?: boolean
+  resumed?: boolean
+}
+
+export type RollbackApplicationRequest = {
+  name?: string
+  namespace?: string
+  revision?: string
+  autoMerge?: boolean
+}
+
+export type RollbackApplicationResponse = {
+  success?: boolean
+  repoUrl?: string
+}
+
+export type UnpinApplicationRequest = {
+  name?: string
+  namespace?: string
+  autoMerge?: boolean
+}
+
+export type UnpinApplicationResponse = {
+  success?: boolean
+  repoUrl?: string
+}
+
 export type Commit = {
   hash?: string
   date?: string
   author?: string
   message?: string
   url?: string
+  signed?: boolean
+  verified?: boolean
 }
 
 
@@ -188,6 +265,7 @@ export type UnstructuredObject = {
   namespace?: string
   uid?: string
   status?: string
+  message?: string
 }
 
 export type GetReconciledObjectsReq = {
@@ -195,6 +273,7 @@ export type GetReconciledObjectsReq = {
   automationNamespace?: string
   automationKind?: AutomationKind
   kinds?: GroupVersionKind[]
+  clusterName?: string
 }
 
 export type GetReconciledObjectsRes = {
@@ -204,12 +283,37 @@ export type GetReconciledObjectsRes = {
 export type GetChildObjectsReq = {
   groupVersionKind?: GroupVersionKind
   parentUid?: string
+  clusterName?: string
 }
 
 export type GetChildObjectsRes = {
   objects?: UnstructuredObject[]
 }
 
+export type ListApplicationEventsRequest = {
+  name?: string
+  namespace?: string
+  type?: string
+  clusterName?: string
+}
+
+export type Event = {
+  type?: string
+  reason?: string
+  message?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  count?: number
+  firstTimestamp?: string
+  lastTimestamp?: string
+  source?: string
+}
+
+export type ListApplicationEventsResponse = {
+  events?: Event[]
+}
+
 export type GetGithubDeviceCodeRequest = {
 }
 
@@ -264,6 +368,38 @@ export type ValidateProviderTokenResponse = {
   valid?: boolean
 }
 
+export type WatchApplicationsRequest = {
+  namespace?: string
+}
+
+export type WatchApplicationsResponse = {
+  type?: string
+  kind?: string
+  name?: string
+  namespace?: string
+  application?: string
+  conditions?: Condition[]
+  lastAppliedRevision?: string
+  suspended?: boolean
+}
+
+export type StreamApplicationLogsRequest = {
+  name?: string
+  namespace?: string
+  labelSelector?: string
+  container?: string
+  sinceSeconds?: string
+  follow?: boolean
+  clusterName?: string
+}
+
+export type StreamApplicationLogsResponse = {
+  namespace?: string
+  pod?: string
+  container?: string
+  line?: string
+}
+
 export class Applications {
   static Authenticate(req: AuthenticateRequest, initReq?: fm.InitReq): Promise<AuthenticateResponse> {
     return fm.fetchReq<AuthenticateRequest, AuthenticateResponse>(`/v1/authenticate/${req["providerName"]}`, {...initReq, method: "POST", body: JSON.stringify(req)})
@@ -283,6 +419,9 @@ export class Applications {
   static GetChildObjects(req: GetChildObjectsReq, initReq?: fm.InitReq): Promise<GetChildObjectsRes> {
     return fm.fetchReq<GetChildObjectsReq, GetChildObjectsRes>(`/v1/applications/child_objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static ListApplicationEvents(req: ListApplicationEventsRequest, initReq?: fm.InitReq): Promise<ListApplicationEventsResponse> {
+    return fm.fetchReq<ListApplicationEventsRequest, ListApplicationEventsResponse>(`/v1/applications/${req["name"]}/events?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
   static GetGithubDeviceCode(req: GetGithubDeviceCodeRequest, initReq?: fm.InitReq): Promise<GetGithubDeviceCodeResponse> {
     return fm.fetchReq<GetGithubDeviceCodeRequest, GetGithubDeviceCodeResponse>(`/v1/applications/auth_providers/github?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -304,10 +443,28 @@ export class Applications {
   static SyncApplication(req: SyncApplicationRequest, initReq?: fm.InitReq): Promise<SyncApplicationResponse> {
     return fm.fetchReq<SyncApplicationRequest, SyncApplicationResponse>(`/v1/applications/${req["name"]}/sync`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static SuspendApplication(req: SuspendApplicationRequest, initReq?: fm.InitReq): Promise<SuspendApplicationResponse> {
+    return fm.fetchReq<SuspendApplicationRequest, SuspendApplicationResponse>(`/v1/applications/${req["name"]}/suspend`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ResumeApplication(req: ResumeApplicationRequest, initReq?: fm.InitReq): Promise<ResumeApplicationResponse> {
+    return fm.fetchReq<ResumeApplicationRequest, ResumeApplicationResponse>(`/v1/applications/${req["name"]}/resume`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static RollbackApplication(req: RollbackApplicationRequest, initReq?: fm.InitReq): Promise<RollbackApplicationResponse> {
+    return fm.fetchReq<RollbackApplicationRequest, RollbackApplicationResponse>(`/v1/applications/${req["name"]}/rollback`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static UnpinApplication(req: UnpinApplicationRequest, initReq?: fm.InitReq): Promise<UnpinApplicationResponse> {
+    return fm.fetchReq<UnpinApplicationRequest, UnpinApplicationResponse>(`/v1/applications/${req["name"]}/unpin`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static ParseRepoURL(req: ParseRepoURLRequest, initReq?: fm.InitReq): Promise<ParseRepoURLResponse> {
     return fm.fetchReq<ParseRepoURLRequest, ParseRepoURLResponse>(`/v1/applications/parse_repo_url?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
   static ValidateProviderToken(req: ValidateProviderTokenRequest, initReq?: fm.InitReq): Promise<ValidateProviderTokenResponse> {
     return fm.fetchReq<ValidateProviderTokenRequest, ValidateProviderTokenResponse>(`/v1/applications/validate_token`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static WatchApplications(req: WatchApplicationsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchApplicationsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchApplicationsRequest, WatchApplicationsResponse>(`/v1/applications/watch?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static StreamApplicationLogs(req: StreamApplicationLogsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<StreamApplicationLogsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<StreamApplicationLogsRequest, StreamApplicationLogsResponse>(`/v1/applications/${req["name"]}/logs?${fm.renderURLSearchParams(req, ["name"])}`, entityNotifier, {...initReq, method: "GET"})
+  }
 }
\ No newline at end of file